@@ -0,0 +1,174 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"minecraftgo/commands"
+	"minecraftgo/wrapper"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Spec describes an action by kind and parameters, so it can be written to disk
+// and built again later
+type Spec struct {
+	Kind   string `json:"kind"`
+	Params Params `json:"params,omitempty"`
+}
+
+// Params are read from JSON as strings, numbers, booleans or lists of
+// strings, lists become "a,b,c"
+type Params map[string]string
+
+func (p *Params) UnmarshalJSON(data []byte) error {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := make(Params, len(raw))
+	for _, key := range keys {
+		param, err := paramString(raw[key])
+		if err != nil {
+			return fmt.Errorf("param %s: %w", key, err)
+		}
+		params[key] = param
+	}
+	*p = params
+	return nil
+}
+
+func paramString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []any:
+		items := make([]string, len(v))
+		for idx, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return "", errors.New("lists can only hold strings")
+			}
+			items[idx] = s
+		}
+		return strings.Join(items, ","), nil
+	}
+	return "", fmt.Errorf("can't use %v", value)
+}
+
+func (p Params) String(key string, def string) string {
+	if v, ok := p[key]; ok && v != "" {
+		return v
+	}
+	return def
+}
+
+func (p Params) Int(key string, def int) (int, error) {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("param %s: %w", key, err)
+	}
+	return i, nil
+}
+
+func (p Params) Float(key string, def float64) (float64, error) {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("param %s: %w", key, err)
+	}
+	return f, nil
+}
+
+func (p Params) Bool(key string, def bool) (bool, error) {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("param %s: %w", key, err)
+	}
+	return b, nil
+}
+
+func (p Params) List(key string) []string {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return nil
+	}
+	items := strings.Split(v, ",")
+	for idx := range items {
+		items[idx] = strings.TrimSpace(items[idx])
+	}
+	return items
+}
+
+// Env is everything an action needs to run against the game
+type Env struct {
+	Wrapper    *wrapper.Wrapper
+	Player     string
+	Attributes *commands.AttributeManager
+}
+
+type Action interface {
+	Apply(env *Env) error
+}
+
+// Timed actions can be undone after a while
+type Timed interface {
+	Action
+	// Key groups actions that replace each other, e.g. all weather changes
+	Key() string
+	// Revert is called before Apply and returns the action that restores the current state
+	Revert(env *Env) (Spec, error)
+}
+
+type Builder func(params Params) (Action, error)
+
+var builders = map[string]Builder{}
+
+func Register(kind string, b Builder) {
+	builders[kind] = b
+}
+
+func Build(spec Spec) (Action, error) {
+	b, ok := builders[spec.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown action kind %q", spec.Kind)
+	}
+	return b(spec.Params)
+}
+
+func Kinds() []string {
+	kinds := make([]string, 0, len(builders))
+	for k := range builders {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// ActionFunc adapts a plain function to an Action
+type ActionFunc func(env *Env) error
+
+func (f ActionFunc) Apply(env *Env) error {
+	return f(env)
+}
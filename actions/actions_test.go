@@ -0,0 +1,29 @@
+package actions_test
+
+import (
+	"encoding/json"
+	"minecraftgo/actions"
+	"testing"
+)
+
+// config.json and commands.json write params the same way
+func TestSpecParams(t *testing.T) {
+	var spec actions.Spec
+	err := json.Unmarshal([]byte(`{"kind": "give", "params": {"items": ["minecraft:diamond", "minecraft:emerald"], "count": 5, "scale": 1.5, "quiet": true}}`), &spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := actions.Params{"items": "minecraft:diamond,minecraft:emerald", "count": "5", "scale": "1.5", "quiet": "true"}
+	for key, value := range want {
+		if spec.Params[key] != value {
+			t.Errorf("%s: got %q, want %q", key, spec.Params[key], value)
+		}
+	}
+	if _, err := actions.Build(spec); err != nil {
+		t.Error(err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"kind": "give", "params": {"items": [1, 2]}}`), &spec); err == nil {
+		t.Error("expected an error for a list of numbers")
+	}
+}
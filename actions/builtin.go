@@ -0,0 +1,257 @@
+package actions
+
+import (
+	"fmt"
+	"minecraftgo/commands"
+)
+
+func init() {
+	Register("tell", buildTell)
+	Register("summon", buildSummon)
+	Register("teleport", buildTeleport)
+	Register("weather", buildWeather)
+	Register("difficulty", buildDifficulty)
+	Register("damage", buildDamage)
+	Register("attribute", buildAttribute)
+	Register("remove_attribute", buildRemoveAttribute)
+	Register("effect", buildEffect)
+	Register("enchant", buildEnchant)
+	Register("levels", buildLevels)
+	Register("kill", buildKill)
+	Register("give", buildGive)
+	Register("clear", buildClear)
+	Register("drop_held", buildDropHeld)
+	Register("shuffle_hotbar", buildShuffleHotbar)
+}
+
+func buildTell(params Params) (Action, error) {
+	message := params.String("message", "")
+	return ActionFunc(func(env *Env) error {
+		return commands.Tell(env.Wrapper, env.Player, message)
+	}), nil
+}
+
+func buildSummon(params Params) (Action, error) {
+	mob := commands.Mob(params.String("mob", ""))
+	if mob == "" {
+		return nil, fmt.Errorf("summon: mob is required")
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.SummonMob(env.Wrapper, env.Player, mob)
+	}), nil
+}
+
+func buildTeleport(params Params) (Action, error) {
+	distance, err := params.Float("distance", 50)
+	if err != nil {
+		return nil, err
+	}
+	height, err := params.Float("height", 10)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.TeleportRandom(env.Wrapper, env.Player, commands.NewVec3(distance, height, distance))
+	}), nil
+}
+
+type weatherAction struct {
+	weather commands.Weather
+}
+
+func buildWeather(params Params) (Action, error) {
+	weather := commands.Weather(params.String("weather", ""))
+	switch weather {
+	case commands.Clear, commands.Rain, commands.Thunder:
+	default:
+		return nil, fmt.Errorf("weather: unknown weather %q", weather)
+	}
+	return weatherAction{weather: weather}, nil
+}
+
+func (a weatherAction) Apply(env *Env) error {
+	return commands.SetWeather(env.Wrapper, a.weather)
+}
+
+func (a weatherAction) Key() string {
+	return "weather"
+}
+
+// there is no way to ask the server for the weather, so it clears up afterwards
+func (a weatherAction) Revert(env *Env) (Spec, error) {
+	return Spec{Kind: "weather", Params: Params{"weather": string(commands.Clear)}}, nil
+}
+
+type difficultyAction struct {
+	difficulty commands.Difficulty
+}
+
+func buildDifficulty(params Params) (Action, error) {
+	diff := commands.Difficulty(params.String("difficulty", ""))
+	switch diff {
+	case commands.Peaceful, commands.Easy, commands.Normal, commands.Hard:
+	default:
+		return nil, fmt.Errorf("difficulty: unknown difficulty %q", diff)
+	}
+	return difficultyAction{difficulty: diff}, nil
+}
+
+func (a difficultyAction) Apply(env *Env) error {
+	return commands.SetDifficulty(env.Wrapper, a.difficulty)
+}
+
+func (a difficultyAction) Key() string {
+	return "difficulty"
+}
+
+func (a difficultyAction) Revert(env *Env) (Spec, error) {
+	current, err := commands.GetDifficulty(env.Wrapper)
+	if err != nil {
+		return Spec{}, err
+	}
+	return Spec{Kind: "difficulty", Params: Params{"difficulty": string(current)}}, nil
+}
+
+func buildDamage(params Params) (Action, error) {
+	amount, err := params.Int("amount", 10)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.Damage(env.Wrapper, env.Player, amount)
+	}), nil
+}
+
+type attributeAction struct {
+	attribute commands.AttributeName
+	name      string
+	amount    float64
+	operation commands.AttributeOperation
+}
+
+func buildAttribute(params Params) (Action, error) {
+	a := attributeAction{
+		attribute: commands.AttributeName(params.String("attribute", "")),
+		name:      params.String("name", ""),
+		operation: commands.AttributeOperation(params.String("operation", string(commands.AddMultipliedBase))),
+	}
+	if a.attribute == "" || a.name == "" {
+		return nil, fmt.Errorf("attribute: attribute and name are required")
+	}
+	amount, err := params.Float("amount", 0)
+	if err != nil {
+		return nil, err
+	}
+	a.amount = amount
+	return a, nil
+}
+
+func (a attributeAction) Apply(env *Env) error {
+	_, err := env.Attributes.Add(env.Player, a.attribute, a.name, a.amount, a.operation)
+	return err
+}
+
+func (a attributeAction) Key() string {
+	return "attribute:" + a.name
+}
+
+func (a attributeAction) Revert(env *Env) (Spec, error) {
+	return Spec{Kind: "remove_attribute", Params: Params{"attribute": string(a.attribute), "name": a.name}}, nil
+}
+
+func buildRemoveAttribute(params Params) (Action, error) {
+	attribute := commands.AttributeName(params.String("attribute", ""))
+	name := params.String("name", "")
+	if attribute == "" || name == "" {
+		return nil, fmt.Errorf("remove_attribute: attribute and name are required")
+	}
+	return ActionFunc(func(env *Env) error {
+		id := commands.ModifierID(commands.DialectFor(env.Wrapper), name)
+		return env.Attributes.Remove(env.Player, attribute, id)
+	}), nil
+}
+
+func buildEffect(params Params) (Action, error) {
+	effect := commands.Effect(params.String("effect", ""))
+	if effect == "" {
+		return nil, fmt.Errorf("effect: effect is required")
+	}
+	seconds, err := params.Int("seconds", 10)
+	if err != nil {
+		return nil, err
+	}
+	amplifier, err := params.Int("amplifier", 1)
+	if err != nil {
+		return nil, err
+	}
+	hideParticles, err := params.Bool("hide_particles", false)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.SetEffect(env.Wrapper, env.Player, effect, seconds, amplifier, hideParticles)
+	}), nil
+}
+
+func buildEnchant(params Params) (Action, error) {
+	enchantment := commands.Enchantment(params.String("enchantment", ""))
+	if enchantment == "" {
+		return nil, fmt.Errorf("enchant: enchantment is required")
+	}
+	level, err := params.Int("level", 1)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.Enchant(env.Wrapper, env.Player, enchantment, level)
+	}), nil
+}
+
+func buildLevels(params Params) (Action, error) {
+	amount, err := params.Int("amount", 10)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.AddLevels(env.Wrapper, env.Player, amount)
+	}), nil
+}
+
+func buildKill(params Params) (Action, error) {
+	return ActionFunc(func(env *Env) error {
+		return commands.Kill(env.Wrapper, env.Player)
+	}), nil
+}
+
+func buildGive(params Params) (Action, error) {
+	items := params.List("items")
+	if len(items) == 0 {
+		return nil, fmt.Errorf("give: items are required")
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.Give(env.Wrapper, env.Player, items)
+	}), nil
+}
+
+func buildClear(params Params) (Action, error) {
+	item := params.String("item", "")
+	count, err := params.Int("count", -1)
+	if err != nil {
+		return nil, err
+	}
+	return ActionFunc(func(env *Env) error {
+		return commands.ClearInventory(env.Wrapper, env.Player, item, count)
+	}), nil
+}
+
+func buildDropHeld(params Params) (Action, error) {
+	return ActionFunc(func(env *Env) error {
+		return commands.DropHeldItem(env.Wrapper, env.Player)
+	}), nil
+}
+
+func buildShuffleHotbar(params Params) (Action, error) {
+	return ActionFunc(func(env *Env) error {
+		return commands.SwapHotbar(env.Wrapper, env.Player)
+	}), nil
+}
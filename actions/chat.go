@@ -0,0 +1,288 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChatCommand is one entry of the chat commands file. A message runs it when
+// it is one of Aliases, starts with Prefix or matches Regex, or is Name when
+// there is neither a Prefix nor a Regex. Name is also the cooldown key.
+//
+// Params can use placeholders filled from the message in lower case, like
+// the ids they usually end up as: {args} is whatever follows Prefix, {1},
+// {2}... and {name} are Regex's groups.
+type ChatCommand struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+	Prefix  string   `json:"prefix,omitempty"`
+	// has to match the whole message, ignoring case like names and prefixes
+	Regex  string `json:"regex,omitempty"`
+	Action Spec   `json:"action"`
+	// how long a timed action lasts before it's reverted, 0 keeps it
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+}
+
+type chatCommandsFile struct {
+	Commands []ChatCommand `json:"commands"`
+}
+
+// ChatMatch is a command a message ran into, with the placeholders filled in
+type ChatMatch struct {
+	Name     string
+	Spec     Spec
+	Duration time.Duration
+}
+
+type compiledCommand struct {
+	name     string
+	prefix   string
+	regex    *regexp.Regexp
+	params   Params
+	kind     string
+	duration time.Duration
+}
+
+var placeholderPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// ChatCommands maps chat messages to actions, read from a JSON file that is
+// read again whenever it changes, see Watch
+type ChatCommands struct {
+	Path string
+
+	mu       sync.Mutex
+	exact    map[string]*compiledCommand
+	patterns []*compiledCommand
+	modTime  time.Time
+}
+
+// LoadChatCommands reads path, every problem in it is reported at once
+func LoadChatCommands(path string) (*ChatCommands, error) {
+	c := &ChatCommands{Path: path}
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Reload reads the file again, on errors the commands stay as they were
+func (c *ChatCommands) Reload() error {
+	info, err := os.Stat(c.Path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(c.Path)
+	if err != nil {
+		return err
+	}
+	var file chatCommandsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("chat commands %s: %w", c.Path, err)
+	}
+	exact, patterns, err := compileChatCommands(file.Commands)
+	if err != nil {
+		return fmt.Errorf("chat commands %s: %w", c.Path, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.exact, c.patterns, c.modTime = exact, patterns, info.ModTime()
+	return nil
+}
+
+// Watch checks the file every interval and reloads it when it changed, until
+// ctx is done
+func (c *ChatCommands) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		info, err := os.Stat(c.Path)
+		if err != nil {
+			continue
+		}
+		c.mu.Lock()
+		changed := !info.ModTime().Equal(c.modTime)
+		c.mu.Unlock()
+		if !changed {
+			continue
+		}
+		if err := c.Reload(); err != nil {
+			fmt.Println("!! Chat commands not reloaded, keeping the old ones:", err)
+			// don't complain again until the file changes
+			c.mu.Lock()
+			c.modTime = info.ModTime()
+			c.mu.Unlock()
+			continue
+		}
+		fmt.Println("!! Chat commands reloaded from", c.Path)
+	}
+}
+
+// Match finds the command for a message. Names and aliases win over prefixes
+// and regexes, which are tried in the order of the file.
+func (c *ChatCommands) Match(text string) (ChatMatch, bool) {
+	text = strings.TrimSpace(text)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cmd, ok := c.exact[strings.ToLower(text)]; ok {
+		return cmd.match(nil), true
+	}
+	for _, cmd := range c.patterns {
+		if cmd.prefix != "" {
+			if len(text) > len(cmd.prefix) && strings.EqualFold(text[:len(cmd.prefix)], cmd.prefix) {
+				return cmd.match(map[string]string{"args": strings.ToLower(strings.TrimSpace(text[len(cmd.prefix):]))}), true
+			}
+			continue
+		}
+		if values, ok := groupValues(cmd.regex, text); ok {
+			return cmd.match(values), true
+		}
+	}
+	return ChatMatch{}, false
+}
+
+// groupValues are the groups of regex in text, by number and by name
+func groupValues(regex *regexp.Regexp, text string) (map[string]string, bool) {
+	groups := regex.FindStringSubmatch(text)
+	if groups == nil {
+		return nil, false
+	}
+	values := map[string]string{}
+	for idx, name := range regex.SubexpNames() {
+		// the regex ignores case, ids in the game don't
+		value := strings.ToLower(groups[idx])
+		values[strconv.Itoa(idx)] = value
+		if name != "" {
+			values[name] = value
+		}
+	}
+	return values, true
+}
+
+func (cmd *compiledCommand) match(values map[string]string) ChatMatch {
+	params := Params{}
+	for key, value := range cmd.params {
+		params[key] = placeholderPattern.ReplaceAllStringFunc(value, func(placeholder string) string {
+			return values[placeholder[1:len(placeholder)-1]]
+		})
+	}
+	return ChatMatch{Name: cmd.name, Spec: Spec{Kind: cmd.kind, Params: params}, Duration: cmd.duration}
+}
+
+func compileChatCommands(list []ChatCommand) (map[string]*compiledCommand, []*compiledCommand, error) {
+	var errs []error
+	exact := map[string]*compiledCommand{}
+	var patterns []*compiledCommand
+	names := map[string]bool{}
+
+	for idx, entry := range list {
+		label := entry.Name
+		if label == "" {
+			label = "#" + strconv.Itoa(idx+1)
+		}
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("command %s: %s", label, fmt.Sprintf(format, args...)))
+		}
+
+		name := strings.ToLower(strings.TrimSpace(entry.Name))
+		if name == "" {
+			fail("name is required")
+		} else if names[name] {
+			fail("name is used twice")
+		} else {
+			names[name] = true
+		}
+		if entry.Prefix != "" && entry.Regex != "" {
+			fail("can have a prefix or a regex, not both")
+		}
+		if entry.DurationSeconds < 0 {
+			fail("duration_seconds can't be negative")
+		}
+
+		cmd := &compiledCommand{
+			name:     name,
+			prefix:   entry.Prefix,
+			kind:     entry.Action.Kind,
+			duration: time.Duration(entry.DurationSeconds) * time.Second,
+			params:   Params{},
+		}
+		// what placeholders can be filled in from
+		available := map[string]bool{}
+		if entry.Prefix != "" {
+			available["args"] = true
+		}
+		if entry.Regex != "" {
+			// case doesn't matter, like for names and prefixes
+			regex, err := regexp.Compile("(?i)^(?:" + entry.Regex + ")$")
+			if err != nil {
+				fail("regex: %v", err)
+			} else {
+				cmd.regex = regex
+				for group, groupName := range regex.SubexpNames() {
+					available[strconv.Itoa(group)] = true
+					if groupName != "" {
+						available[groupName] = true
+					}
+				}
+			}
+		}
+
+		for key, param := range entry.Action.Params {
+			for _, placeholder := range placeholderPattern.FindAllStringSubmatch(param, -1) {
+				if !available[placeholder[1]] {
+					fail("param %s: nothing fills in {%s}", key, placeholder[1])
+				}
+			}
+			cmd.params[key] = param
+		}
+		// what a message fills in is checked when it's run, a command without
+		// placeholders can be checked now
+		placeholders := false
+		for _, param := range cmd.params {
+			placeholders = placeholders || placeholderPattern.MatchString(param)
+		}
+
+		if entry.Action.Kind == "" {
+			fail("action kind is required")
+		} else if _, ok := builders[entry.Action.Kind]; !ok {
+			fail("unknown action kind %q, known are %s", entry.Action.Kind, strings.Join(Kinds(), ", "))
+		} else if _, err := Build(cmd.match(nil).Spec); err != nil && !placeholders {
+			fail("%v", err)
+		}
+
+		triggers := entry.Aliases
+		if entry.Prefix == "" && entry.Regex == "" {
+			triggers = append([]string{entry.Name}, triggers...)
+		}
+		for _, trigger := range triggers {
+			trigger = strings.ToLower(strings.TrimSpace(trigger))
+			if trigger == "" {
+				continue
+			}
+			if other, ok := exact[trigger]; ok && other != cmd {
+				fail("%q is already a trigger of %s", trigger, other.name)
+				continue
+			}
+			exact[trigger] = cmd
+		}
+		if cmd.prefix != "" || cmd.regex != nil {
+			patterns = append(patterns, cmd)
+		}
+	}
+	return exact, patterns, errors.Join(errs...)
+}
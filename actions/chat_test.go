@@ -0,0 +1,61 @@
+package actions_test
+
+import (
+	"minecraftgo/actions"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func loadCommands(t *testing.T, file string) (*actions.ChatCommands, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "commands.json")
+	if err := os.WriteFile(path, []byte(file), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return actions.LoadChatCommands(path)
+}
+
+func TestChatCommandPlaceholders(t *testing.T) {
+	commands, err := loadCommands(t, `{"commands": [
+		{"name": "weather", "prefix": "weather ", "action": {"kind": "weather", "params": {"weather": "{args}"}}},
+		{"name": "difficulty", "prefix": "difficulty ", "action": {"kind": "difficulty", "params": {"difficulty": "{args}"}}},
+		{"name": "spawn", "regex": "spawn (?P<mob>\\w+)", "action": {"kind": "summon", "params": {"mob": "{mob}"}}}
+	]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text  string
+		param string
+		want  string
+	}{
+		{"weather Rain", "weather", "rain"},
+		{"DIFFICULTY hard", "difficulty", "hard"},
+		{"Spawn Zombie", "mob", "zombie"},
+	}
+	for _, test := range tests {
+		match, ok := commands.Match(test.text)
+		if !ok {
+			t.Errorf("%q matched nothing", test.text)
+			continue
+		}
+		if got := match.Spec.Params[test.param]; got != test.want {
+			t.Errorf("%q: %s is %q, want %q", test.text, test.param, got, test.want)
+		}
+		// what a message fills in is only checked when it runs
+		if _, err := actions.Build(match.Spec); err != nil {
+			t.Errorf("%q: %v", test.text, err)
+		}
+	}
+}
+
+func TestChatCommandCheckedAtLoad(t *testing.T) {
+	_, err := loadCommands(t, `{"commands": [
+		{"name": "storm", "action": {"kind": "weather", "params": {"weather": "hail"}}}
+	]}`)
+	if err == nil {
+		t.Error("a command that can never run loaded")
+	}
+}
@@ -0,0 +1,33 @@
+package actions
+
+import (
+	"sync"
+	"time"
+)
+
+// Cooldowns stops the same key from being used again too soon
+type Cooldowns struct {
+	Duration time.Duration
+
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+func NewCooldowns(duration time.Duration) *Cooldowns {
+	return &Cooldowns{Duration: duration, last: map[string]time.Time{}}
+}
+
+// Take claims key if it's off cooldown, otherwise reports how long is left
+func (c *Cooldowns) Take(key string) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := c.last[key]; ok {
+		if left := c.Duration - now.Sub(last); left > 0 {
+			return left, false
+		}
+	}
+	c.last[key] = now
+	return 0, true
+}
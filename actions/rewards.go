@@ -0,0 +1,29 @@
+package actions
+
+import (
+	"strings"
+	"time"
+)
+
+type Reward struct {
+	Spec     Spec
+	Duration time.Duration
+	// channel points it costs when we create the reward
+	Cost int
+}
+
+// RewardMap maps channel point rewards to actions, keyed by reward id or by
+// title, titles are matched ignoring case
+type RewardMap map[string]Reward
+
+func (m RewardMap) Lookup(id string, title string) (Reward, bool) {
+	if r, ok := m[id]; ok {
+		return r, true
+	}
+	for key, r := range m {
+		if strings.EqualFold(key, title) {
+			return r, true
+		}
+	}
+	return Reward{}, false
+}
@@ -0,0 +1,226 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Pending is a revert that has not run yet, kept on disk so a restart doesn't
+// leave the world stuck in rain or hard mode
+type Pending struct {
+	Key    string    `json:"key"`
+	Revert Spec      `json:"revert"`
+	At     time.Time `json:"at"`
+}
+
+type Scheduler struct {
+	env     *Env
+	path    string
+	mu      sync.Mutex
+	pending map[string]*Pending
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewScheduler loads pending reverts from path, an empty path keeps them in memory only
+func NewScheduler(env *Env, path string) (*Scheduler, error) {
+	s := &Scheduler{
+		env:     env,
+		path:    path,
+		pending: map[string]*Pending{},
+	}
+
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var saved []*Pending
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("pending reverts %s: %w", path, err)
+	}
+	for _, p := range saved {
+		s.pending[p.Key] = p
+	}
+	return s, nil
+}
+
+// Start reverts anything that came due while we were down as soon as the
+// server is online and then checks once a second until Shutdown
+func (s *Scheduler) Start() {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.loop()
+}
+
+func (s *Scheduler) loop() {
+	defer close(s.done)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		s.revertDue(time.Now())
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Run applies the action described by spec. Timed actions are reverted after
+// duration; running one while another with the same key is pending extends
+// the pending revert rather than stacking a second one, and the original
+// state is still what gets restored.
+func (s *Scheduler) Run(spec Spec, duration time.Duration) error {
+	action, err := Build(spec)
+	if err != nil {
+		return err
+	}
+
+	timed, ok := action.(Timed)
+	if !ok || duration <= 0 {
+		return action.Apply(s.env)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.pending[timed.Key()]; ok {
+		if err := timed.Apply(s.env); err != nil {
+			return err
+		}
+		p.At = p.At.Add(duration)
+		fmt.Println("Extended", p.Key, "until", p.At.Format(time.TimeOnly))
+		return s.save()
+	}
+
+	revert, err := timed.Revert(s.env)
+	if err != nil {
+		return fmt.Errorf("%s: capturing revert: %w", spec.Kind, err)
+	}
+	if err := timed.Apply(s.env); err != nil {
+		return err
+	}
+
+	s.pending[timed.Key()] = &Pending{Key: timed.Key(), Revert: revert, At: time.Now().Add(duration)}
+	return s.save()
+}
+
+func (s *Scheduler) Pending() []Pending {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]Pending, 0, len(s.pending))
+	for _, p := range s.pending {
+		list = append(list, *p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].At.Before(list[j].At) })
+	return list
+}
+
+// online reports whether commands can reach the server right now
+func (s *Scheduler) online() bool {
+	return s.env.Wrapper == nil || s.env.Wrapper.Online()
+}
+
+func (s *Scheduler) revertDue(now time.Time) {
+	if !s.online() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	for key, p := range s.pending {
+		if p.At.After(now) {
+			continue
+		}
+		if !s.revert(p) {
+			continue
+		}
+		delete(s.pending, key)
+		changed = true
+	}
+
+	if changed {
+		if err := s.save(); err != nil {
+			fmt.Println("problem saving pending reverts", err)
+		}
+	}
+}
+
+// revert runs p and reports whether it is done with, false means it should
+// be tried again later
+func (s *Scheduler) revert(p *Pending) bool {
+	fmt.Println("Reverting", p.Key)
+	action, err := Build(p.Revert)
+	if err != nil {
+		// it will never build, trying again won't help
+		fmt.Println("problem reverting", p.Key, err)
+		return true
+	}
+	if err := action.Apply(s.env); err != nil {
+		fmt.Println("problem reverting", p.Key, err)
+		return false
+	}
+	// commands sent while the server went down never ran
+	return s.online()
+}
+
+// Shutdown reverts everything still pending, so nothing outlives the session.
+// Whatever can't reach the server stays on disk for the next start.
+func (s *Scheduler) Shutdown() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop = nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.online() {
+		fmt.Println("Server offline, keeping", len(s.pending), "pending reverts for next time")
+		return s.save()
+	}
+	for key, p := range s.pending {
+		if s.revert(p) {
+			delete(s.pending, key)
+		}
+	}
+	return s.save()
+}
+
+func (s *Scheduler) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	list := make([]*Pending, 0, len(s.pending))
+	for _, p := range s.pending {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,74 @@
+package actions
+
+import "time"
+
+// Tier is one rung of a support ladder, e.g. 100 bits spawns a creeper and
+// 1000 bits spawns a wither
+type Tier struct {
+	// smallest amount (bits, gifted subs, ...) that reaches this tier
+	Min int
+	// lowest sub tier ("1000", "2000", "3000") that reaches this tier, empty for any
+	SubTier  string
+	Spec     Spec
+	Duration time.Duration
+	// when set, the action runs once per Unit of the amount, at most Max times
+	Unit int
+	Max  int
+}
+
+// Times is how often the tier's action runs for amount
+func (t Tier) Times(amount int) int {
+	if t.Unit <= 0 {
+		return 1
+	}
+	n := amount / t.Unit
+	if n < 1 {
+		n = 1
+	}
+	if t.Max > 0 && n > t.Max {
+		n = t.Max
+	}
+	return n
+}
+
+type TierTable []Tier
+
+// Match picks the highest tier the amount and sub tier reach
+func (tt TierTable) Match(amount int, subTier string) (Tier, bool) {
+	best := -1
+	for idx, t := range tt {
+		if amount < t.Min || (t.SubTier != "" && subTier < t.SubTier) {
+			continue
+		}
+		if best < 0 || t.Min > tt[best].Min || (t.Min == tt[best].Min && t.SubTier > tt[best].SubTier) {
+			best = idx
+		}
+	}
+	if best < 0 {
+		return Tier{}, false
+	}
+	return tt[best], true
+}
+
+type SupportTiers struct {
+	Cheer     TierTable
+	Subscribe TierTable
+	Resub     TierTable
+	GiftSub   TierTable
+	// Min is in US cents
+	Tip TierTable
+}
+
+// RunTier runs the matching tier's action as many times as the amount asks for
+func (s *Scheduler) RunTier(tt TierTable, amount int, subTier string) (bool, error) {
+	tier, ok := tt.Match(amount, subTier)
+	if !ok {
+		return false, nil
+	}
+	for idx := 0; idx < tier.Times(amount); idx++ {
+		if err := s.Run(tier.Spec, tier.Duration); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,70 @@
+// discordmock runs a fake discord to point the bot at, put the printed
+// endpoints under "discord" in config.json. Messages and slash commands come
+// from -script or from posting script lines to /mock/inject, e.g.
+//
+//	curl -d '{"message": {"user": "viewer1", "text": "!skeleton"}}' localhost:8081/mock/inject
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"minecraftgo/discord/discordmock"
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8081", "address to listen on")
+	script := flag.String("script", "", "script of messages to play once the bot has connected")
+	heartbeat := flag.Duration("heartbeat", 10*time.Second, "heartbeat interval sent in hello")
+	token := flag.String("token", "", "bot token to accept, empty accepts any")
+	flag.Parse()
+
+	server := discordmock.NewServer()
+	server.HeartbeatInterval = *heartbeat
+	server.Token = *token
+
+	// discord stays off without a token, any does unless -token is set
+	configToken := *token
+	if configToken == "" {
+		configToken = "mock-token"
+	}
+	settings, _ := json.MarshalIndent(map[string]any{"discord": map[string]any{
+		"token":          configToken,
+		"application_id": "mockapp",
+		"channel_id":     server.ChannelId,
+		"guild_id":       server.GuildId,
+		"endpoints":      discordmock.Endpoints("http://" + *addr),
+	}}, "", "\t")
+	fmt.Println(string(settings))
+
+	if *script != "" {
+		go playScript(server, *script)
+	}
+
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		panic(err)
+	}
+}
+
+// playScript waits for the bot to connect before starting
+func playScript(server *discordmock.Server, path string) {
+	for server.Connected() == 0 {
+		time.Sleep(time.Second)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Println("!! Could not open script", err)
+		return
+	}
+	defer f.Close()
+
+	if err := server.RunScript(context.Background(), f); err != nil {
+		fmt.Println("!! Script failed", err)
+	}
+	fmt.Println("Script done")
+}
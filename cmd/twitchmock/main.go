@@ -0,0 +1,59 @@
+// twitchmock runs a fake twitch to point the bot at, put the printed
+// endpoints under "twitch" in config.json. Events come from -script or from
+// posting script lines to /mock/inject, e.g.
+//
+//	curl -d '{"chat": {"user": "viewer1", "text": "skeleton"}}' localhost:8080/mock/inject
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"minecraftgo/twitch/twitchmock"
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	script := flag.String("script", "", "script of events to play once the bot has subscribed")
+	keepalive := flag.Duration("keepalive", 10*time.Second, "default websocket keepalive timeout")
+	login := flag.String("login", "streamer", "login suggested on the authorize page")
+	flag.Parse()
+
+	server := twitchmock.NewServer()
+	server.KeepaliveTimeout = *keepalive
+	server.DefaultLogin = *login
+
+	endpoints, _ := json.MarshalIndent(map[string]any{"twitch": twitchmock.Endpoints("http://" + *addr)}, "", "\t")
+	fmt.Println(string(endpoints))
+
+	if *script != "" {
+		go playScript(server, *script)
+	}
+
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		panic(err)
+	}
+}
+
+// playScript waits for the bot to subscribe to something before starting
+func playScript(server *twitchmock.Server, path string) {
+	for len(server.Subscriptions()) == 0 {
+		time.Sleep(time.Second)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Println("!! Could not open script", err)
+		return
+	}
+	defer f.Close()
+
+	if err := server.RunScript(context.Background(), f); err != nil {
+		fmt.Println("!! Script failed", err)
+	}
+	fmt.Println("Script done")
+}
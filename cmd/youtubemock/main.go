@@ -0,0 +1,61 @@
+// youtubemock runs a fake YouTube live chat to point the bot at, put the
+// printed settings under "youtube" in config.json. Chat comes from -script or
+// from posting script lines to /mock/inject, e.g.
+//
+//	curl -d '{"author": {"name": "viewer1"}, "chat": "skeleton"}' localhost:8082/mock/inject
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"minecraftgo/youtube/youtubemock"
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8082", "address to listen on")
+	script := flag.String("script", "", "script of chat to play once the bot polls")
+	interval := flag.Duration("interval", 2*time.Second, "pollingIntervalMillis handed to the bot")
+	flag.Parse()
+
+	server := youtubemock.NewServer()
+	server.PollingInterval = *interval
+
+	settings, _ := json.MarshalIndent(map[string]any{"youtube": map[string]any{
+		"api_key":  "mock",
+		"video_id": server.VideoId,
+		"api":      youtubemock.ApiUrl("http://" + *addr),
+	}}, "", "\t")
+	fmt.Println(string(settings))
+
+	if *script != "" {
+		go playScript(server, *script)
+	}
+
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		panic(err)
+	}
+}
+
+// playScript waits for the bot to poll before starting
+func playScript(server *youtubemock.Server, path string) {
+	for server.Polls() == 0 {
+		time.Sleep(time.Second)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Println("!! Could not open script", err)
+		return
+	}
+	defer f.Close()
+
+	if err := server.RunScript(context.Background(), f); err != nil {
+		fmt.Println("!! Script failed", err)
+	}
+	fmt.Println("Script done")
+}
@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"fmt"
+	"minecraftgo/wrapper"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// modifier ids are derived from a name so re-applying the same effect replaces
+// the modifier instead of stacking another one
+var modifierNamespace = uuid.MustParse("6f1c8f43-2b7e-4c55-9a39-0d0a3c1f6b2e")
+
+var attributeValueRegex = regexp.MustCompile(`(-?[0-9]+(\.[0-9]+)?([eE]-?[0-9]+)?)\s*$`)
+
+type AttributeModifier struct {
+	ID        string
+	Attribute AttributeName
+	Amount    float64
+	Operation AttributeOperation
+	// zero for modifiers that stay until removed
+	Expires time.Time
+}
+
+type trackedModifier struct {
+	AttributeModifier
+	timer *time.Timer
+}
+
+type AttributeManager struct {
+	wpr       *wrapper.Wrapper
+	mu        sync.Mutex
+	modifiers map[string]map[string]*trackedModifier
+}
+
+func NewAttributeManager(wpr *wrapper.Wrapper) *AttributeManager {
+	return &AttributeManager{
+		wpr:       wpr,
+		modifiers: map[string]map[string]*trackedModifier{},
+	}
+}
+
+// ModifierID returns the id used for a named modifier, a resource location on
+// 1.21 and newer and a stable uuid before that
+func ModifierID(d Dialect, name string) string {
+	if d.AtLeast("1.21") {
+		return "minecraftgo:" + name
+	}
+	return uuid.NewSHA1(modifierNamespace, []byte(name)).String()
+}
+
+func modifierKey(attribute AttributeName, id string) string {
+	return string(attribute) + " " + id
+}
+
+func (m *AttributeManager) Add(player_name string, attribute AttributeName, name string, amount float64, operation AttributeOperation) (string, error) {
+	return m.add(player_name, attribute, name, amount, operation, 0)
+}
+
+// AddTimed applies a modifier that removes itself after duration. Adding the
+// same name again replaces the modifier and restarts the timer.
+func (m *AttributeManager) AddTimed(player_name string, attribute AttributeName, name string, amount float64, operation AttributeOperation, duration time.Duration) (string, error) {
+	return m.add(player_name, attribute, name, amount, operation, duration)
+}
+
+func (m *AttributeManager) add(player_name string, attribute AttributeName, name string, amount float64, operation AttributeOperation, duration time.Duration) (string, error) {
+	d := DialectFor(m.wpr)
+	if err := d.validateAttribute(attribute); err != nil {
+		return "", err
+	}
+	id := ModifierID(d, name)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the server refuses to add a modifier id twice, and after a restart it
+	// can still have one we lost track of, so take it off either way
+	if existing := m.modifiers[player_name][modifierKey(attribute, id)]; existing != nil {
+		if err := m.remove(player_name, existing); err != nil {
+			return "", err
+		}
+	} else if err := RemoveAttributeModifier(m.wpr, player_name, attribute, id); err != nil {
+		return "", err
+	}
+
+	cmd, err := d.attributeModifierAdd(player_name, attribute, id, amount, operation)
+	if err != nil {
+		return "", err
+	}
+	if err := run(m.wpr, cmd); err != nil {
+		return "", err
+	}
+
+	tm := &trackedModifier{AttributeModifier: AttributeModifier{ID: id, Attribute: attribute, Amount: amount, Operation: operation}}
+	if duration > 0 {
+		tm.Expires = time.Now().Add(duration)
+		tm.timer = time.AfterFunc(duration, func() { m.expire(player_name, tm) })
+	}
+	if m.modifiers[player_name] == nil {
+		m.modifiers[player_name] = map[string]*trackedModifier{}
+	}
+	m.modifiers[player_name][modifierKey(attribute, id)] = tm
+
+	return id, nil
+}
+
+func (m *AttributeManager) Remove(player_name string, attribute AttributeName, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tm := m.modifiers[player_name][modifierKey(attribute, id)]
+	if tm == nil {
+		// applied before a restart, the server still has it even if we don't
+		return RemoveAttributeModifier(m.wpr, player_name, attribute, id)
+	}
+	return m.remove(player_name, tm)
+}
+
+func (m *AttributeManager) expire(player_name string, tm *trackedModifier) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the modifier may have been replaced while the timer was firing
+	if m.modifiers[player_name][modifierKey(tm.Attribute, tm.ID)] != tm {
+		return
+	}
+	if err := m.remove(player_name, tm); err != nil {
+		fmt.Println("problem removing expired modifier", tm.ID, err)
+	}
+}
+
+// RemoveAll takes off every modifier the manager applied to the player
+func (m *AttributeManager) RemoveAll(player_name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tm := range m.modifiers[player_name] {
+		if err := m.remove(player_name, tm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *AttributeManager) remove(player_name string, tm *trackedModifier) error {
+	if tm.timer != nil {
+		tm.timer.Stop()
+	}
+
+	delete(m.modifiers[player_name], modifierKey(tm.Attribute, tm.ID))
+	return RemoveAttributeModifier(m.wpr, player_name, tm.Attribute, tm.ID)
+}
+
+func RemoveAttributeModifier(wpr *wrapper.Wrapper, player_name string, attribute AttributeName, id string) error {
+	d := DialectFor(wpr)
+	if err := d.require("1.16", "attribute command"); err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("/attribute %s %s modifier remove %s", player_name, d.attribute(attribute), id)
+	fmt.Println("Running command --> ", cmd)
+	wpr.SendCommand(cmd)
+	return nil
+}
+
+func (m *AttributeManager) Modifiers(player_name string) []AttributeModifier {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mods := make([]AttributeModifier, 0, len(m.modifiers[player_name]))
+	for _, tm := range m.modifiers[player_name] {
+		mods = append(mods, tm.AttributeModifier)
+	}
+	return mods
+}
+
+func GetAttributeBase(wpr *wrapper.Wrapper, player_name string, attribute AttributeName) (float64, error) {
+	d := DialectFor(wpr)
+	return queryAttribute(wpr, fmt.Sprintf("/attribute %s %s base get", player_name, d.attribute(attribute)))
+}
+
+func SetAttributeBase(wpr *wrapper.Wrapper, player_name string, attribute AttributeName, value float64) error {
+	d := DialectFor(wpr)
+	if err := d.validateAttribute(attribute); err != nil {
+		return err
+	}
+
+	return run(wpr, fmt.Sprintf("/attribute %s %s base set %f", player_name, d.attribute(attribute), value))
+}
+
+// GetAttributeValue returns the effective value with all modifiers applied
+func GetAttributeValue(wpr *wrapper.Wrapper, player_name string, attribute AttributeName) (float64, error) {
+	d := DialectFor(wpr)
+	return queryAttribute(wpr, fmt.Sprintf("/attribute %s %s get", player_name, d.attribute(attribute)))
+}
+
+func queryAttribute(wpr *wrapper.Wrapper, cmd string) (float64, error) {
+	fmt.Println("Running command --> ", cmd)
+	res := wpr.SendCommand(cmd)
+	fmt.Println("Response to command <--", res)
+
+	matches := attributeValueRegex.FindStringSubmatch(res)
+	if len(matches) < 2 {
+		return 0, fmt.Errorf("no attribute value in response: %s", res)
+	}
+	return strconv.ParseFloat(matches[1], 64)
+}
@@ -31,96 +31,209 @@ func (vec *vec3) add(addby *vec3) {
 	vec.Z = vec.Z + addby.Z
 }
 
-func SummonMob(wpr *wrapper.Wrapper, player_name string, mob_name Mob) {
+// what the server, or the wrapper for it, answers when a command did nothing
+var (
+	failurePrefixes = []string{"Unknown", "Incorrect argument", "Expected", "Invalid", "No player was found",
+		"No entity was found", "No items were found", "Unable to", "Could not", "Can't", "Nothing changed",
+		"Only players", "Server not online", "No response from server", "Command has control characters"}
+	failureParts = []string{"<--[HERE]", " cannot support that enchantment", " is not holding any item"}
+)
+
+// CheckResponse turns an answer saying cmd didn't work into an error
+func CheckResponse(cmd string, res string) error {
+	msg := strings.TrimSpace(res)
+	if idx := strings.Index(msg, "]: "); idx >= 0 {
+		msg = msg[idx+len("]: "):]
+	}
+	for _, prefix := range failurePrefixes {
+		if strings.HasPrefix(msg, prefix) {
+			return fmt.Errorf("%s: %s", cmd, msg)
+		}
+	}
+	for _, part := range failureParts {
+		if strings.Contains(msg, part) {
+			return fmt.Errorf("%s: %s", cmd, msg)
+		}
+	}
+	return nil
+}
+
+// run sends cmd and fails when the server says it didn't work
+func run(wpr *wrapper.Wrapper, cmd string) error {
+	fmt.Println("Running command --> ", cmd)
+	res := wpr.SendCommand(cmd)
+	fmt.Println("Response to command <--", res)
+	return CheckResponse(cmd, res)
+}
+
+func SummonMob(wpr *wrapper.Wrapper, player_name string, mob_name Mob) error {
+	if err := DialectFor(wpr).validate(EntityTypes, string(mob_name)); err != nil {
+		return err
+	}
+
 	//get the location of the player
 	cmd := fmt.Sprintf("/data get entity %s Pos", player_name)
 	fmt.Println("Running command --> ", cmd)
 	res := wpr.SendCommand(cmd)
 	fmt.Println("Response to command <--", res)
+	if err := CheckResponse(cmd, res); err != nil {
+		return err
+	}
 
 	//parse the information
 	pos_start := strings.LastIndex(res, "[")
 	pos_end := strings.LastIndex(res, "]")
+	if pos_start < 0 || pos_end <= pos_start {
+		return fmt.Errorf("no position in response: %s", res)
+	}
 	pos_string := strings.ReplaceAll(res[(pos_start+1):(pos_end-1)], "d", "") //clear out the double modifier
 	pos := strings.Split(pos_string, ",")
+	if len(pos) < 3 {
+		return fmt.Errorf("no position in response: %s", res)
+	}
 
-	fmt.Println("Running command --> ", cmd)
 	cmd = fmt.Sprintf("/summon %s %s%s%s", mob_name, pos[0], pos[1], pos[2])
-	fmt.Println("Response to command <--", res)
-	wpr.SendCommand(cmd)
+	return run(wpr, cmd)
 }
 
-func Tell(wpr *wrapper.Wrapper, player_name string, message string) {
+func Tell(wpr *wrapper.Wrapper, player_name string, message string) error {
+	if wrapper.HasControlCharacters(message) {
+		return fmt.Errorf("message has line breaks or control characters")
+	}
 	tell := fmt.Sprintf("/tell %s \"%s\"", player_name, message)
 	wpr.SendCommand(tell)
+	return nil
 }
 
-func SetWeather(wpr *wrapper.Wrapper, weather Weather) {
-	cmd := fmt.Sprintf("/weather %s", weather)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+func SetWeather(wpr *wrapper.Wrapper, weather Weather) error {
+	return run(wpr, fmt.Sprintf("/weather %s", weather))
 }
 
-func Damage(wpr *wrapper.Wrapper, player_name string, amount int) {
+func Damage(wpr *wrapper.Wrapper, player_name string, amount int) error {
+	if err := DialectFor(wpr).require("1.19.4", "damage command"); err != nil {
+		return err
+	}
+
 	cmd := fmt.Sprintf("/damage %s %d minecraft:fireball by %s", player_name, amount, player_name)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+	return run(wpr, cmd)
 }
 
-func Attribute(wpr *wrapper.Wrapper, player_name string, attribute AttributeName, uuid string, modifier float64) {
-	cmd := fmt.Sprintf("/attribute %s %s modifier add %s %.2f add_multiplied_base", player_name, attribute, uuid, modifier)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+func Attribute(wpr *wrapper.Wrapper, player_name string, attribute AttributeName, uuid string, modifier float64) error {
+	d := DialectFor(wpr)
+	if err := d.validateAttribute(attribute); err != nil {
+		return err
+	}
+	cmd, err := d.attributeModifierAdd(player_name, attribute, uuid, modifier, AddMultipliedBase)
+	if err != nil {
+		return err
+	}
+	return run(wpr, cmd)
 }
 
-func SetDifficulty(wpr *wrapper.Wrapper, diff Difficulty) {
+func SetDifficulty(wpr *wrapper.Wrapper, diff Difficulty) error {
 	cmd := fmt.Sprintf("/difficulty %s", diff)
 	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+	res := wpr.SendCommand(cmd)
+	fmt.Println("Response to command <--", res)
+	// already being at diff is no failure, a timed difficulty gets set again
+	if strings.Contains(res, "did not change") {
+		return nil
+	}
+	return CheckResponse(cmd, res)
 }
 
-func SetEffect(wpr *wrapper.Wrapper, player_name string, effect Effect, seconds int, amplifier int, hideParticles bool) {
-	cmd := fmt.Sprintf("/effect give %s %s %d %d %t", player_name, effect, seconds, amplifier, hideParticles)
+func GetDifficulty(wpr *wrapper.Wrapper) (Difficulty, error) {
+	cmd := "/difficulty"
 	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+	res := wpr.SendCommand(cmd)
+	fmt.Println("Response to command <--", res)
+
+	idx := strings.LastIndex(res, "The difficulty is ")
+	if idx < 0 {
+		return "", fmt.Errorf("no difficulty in response: %s", res)
+	}
+	return Difficulty(strings.ToLower(strings.TrimSpace(res[idx+len("The difficulty is "):]))), nil
+}
+
+// GetDimension returns the dimension the player is in, e.g. minecraft:the_nether
+func GetDimension(wpr *wrapper.Wrapper, player_name string) (string, error) {
+	data, err := getEntityData(wpr, player_name, "Dimension")
+	if err != nil {
+		return "", err
+	}
+	dimension, ok := data.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected dimension data %T", data)
+	}
+	return dimension, nil
+}
+
+func SetEffect(wpr *wrapper.Wrapper, player_name string, effect Effect, seconds int, amplifier int, hideParticles bool) error {
+	if err := DialectFor(wpr).validate(Effects, string(effect)); err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("/effect give %s %s %d %d %t", player_name, effect, seconds, amplifier, hideParticles)
+	return run(wpr, cmd)
 }
 
-func Enchant(wpr *wrapper.Wrapper, player_name string, enchantment Enchantment, level int) {
+func Enchant(wpr *wrapper.Wrapper, player_name string, enchantment Enchantment, level int) error {
+	if err := DialectFor(wpr).validate(Enchantments, string(enchantment)); err != nil {
+		return err
+	}
+
 	cmd := fmt.Sprintf("/enchant %s minecraft:%s %d", player_name, enchantment, level)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+	return run(wpr, cmd)
 }
 
-func AddLevels(wpr *wrapper.Wrapper, player_name string, amount int) {
-	cmd := fmt.Sprintf("/experience add %s %d levels", player_name, amount)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+func AddLevels(wpr *wrapper.Wrapper, player_name string, amount int) error {
+	return run(wpr, fmt.Sprintf("/experience add %s %d levels", player_name, amount))
 }
 
-func Kill(wpr *wrapper.Wrapper, player_name string) {
-	cmd := fmt.Sprintf("/kill %s", player_name)
-	fmt.Println("Running command --> ", cmd)
-	wpr.SendCommand(cmd)
+func Kill(wpr *wrapper.Wrapper, player_name string) error {
+	return run(wpr, fmt.Sprintf("/kill %s", player_name))
 }
 
-func Give(wpr *wrapper.Wrapper, player_name string, items []string) {
+func Give(wpr *wrapper.Wrapper, player_name string, items []string) error {
+	d := DialectFor(wpr)
 	for _, item := range items {
-		cmd := fmt.Sprintf("/give %s %s", player_name, item)
-		fmt.Println("Running command --> ", cmd)
-		wpr.SendCommand(cmd)
+		if strings.Contains(item, "[") {
+			if err := d.require("1.20.5", "item components"); err != nil {
+				return err
+			}
+		}
+		if idx := strings.IndexAny(item, "[{"); idx >= 0 {
+			item = item[:idx]
+		}
+		if err := d.validate(Items, item); err != nil {
+			return err
+		}
 	}
+
+	for _, item := range items {
+		if err := run(wpr, fmt.Sprintf("/give %s %s", player_name, item)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func TeleportRandom(wpr *wrapper.Wrapper, player_name string, maxVec vec3) {
+func TeleportRandom(wpr *wrapper.Wrapper, player_name string, maxVec vec3) error {
 	//get the location of the player
 	cmd := fmt.Sprintf("/data get entity %s Pos", player_name)
 	fmt.Println("Running command --> ", cmd)
 	res := wpr.SendCommand(cmd)
 	fmt.Println("Response to command <--", res)
+	if err := CheckResponse(cmd, res); err != nil {
+		return err
+	}
 
 	//parse the information
 	pos_start := strings.LastIndex(res, "[")
 	pos_end := strings.LastIndex(res, "]")
+	if pos_start < 0 || pos_end <= pos_start {
+		return fmt.Errorf("no position in response: %s", res)
+	}
 	pos_string := strings.ReplaceAll(res[(pos_start+1):(pos_end-1)], "d", "") //clear out the double modifier
 	pos_string = strings.ReplaceAll(pos_string, " ", "")                      //remove spaces
 	pos := strings.Split(pos_string, ",")
@@ -128,8 +241,7 @@ func TeleportRandom(wpr *wrapper.Wrapper, player_name string, maxVec vec3) {
 	for idx, p := range pos {
 		fp, err := strconv.ParseFloat(p, 64)
 		if err != nil {
-			fmt.Println("problem parsing positions, aborting")
-			return
+			return fmt.Errorf("problem parsing positions: %w", err)
 		}
 
 		fpos[idx] = fp
@@ -145,11 +257,8 @@ func TeleportRandom(wpr *wrapper.Wrapper, player_name string, maxVec vec3) {
 	moveVec.mul(&dirVec) //pick positive or negative movement
 	posVec.add(&moveVec) //apply movement to current position
 
-	fmt.Println("Running command --> ", cmd)
 	cmd = fmt.Sprintf("/teleport %s %.6f %.6f %.6f", player_name, posVec.X, posVec.Y, posVec.Z)
-	fmt.Println("Response to command <--", res)
-	wpr.SendCommand(cmd)
-
+	return run(wpr, cmd)
 }
 
 // returns either 1 or -1, used for multiplying directions randomly
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"minecraftgo/wrapper"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var ErrUnsupportedVersion = errors.New("not supported by this server version")
+
+var releaseRegex = regexp.MustCompile(`^[0-9]+\.[0-9]+(\.[0-9]+)?$`)
+
+// Dialect picks command syntax for a server version. An unknown version, such
+// as a snapshot or a server that has not finished starting, uses the newest syntax.
+type Dialect struct {
+	Version  string
+	Registry *Registry
+}
+
+var (
+	registryCache   = map[string]*Registry{}
+	registryCacheMu sync.Mutex
+)
+
+func NewDialect(version string) Dialect {
+	d := Dialect{Version: version}
+	if !d.known() {
+		return d
+	}
+
+	registryCacheMu.Lock()
+	defer registryCacheMu.Unlock()
+	reg, ok := registryCache[version]
+	if !ok {
+		// a server newer than every fixture is checked against the newest one,
+		// until UseRegistry has its own report
+		reg, _ = LoadRegistry(version)
+		registryCache[version] = reg
+	}
+	d.Registry = reg
+	return d
+}
+
+// UseRegistry makes dialects of reg's version check against it instead of
+// the checked in fixture
+func UseRegistry(reg *Registry) {
+	registryCacheMu.Lock()
+	defer registryCacheMu.Unlock()
+	registryCache[reg.Version] = reg
+}
+
+func DialectFor(wpr *wrapper.Wrapper) Dialect {
+	return NewDialect(wpr.Version())
+}
+
+func (d Dialect) known() bool {
+	return releaseRegex.MatchString(d.Version)
+}
+
+func (d Dialect) AtLeast(version string) bool {
+	return !d.known() || compareVersions(d.Version, version) >= 0
+}
+
+func (d Dialect) require(version string, what string) error {
+	if !d.AtLeast(version) {
+		return fmt.Errorf("%s needs %s, server is %s: %w", what, version, d.Version, ErrUnsupportedVersion)
+	}
+	return nil
+}
+
+// validate skips only versions without any registry, such as snapshots
+func (d Dialect) validate(kind RegistryKind, id string) error {
+	if d.Registry == nil {
+		return nil
+	}
+	return d.Registry.Validate(kind, id)
+}
+
+// attributes are looked up under the names of the registry's version, which
+// can be an older fixture that still has the generic. prefix
+func (d Dialect) validateAttribute(attribute AttributeName) error {
+	if d.Registry == nil {
+		return nil
+	}
+	return d.validate(Attributes, Dialect{Version: d.Registry.Version}.attribute(attribute))
+}
+
+// attributes were prefixed with generic. (or horse. and zombie.) until 1.21.2
+func (d Dialect) attribute(attribute AttributeName) string {
+	name := strings.TrimPrefix(string(attribute), "minecraft:")
+	if d.AtLeast("1.21.2") {
+		if idx := strings.Index(name, "."); idx >= 0 {
+			name = name[idx+1:]
+		}
+		return name
+	}
+	if strings.Contains(name, ".") {
+		return name
+	}
+	switch name {
+	case "jump_strength":
+		return "horse." + name
+	case "spawn_reinforcements":
+		return "zombie." + name
+	}
+	return "generic." + name
+}
+
+// attribute operations were renamed in 1.20.5
+func (d Dialect) attributeOperation(operation AttributeOperation) string {
+	if d.AtLeast("1.20.5") {
+		return string(operation)
+	}
+	switch operation {
+	case AddValue:
+		return "add"
+	case AddMultipliedBase:
+		return "multiply_base"
+	case AddMultipliedTotal:
+		return "multiply"
+	}
+	return string(operation)
+}
+
+type AttributeOperation string
+
+const (
+	AddValue           AttributeOperation = "add_value"
+	AddMultipliedBase  AttributeOperation = "add_multiplied_base"
+	AddMultipliedTotal AttributeOperation = "add_multiplied_total"
+)
+
+// modifiers are identified by a resource location since 1.21, by a uuid and a
+// display name before that
+func (d Dialect) attributeModifierAdd(player_name string, attribute AttributeName, id string, amount float64, operation AttributeOperation) (string, error) {
+	if err := d.require("1.16", "attribute command"); err != nil {
+		return "", err
+	}
+	if d.AtLeast("1.21") {
+		return fmt.Sprintf("/attribute %s %s modifier add %s %.2f %s", player_name, d.attribute(attribute), id, amount, d.attributeOperation(operation)), nil
+	}
+	return fmt.Sprintf("/attribute %s %s modifier add %s minecraftgo %.2f %s", player_name, d.attribute(attribute), id, amount, d.attributeOperation(operation)), nil
+}
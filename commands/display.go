@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"minecraftgo/wrapper"
+)
+
+type BossbarColor string
+
+const (
+	BossbarBlue   BossbarColor = "blue"
+	BossbarGreen  BossbarColor = "green"
+	BossbarPink   BossbarColor = "pink"
+	BossbarPurple BossbarColor = "purple"
+	BossbarRed    BossbarColor = "red"
+	BossbarWhite  BossbarColor = "white"
+	BossbarYellow BossbarColor = "yellow"
+)
+
+// textComponent quotes plain text as a json text component
+func textComponent(text string) string {
+	data, _ := json.Marshal(map[string]string{"text": text})
+	return string(data)
+}
+
+// ActionBar shows text just above the player's hotbar for a few seconds
+func ActionBar(wpr *wrapper.Wrapper, player_name string, text string) {
+	cmd := fmt.Sprintf("/title %s actionbar %s", player_name, textComponent(text))
+	fmt.Println("Running command --> ", cmd)
+	wpr.SendCommand(cmd)
+}
+
+// ShowBossbar creates the bossbar if needed and shows it to the player, id is a
+// resource location like minecraftgo:poll
+func ShowBossbar(wpr *wrapper.Wrapper, player_name string, id string, title string, color BossbarColor) {
+	for _, cmd := range []string{
+		fmt.Sprintf("/bossbar add %s %s", id, textComponent(title)),
+		fmt.Sprintf("/bossbar set %s name %s", id, textComponent(title)),
+		fmt.Sprintf("/bossbar set %s color %s", id, color),
+		fmt.Sprintf("/bossbar set %s players %s", id, player_name),
+		fmt.Sprintf("/bossbar set %s visible true", id),
+	} {
+		fmt.Println("Running command --> ", cmd)
+		wpr.SendCommand(cmd)
+	}
+}
+
+// SetBossbarProgress fills the bar to value out of max and retitles it
+func SetBossbarProgress(wpr *wrapper.Wrapper, id string, title string, value int, max int) {
+	if max < 1 {
+		max = 1
+	}
+	for _, cmd := range []string{
+		fmt.Sprintf("/bossbar set %s name %s", id, textComponent(title)),
+		fmt.Sprintf("/bossbar set %s max %d", id, max),
+		fmt.Sprintf("/bossbar set %s value %d", id, min(value, max)),
+	} {
+		fmt.Println("Running command --> ", cmd)
+		wpr.SendCommand(cmd)
+	}
+}
+
+func RemoveBossbar(wpr *wrapper.Wrapper, id string) {
+	cmd := fmt.Sprintf("/bossbar remove %s", id)
+	fmt.Println("Running command --> ", cmd)
+	wpr.SendCommand(cmd)
+}
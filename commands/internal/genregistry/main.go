@@ -0,0 +1,180 @@
+// genregistry imports server data reports as registry fixtures and generates
+// the typed constants in registry_consts.go from a fixture.
+//
+//	genregistry fixture -report generated -version 1.21.4 -out reports/1.21.4.json
+//	genregistry consts -fixture reports/1.21.4.json -out registry_consts.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"minecraftgo/commands"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// order matters, later kinds get a suffix when their name is already taken
+var constKinds = []struct {
+	kind   commands.RegistryKind
+	goType string
+	suffix string
+	// keep every dotted part of the id, entity.creeper.primed is EntityCreeperPrimed
+	fullName bool
+}{
+	{commands.EntityTypes, "Mob", "Mob", false},
+	{commands.Attributes, "AttributeName", "Attribute", false},
+	{commands.Effects, "Effect", "Effect", false},
+	{commands.Enchantments, "Enchantment", "Enchantment", false},
+	{commands.Blocks, "Block", "Block", false},
+	{commands.Items, "Item", "Item", false},
+	{commands.Sounds, "Sound", "Sound", true},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: genregistry fixture|consts [flags]")
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "fixture":
+		err = importFixture(os.Args[2:])
+	case "consts":
+		err = generateConsts(os.Args[2:])
+	default:
+		err = fmt.Errorf("unknown mode %q", os.Args[1])
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "genregistry:", err)
+		os.Exit(1)
+	}
+}
+
+func importFixture(args []string) error {
+	fs := flag.NewFlagSet("fixture", flag.ExitOnError)
+	report := fs.String("report", "generated", "directory written by the server's --reports flag")
+	version := fs.String("version", "", "game version of the report")
+	out := fs.String("out", "", "fixture file to write")
+	fs.Parse(args)
+	if *version == "" || *out == "" {
+		return fmt.Errorf("-version and -out are required")
+	}
+
+	fixture, err := commands.ReadReport(*report, *version)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(fixture, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, append(data, '\n'), 0644)
+}
+
+func generateConsts(args []string) error {
+	fs := flag.NewFlagSet("consts", flag.ExitOnError)
+	fixturePath := fs.String("fixture", "", "fixture to generate from")
+	out := fs.String("out", "registry_consts.go", "go file to write")
+	fs.Parse(args)
+
+	data, err := os.ReadFile(*fixturePath)
+	if err != nil {
+		return err
+	}
+	var fixture commands.RegistryFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return err
+	}
+
+	taken, err := packageIdentifiers(filepath.Dir(*out), filepath.Base(*out))
+	if err != nil {
+		return err
+	}
+	for _, k := range constKinds {
+		taken[k.goType] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genregistry from %s; DO NOT EDIT.\n\npackage commands\n", filepath.ToSlash(*fixturePath))
+	for _, k := range constKinds {
+		fmt.Fprintf(&buf, "\ntype %s string\n\nconst (\n", k.goType)
+		for _, id := range fixture.Registries[k.kind] {
+			value := strings.TrimPrefix(id, "minecraft:")
+			name := constName(value, k.fullName)
+			for taken[name] {
+				name += k.suffix
+			}
+			taken[name] = true
+			fmt.Fprintf(&buf, "\t%s %s = %q\n", name, k.goType, value)
+		}
+		buf.WriteString(")\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0644)
+}
+
+// generic.movement_speed and movement_speed both become MovementSpeed
+func constName(value string, fullName bool) string {
+	if fullName {
+		value = strings.ReplaceAll(value, ".", "_")
+	} else if idx := strings.LastIndex(value, "."); idx >= 0 {
+		value = value[idx+1:]
+	}
+	var sb strings.Builder
+	for _, part := range strings.Split(value, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+func packageIdentifiers(dir string, skip string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return fi.Name() != skip && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv == nil {
+						names[d.Name.Name] = true
+					}
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							names[s.Name.Name] = true
+						case *ast.ValueSpec:
+							for _, n := range s.Names {
+								names[n.Name] = true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
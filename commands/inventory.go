@@ -0,0 +1,226 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"minecraftgo/wrapper"
+	"strings"
+)
+
+var ErrNoEntityData = errors.New("no entity data in response")
+
+// ErrNoElements means the entity is there but has nothing at the path, like
+// an empty hand. It wraps ErrNoEntityData.
+var ErrNoElements = fmt.Errorf("%w, nothing at that path", ErrNoEntityData)
+
+type ItemStack struct {
+	Slot  int
+	ID    string
+	Count int
+	// 1.20.5+ item components, nil on older servers
+	Components Compound
+	// pre-1.20.5 item tag, nil on newer servers
+	Tag Compound
+	// read in the pre-1.20.5 shape, with Count and tag, and written back in it
+	Legacy bool
+}
+
+// Argument formats the stack the way /give and /item replace expect it,
+// including components or tag so enchantments and names survive
+func (is ItemStack) Argument() string {
+	if len(is.Components) > 0 {
+		parts := make([]string, 0, len(is.Components))
+		for _, k := range sortedKeys(is.Components) {
+			parts = append(parts, fmt.Sprintf("%s=%s", k, EncodeSNBT(is.Components[k])))
+		}
+		return fmt.Sprintf("%s[%s]", is.ID, strings.Join(parts, ","))
+	}
+	if len(is.Tag) > 0 {
+		return is.ID + EncodeSNBT(is.Tag)
+	}
+	return is.ID
+}
+
+// SNBT returns the stack as an item compound, suitable for summoning an item entity
+func (is ItemStack) SNBT() string {
+	c := Compound{"id": is.ID}
+	if is.Legacy || is.Tag != nil {
+		// older servers read a missing Count as an empty stack
+		c["Count"] = Literal(fmt.Sprintf("%db", is.Count))
+		if len(is.Tag) > 0 {
+			c["tag"] = is.Tag
+		}
+	} else {
+		c["count"] = Literal(fmt.Sprint(is.Count))
+		if len(is.Components) > 0 {
+			c["components"] = is.Components
+		}
+	}
+	return EncodeSNBT(c)
+}
+
+func itemStackFromCompound(c Compound) ItemStack {
+	is := ItemStack{ID: c.String("id"), Components: c.Compound("components"), Tag: c.Compound("tag")}
+	is.Slot, _ = c.Int("Slot")
+	if count, ok := c.Int("count"); ok {
+		is.Count = count
+	} else if count, ok := c.Int("Count"); ok {
+		is.Count = count
+		is.Legacy = true
+	} else {
+		is.Count = 1
+	}
+	return is
+}
+
+// SlotName maps an Inventory slot number to the slot argument used by /item
+func SlotName(slot int) string {
+	switch {
+	case slot >= 0 && slot <= 8:
+		return fmt.Sprintf("hotbar.%d", slot)
+	case slot >= 9 && slot <= 35:
+		return fmt.Sprintf("inventory.%d", slot-9)
+	case slot == 100:
+		return "armor.feet"
+	case slot == 101:
+		return "armor.legs"
+	case slot == 102:
+		return "armor.chest"
+	case slot == 103:
+		return "armor.head"
+	case slot == -106:
+		return "weapon.offhand"
+	}
+	return ""
+}
+
+func getEntityData(wpr *wrapper.Wrapper, player_name string, path string) (any, error) {
+	cmd := fmt.Sprintf("/data get entity %s %s", player_name, path)
+	fmt.Println("Running command --> ", cmd)
+	res := wpr.SendCommand(cmd)
+	fmt.Println("Response to command <--", res)
+
+	idx := strings.Index(res, "entity data: ")
+	if idx < 0 {
+		// the server's answer when the player is there but the path isn't
+		if strings.Contains(res, "Found no elements matching") {
+			return nil, fmt.Errorf("%w: %s", ErrNoElements, strings.TrimSpace(res))
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNoEntityData, strings.TrimSpace(res))
+	}
+	return ParseSNBT(strings.TrimSpace(res[idx+len("entity data: "):]))
+}
+
+func GetInventory(wpr *wrapper.Wrapper, player_name string) ([]ItemStack, error) {
+	data, err := getEntityData(wpr, player_name, "Inventory")
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := data.(List)
+	if !ok {
+		return nil, fmt.Errorf("unexpected inventory data %T", data)
+	}
+
+	items := make([]ItemStack, 0, len(list))
+	for _, entry := range list {
+		if c, ok := entry.(Compound); ok {
+			items = append(items, itemStackFromCompound(c))
+		}
+	}
+	return items, nil
+}
+
+// returns nil when the player is not holding anything, an offline server or
+// player is an error
+func GetSelectedItem(wpr *wrapper.Wrapper, player_name string) (*ItemStack, error) {
+	data, err := getEntityData(wpr, player_name, "SelectedItem")
+	if errors.Is(err, ErrNoElements) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := data.(Compound)
+	if !ok {
+		return nil, fmt.Errorf("unexpected selected item data %T", data)
+	}
+	is := itemStackFromCompound(c)
+	return &is, nil
+}
+
+// item may be empty to match everything and may be a tag or carry a predicate,
+// e.g. "#minecraft:logs" or "minecraft:diamond_sword[minecraft:damage=0]".
+// A negative maxCount clears every matching item.
+func ClearInventory(wpr *wrapper.Wrapper, player_name string, item string, maxCount int) error {
+	cmd := fmt.Sprintf("/clear %s", player_name)
+	if item != "" {
+		cmd = fmt.Sprintf("%s %s", cmd, item)
+		if maxCount >= 0 {
+			cmd = fmt.Sprintf("%s %d", cmd, maxCount)
+		}
+	}
+	return run(wpr, cmd)
+}
+
+func ReplaceItem(wpr *wrapper.Wrapper, player_name string, slot string, item string, count int) error {
+	if err := DialectFor(wpr).require("1.17", "item command"); err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("/item replace entity %s %s with %s %d", player_name, slot, item, count)
+	return run(wpr, cmd)
+}
+
+func ModifyItem(wpr *wrapper.Wrapper, player_name string, slot string, modifier string) error {
+	if err := DialectFor(wpr).require("1.17", "item command"); err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("/item modify entity %s %s %s", player_name, slot, modifier)
+	return run(wpr, cmd)
+}
+
+// shuffles the items in the player's hotbar, including empty slots
+func SwapHotbar(wpr *wrapper.Wrapper, player_name string) error {
+	items, err := GetInventory(wpr, player_name)
+	if err != nil {
+		return err
+	}
+
+	hotbar := make([]*ItemStack, 9)
+	for idx := range items {
+		if items[idx].Slot >= 0 && items[idx].Slot <= 8 {
+			hotbar[items[idx].Slot] = &items[idx]
+		}
+	}
+	rand.Shuffle(len(hotbar), func(i, j int) { hotbar[i], hotbar[j] = hotbar[j], hotbar[i] })
+
+	for slot, item := range hotbar {
+		if item == nil {
+			err = ReplaceItem(wpr, player_name, SlotName(slot), "minecraft:air", 1)
+		} else {
+			err = ReplaceItem(wpr, player_name, SlotName(slot), item.Argument(), item.Count)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// throws whatever the player is holding on the ground in front of them
+func DropHeldItem(wpr *wrapper.Wrapper, player_name string) error {
+	item, err := GetSelectedItem(wpr, player_name)
+	if err != nil || item == nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("/execute at %s run summon minecraft:item ^ ^1.5 ^1 {PickupDelay:40s,Item:%s}", player_name, item.SNBT())
+	if err := run(wpr, cmd); err != nil {
+		return err
+	}
+	return ReplaceItem(wpr, player_name, "weapon.mainhand", "minecraft:air", 1)
+}
@@ -0,0 +1,28 @@
+package commands
+
+import "testing"
+
+func TestItemStackSNBTRoundTrip(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		// 1.20.4 stacks without a tag still need their Count
+		{`{Slot:0b,id:"minecraft:dirt",Count:64b}`, `{Count: 64b, id: "minecraft:dirt"}`},
+		{`{Slot:1b,id:"minecraft:diamond_sword",Count:1b,tag:{Damage:0}}`, `{Count: 1b, id: "minecraft:diamond_sword", tag: {Damage: 0}}`},
+		{`{Slot:2b,id:"minecraft:dirt",count:64}`, `{count: 64, id: "minecraft:dirt"}`},
+	}
+	for _, test := range tests {
+		data, err := ParseSNBT(test.in)
+		if err != nil {
+			t.Fatal(test.in, err)
+		}
+		c, ok := data.(Compound)
+		if !ok {
+			t.Fatalf("%s parsed to %T", test.in, data)
+		}
+		if got := itemStackFromCompound(c).SNBT(); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.in, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package commands
+
+import (
+	"archive/zip"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ./internal/genregistry consts -fixture reports/1.21.4.json -out registry_consts.go
+
+// Registry fixtures are the registries of the server's data report that
+// commands check ids against. A server newer than every fixture is checked
+// against the newest one, or against its own report, see ServerRegistry. To
+// add a version, run the report from the server jar and import it:
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --reports --server
+//	go run ./internal/genregistry fixture -report generated -version 1.21.4 -out reports/1.21.4.json
+//
+//go:embed reports/*.json
+var reportFS embed.FS
+
+type RegistryKind string
+
+const (
+	EntityTypes  RegistryKind = "minecraft:entity_type"
+	Items        RegistryKind = "minecraft:item"
+	Blocks       RegistryKind = "minecraft:block"
+	Effects      RegistryKind = "minecraft:mob_effect"
+	Enchantments RegistryKind = "minecraft:enchantment"
+	Attributes   RegistryKind = "minecraft:attribute"
+	Sounds       RegistryKind = "minecraft:sound_event"
+)
+
+var (
+	ErrUnknownEntry        = errors.New("unknown registry entry")
+	ErrRegistryUnavailable = errors.New("registry not available for this version")
+)
+
+type RegistryFixture struct {
+	Version    string                    `json:"version"`
+	Registries map[RegistryKind][]string `json:"registries"`
+}
+
+type Registry struct {
+	Version string
+	entries map[RegistryKind]map[string]bool
+}
+
+func newRegistry(fixture RegistryFixture) *Registry {
+	r := &Registry{Version: fixture.Version, entries: map[RegistryKind]map[string]bool{}}
+	for kind, ids := range fixture.Registries {
+		set := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			set[id] = true
+		}
+		r.entries[kind] = set
+	}
+	return r
+}
+
+// LoadRegistry returns the newest checked in registry that is not newer than version
+func LoadRegistry(version string) (*Registry, error) {
+	files, err := reportFS.ReadDir("reports")
+	if err != nil {
+		return nil, err
+	}
+
+	best := ""
+	for _, f := range files {
+		v := strings.TrimSuffix(f.Name(), ".json")
+		if compareVersions(v, version) <= 0 && (best == "" || compareVersions(v, best) > 0) {
+			best = v
+		}
+	}
+	if best == "" {
+		return nil, fmt.Errorf("%w: no registry for %s", ErrRegistryUnavailable, version)
+	}
+
+	data, err := reportFS.ReadFile("reports/" + best + ".json")
+	if err != nil {
+		return nil, err
+	}
+	var fixture RegistryFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("registry %s: %w", best, err)
+	}
+	return newRegistry(fixture), nil
+}
+
+// LoadReport reads a full data report as written by the server's --reports flag,
+// for servers newer than the checked in fixtures
+func LoadReport(dir string, version string) (*Registry, error) {
+	fixture, err := ReadReport(dir, version)
+	if err != nil {
+		return nil, err
+	}
+	return newRegistry(*fixture), nil
+}
+
+func ReadReport(dir string, version string) (*RegistryFixture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "reports", "registries.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var report map[RegistryKind]struct {
+		Entries map[string]json.RawMessage `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("registries.json: %w", err)
+	}
+
+	fixture := &RegistryFixture{Version: version, Registries: map[RegistryKind][]string{}}
+	for _, kind := range []RegistryKind{EntityTypes, Items, Blocks, Effects, Enchantments, Attributes, Sounds} {
+		reg, ok := report[kind]
+		if !ok {
+			continue
+		}
+		ids := make([]string, 0, len(reg.Entries))
+		for id := range reg.Entries {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		fixture.Registries[kind] = ids
+	}
+
+	// since 1.21 enchantments are data driven and only show up in the data pack
+	if _, ok := fixture.Registries[Enchantments]; !ok {
+		files, err := filepath.Glob(filepath.Join(dir, "data", "minecraft", "enchantment", "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(files))
+		for _, f := range files {
+			ids = append(ids, "minecraft:"+strings.TrimSuffix(filepath.Base(f), ".json"))
+		}
+		sort.Strings(ids)
+		fixture.Registries[Enchantments] = ids
+	}
+
+	return fixture, nil
+}
+
+// ServerRegistry loads every registry of the server in jar from its data
+// report, generated into dir/<version> the first time. That takes a while, so
+// it's done once per version and before the server runs.
+func ServerRegistry(jar string, dir string) (*Registry, error) {
+	version, err := JarVersion(jar)
+	if err != nil {
+		return nil, err
+	}
+	out := filepath.Join(dir, version)
+	if _, err := os.Stat(filepath.Join(out, "reports", "registries.json")); err != nil {
+		fmt.Println("Generating the data report of", jar, "into", out)
+		cmd := exec.Command("java", "-DbundlerMainClass=net.minecraft.data.Main", "-jar", jar, "--reports", "--server", "--output", out)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return nil, fmt.Errorf("data report of %s: %w: %s", jar, err, lastLine(output))
+		}
+	}
+	return LoadReport(out, version)
+}
+
+// JarVersion reads the game version from version.json in a server jar
+func JarVersion(jar string) (string, error) {
+	r, err := zip.OpenReader(jar)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	f, err := r.Open("version.json")
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", jar, err)
+	}
+	defer f.Close()
+	var info struct {
+		Id string `json:"id"`
+	}
+	if err := json.NewDecoder(f).Decode(&info); err != nil {
+		return "", fmt.Errorf("%s version.json: %w", jar, err)
+	}
+	if info.Id == "" {
+		return "", fmt.Errorf("%s version.json has no id", jar)
+	}
+	return info.Id, nil
+}
+
+func lastLine(output []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	return lines[len(lines)-1]
+}
+
+func (r *Registry) Has(kind RegistryKind, id string) bool {
+	return r.entries[kind][namespaced(id)]
+}
+
+func (r *Registry) Entries(kind RegistryKind) []string {
+	ids := make([]string, 0, len(r.entries[kind]))
+	for id := range r.entries[kind] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (r *Registry) Validate(kind RegistryKind, id string) error {
+	set, ok := r.entries[kind]
+	if !ok {
+		return fmt.Errorf("%w: %s in %s", ErrRegistryUnavailable, kind, r.Version)
+	}
+	if !set[namespaced(id)] {
+		return fmt.Errorf("%w: %s is not a %s in %s", ErrUnknownEntry, id, kind, r.Version)
+	}
+	return nil
+}
+
+func (r *Registry) ValidateMob(mob Mob) error {
+	return r.Validate(EntityTypes, string(mob))
+}
+
+func (r *Registry) ValidateEffect(effect Effect) error {
+	return r.Validate(Effects, string(effect))
+}
+
+func (r *Registry) ValidateEnchantment(enchantment Enchantment) error {
+	return r.Validate(Enchantments, string(enchantment))
+}
+
+func (r *Registry) ValidateAttribute(attribute AttributeName) error {
+	return r.Validate(Attributes, string(attribute))
+}
+
+// items can carry components or a tag, only the id part is checked
+func (r *Registry) ValidateItem(item string) error {
+	if idx := strings.IndexAny(item, "[{"); idx >= 0 {
+		item = item[:idx]
+	}
+	return r.Validate(Items, item)
+}
+
+func (r *Registry) ValidateBlock(block string) error {
+	if idx := strings.IndexAny(block, "[{"); idx >= 0 {
+		block = block[:idx]
+	}
+	return r.Validate(Blocks, block)
+}
+
+func (r *Registry) ValidateSound(sound string) error {
+	return r.Validate(Sounds, sound)
+}
+
+func namespaced(id string) string {
+	if strings.Contains(id, ":") {
+		return id
+	}
+	return "minecraft:" + id
+}
+
+// compares dotted release versions, e.g. 1.20.4 < 1.21
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for idx := 0; idx < len(as) || idx < len(bs); idx++ {
+		var an, bn int
+		if idx < len(as) {
+			an, _ = strconv.Atoi(as[idx])
+		}
+		if idx < len(bs) {
+			bn, _ = strconv.Atoi(bs[idx])
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
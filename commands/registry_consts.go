@@ -0,0 +1,4426 @@
+// Code generated by genregistry from reports/1.21.4.json; DO NOT EDIT.
+
+package commands
+
+type Mob string
+
+const (
+	AcaciaBoat           Mob = "acacia_boat"
+	AcaciaChestBoat      Mob = "acacia_chest_boat"
+	Allay                Mob = "allay"
+	AreaEffectCloud      Mob = "area_effect_cloud"
+	Armadillo            Mob = "armadillo"
+	ArmorStand           Mob = "armor_stand"
+	Arrow                Mob = "arrow"
+	Axolotl              Mob = "axolotl"
+	BambooChestRaft      Mob = "bamboo_chest_raft"
+	BambooRaft           Mob = "bamboo_raft"
+	Bat                  Mob = "bat"
+	Bee                  Mob = "bee"
+	BirchBoat            Mob = "birch_boat"
+	BirchChestBoat       Mob = "birch_chest_boat"
+	Blaze                Mob = "blaze"
+	BlockDisplay         Mob = "block_display"
+	Bogged               Mob = "bogged"
+	Breeze               Mob = "breeze"
+	BreezeWindCharge     Mob = "breeze_wind_charge"
+	Camel                Mob = "camel"
+	Cat                  Mob = "cat"
+	CaveSpider           Mob = "cave_spider"
+	CherryBoat           Mob = "cherry_boat"
+	CherryChestBoat      Mob = "cherry_chest_boat"
+	ChestMinecart        Mob = "chest_minecart"
+	Chicken              Mob = "chicken"
+	Cod                  Mob = "cod"
+	CommandBlockMinecart Mob = "command_block_minecart"
+	Cow                  Mob = "cow"
+	Creaking             Mob = "creaking"
+	Creeper              Mob = "creeper"
+	DarkOakBoat          Mob = "dark_oak_boat"
+	DarkOakChestBoat     Mob = "dark_oak_chest_boat"
+	Dolphin              Mob = "dolphin"
+	Donkey               Mob = "donkey"
+	DragonFireball       Mob = "dragon_fireball"
+	Drowned              Mob = "drowned"
+	Egg                  Mob = "egg"
+	ElderGuardian        Mob = "elder_guardian"
+	EndCrystal           Mob = "end_crystal"
+	EnderDragon          Mob = "ender_dragon"
+	EnderPearl           Mob = "ender_pearl"
+	Enderman             Mob = "enderman"
+	Endermite            Mob = "endermite"
+	Evoker               Mob = "evoker"
+	EvokerFangs          Mob = "evoker_fangs"
+	ExperienceBottle     Mob = "experience_bottle"
+	ExperienceOrb        Mob = "experience_orb"
+	EyeOfEnder           Mob = "eye_of_ender"
+	FallingBlock         Mob = "falling_block"
+	Fireball             Mob = "fireball"
+	FireworkRocket       Mob = "firework_rocket"
+	FishingBobber        Mob = "fishing_bobber"
+	Fox                  Mob = "fox"
+	Frog                 Mob = "frog"
+	FurnaceMinecart      Mob = "furnace_minecart"
+	Ghast                Mob = "ghast"
+	Giant                Mob = "giant"
+	GlowItemFrame        Mob = "glow_item_frame"
+	GlowSquid            Mob = "glow_squid"
+	Goat                 Mob = "goat"
+	Guardian             Mob = "guardian"
+	Hoglin               Mob = "hoglin"
+	HopperMinecart       Mob = "hopper_minecart"
+	Horse                Mob = "horse"
+	Husk                 Mob = "husk"
+	Illusioner           Mob = "illusioner"
+	Interaction          Mob = "interaction"
+	IronGolem            Mob = "iron_golem"
+	ItemMob              Mob = "item"
+	ItemDisplay          Mob = "item_display"
+	ItemFrame            Mob = "item_frame"
+	JungleBoat           Mob = "jungle_boat"
+	JungleChestBoat      Mob = "jungle_chest_boat"
+	LeashKnot            Mob = "leash_knot"
+	LightningBolt        Mob = "lightning_bolt"
+	Llama                Mob = "llama"
+	LlamaSpit            Mob = "llama_spit"
+	MagmaCube            Mob = "magma_cube"
+	MangroveBoat         Mob = "mangrove_boat"
+	MangroveChestBoat    Mob = "mangrove_chest_boat"
+	Marker               Mob = "marker"
+	Minecart             Mob = "minecart"
+	Mooshroom            Mob = "mooshroom"
+	Mule                 Mob = "mule"
+	OakBoat              Mob = "oak_boat"
+	OakChestBoat         Mob = "oak_chest_boat"
+	Ocelot               Mob = "ocelot"
+	OminousItemSpawner   Mob = "ominous_item_spawner"
+	Painting             Mob = "painting"
+	PaleOakBoat          Mob = "pale_oak_boat"
+	PaleOakChestBoat     Mob = "pale_oak_chest_boat"
+	Panda                Mob = "panda"
+	Parrot               Mob = "parrot"
+	Phantom              Mob = "phantom"
+	Pig                  Mob = "pig"
+	Piglin               Mob = "piglin"
+	PiglinBrute          Mob = "piglin_brute"
+	Pillager             Mob = "pillager"
+	Player               Mob = "player"
+	PolarBear            Mob = "polar_bear"
+	Potion               Mob = "potion"
+	Pufferfish           Mob = "pufferfish"
+	Rabbit               Mob = "rabbit"
+	Ravager              Mob = "ravager"
+	Salmon               Mob = "salmon"
+	Sheep                Mob = "sheep"
+	Shulker              Mob = "shulker"
+	ShulkerBullet        Mob = "shulker_bullet"
+	Silverfish           Mob = "silverfish"
+	Skeleton             Mob = "skeleton"
+	SkeletonHorse        Mob = "skeleton_horse"
+	Slime                Mob = "slime"
+	SmallFireball        Mob = "small_fireball"
+	Sniffer              Mob = "sniffer"
+	SnowGolem            Mob = "snow_golem"
+	Snowball             Mob = "snowball"
+	SpawnerMinecart      Mob = "spawner_minecart"
+	SpectralArrow        Mob = "spectral_arrow"
+	Spider               Mob = "spider"
+	SpruceBoat           Mob = "spruce_boat"
+	SpruceChestBoat      Mob = "spruce_chest_boat"
+	Squid                Mob = "squid"
+	Stray                Mob = "stray"
+	Strider              Mob = "strider"
+	Tadpole              Mob = "tadpole"
+	TextDisplay          Mob = "text_display"
+	Tnt                  Mob = "tnt"
+	TntMinecart          Mob = "tnt_minecart"
+	TraderLlama          Mob = "trader_llama"
+	Trident              Mob = "trident"
+	TropicalFish         Mob = "tropical_fish"
+	Turtle               Mob = "turtle"
+	Vex                  Mob = "vex"
+	Villager             Mob = "villager"
+	Vindicator           Mob = "vindicator"
+	WanderingTrader      Mob = "wandering_trader"
+	Warden               Mob = "warden"
+	WindCharge           Mob = "wind_charge"
+	Witch                Mob = "witch"
+	Wither               Mob = "wither"
+	WitherSkeleton       Mob = "wither_skeleton"
+	WitherSkull          Mob = "wither_skull"
+	Wolf                 Mob = "wolf"
+	Zoglin               Mob = "zoglin"
+	Zombie               Mob = "zombie"
+	ZombieHorse          Mob = "zombie_horse"
+	ZombieVillager       Mob = "zombie_villager"
+	ZombifiedPiglin      Mob = "zombified_piglin"
+)
+
+type AttributeName string
+
+const (
+	Armor                        AttributeName = "armor"
+	ArmorToughness               AttributeName = "armor_toughness"
+	AttackDamage                 AttributeName = "attack_damage"
+	AttackKnockback              AttributeName = "attack_knockback"
+	AttackSpeed                  AttributeName = "attack_speed"
+	BlockBreakSpeed              AttributeName = "block_break_speed"
+	BlockInteractionRange        AttributeName = "block_interaction_range"
+	BurningTime                  AttributeName = "burning_time"
+	EntityInteractionRange       AttributeName = "entity_interaction_range"
+	ExplosionKnockbackResistance AttributeName = "explosion_knockback_resistance"
+	FallDamageMultiplier         AttributeName = "fall_damage_multiplier"
+	FlyingSpeed                  AttributeName = "flying_speed"
+	FollowRange                  AttributeName = "follow_range"
+	Gravity                      AttributeName = "gravity"
+	JumpStrength                 AttributeName = "jump_strength"
+	KnockbackResistance          AttributeName = "knockback_resistance"
+	Luck                         AttributeName = "luck"
+	MaxAbsorption                AttributeName = "max_absorption"
+	MaxHealth                    AttributeName = "max_health"
+	MiningEfficiency             AttributeName = "mining_efficiency"
+	MovementEfficiency           AttributeName = "movement_efficiency"
+	MovementSpeed                AttributeName = "movement_speed"
+	OxygenBonus                  AttributeName = "oxygen_bonus"
+	SafeFallDistance             AttributeName = "safe_fall_distance"
+	Scale                        AttributeName = "scale"
+	SneakingSpeed                AttributeName = "sneaking_speed"
+	SpawnReinforcements          AttributeName = "spawn_reinforcements"
+	StepHeight                   AttributeName = "step_height"
+	SubmergedMiningSpeed         AttributeName = "submerged_mining_speed"
+	SweepingDamageRatio          AttributeName = "sweeping_damage_ratio"
+	TemptRange                   AttributeName = "tempt_range"
+	WaterMovementEfficiency      AttributeName = "water_movement_efficiency"
+)
+
+type Effect string
+
+const (
+	Absorption       Effect = "absorption"
+	BadOmen          Effect = "bad_omen"
+	Blindness        Effect = "blindness"
+	ConduitPower     Effect = "conduit_power"
+	Darkness         Effect = "darkness"
+	DolphinsGrace    Effect = "dolphins_grace"
+	FireResistance   Effect = "fire_resistance"
+	Glowing          Effect = "glowing"
+	Haste            Effect = "haste"
+	HealthBoost      Effect = "health_boost"
+	HeroOfTheVillage Effect = "hero_of_the_village"
+	Hunger           Effect = "hunger"
+	Infested         Effect = "infested"
+	InstantDamage    Effect = "instant_damage"
+	InstantHealth    Effect = "instant_health"
+	Invisibility     Effect = "invisibility"
+	JumpBoost        Effect = "jump_boost"
+	Levitation       Effect = "levitation"
+	LuckEffect       Effect = "luck"
+	MiningFatigue    Effect = "mining_fatigue"
+	Nausea           Effect = "nausea"
+	NightVision      Effect = "night_vision"
+	Oozing           Effect = "oozing"
+	Poison           Effect = "poison"
+	RaidOmen         Effect = "raid_omen"
+	Regeneration     Effect = "regeneration"
+	Resistance       Effect = "resistance"
+	Saturation       Effect = "saturation"
+	SlowFalling      Effect = "slow_falling"
+	Slowness         Effect = "slowness"
+	Speed            Effect = "speed"
+	Strength         Effect = "strength"
+	TrialOmen        Effect = "trial_omen"
+	Unluck           Effect = "unluck"
+	WaterBreathing   Effect = "water_breathing"
+	Weakness         Effect = "weakness"
+	Weaving          Effect = "weaving"
+	WindCharged      Effect = "wind_charged"
+	WitherEffect     Effect = "wither"
+)
+
+type Enchantment string
+
+const (
+	AquaAffinity         Enchantment = "aqua_affinity"
+	BaneOfArthropods     Enchantment = "bane_of_arthropods"
+	BindingCurse         Enchantment = "binding_curse"
+	BlastProtection      Enchantment = "blast_protection"
+	Breach               Enchantment = "breach"
+	Channeling           Enchantment = "channeling"
+	Density              Enchantment = "density"
+	DepthStrider         Enchantment = "depth_strider"
+	Efficiency           Enchantment = "efficiency"
+	FeatherFalling       Enchantment = "feather_falling"
+	FireAspect           Enchantment = "fire_aspect"
+	FireProtection       Enchantment = "fire_protection"
+	Flame                Enchantment = "flame"
+	Fortune              Enchantment = "fortune"
+	FrostWalker          Enchantment = "frost_walker"
+	Impaling             Enchantment = "impaling"
+	Infinity             Enchantment = "infinity"
+	Knockback            Enchantment = "knockback"
+	Looting              Enchantment = "looting"
+	Loyalty              Enchantment = "loyalty"
+	LuckOfTheSea         Enchantment = "luck_of_the_sea"
+	Lure                 Enchantment = "lure"
+	Mending              Enchantment = "mending"
+	Multishot            Enchantment = "multishot"
+	Piercing             Enchantment = "piercing"
+	Power                Enchantment = "power"
+	ProjectileProtection Enchantment = "projectile_protection"
+	Protection           Enchantment = "protection"
+	Punch                Enchantment = "punch"
+	QuickCharge          Enchantment = "quick_charge"
+	Respiration          Enchantment = "respiration"
+	Riptide              Enchantment = "riptide"
+	Sharpness            Enchantment = "sharpness"
+	SilkTouch            Enchantment = "silk_touch"
+	Smite                Enchantment = "smite"
+	SoulSpeed            Enchantment = "soul_speed"
+	SweepingEdge         Enchantment = "sweeping_edge"
+	SwiftSneak           Enchantment = "swift_sneak"
+	Thorns               Enchantment = "thorns"
+	Unbreaking           Enchantment = "unbreaking"
+	VanishingCurse       Enchantment = "vanishing_curse"
+	WindBurst            Enchantment = "wind_burst"
+)
+
+type Block string
+
+const (
+	AcaciaButton                    Block = "acacia_button"
+	AcaciaDoor                      Block = "acacia_door"
+	AcaciaFence                     Block = "acacia_fence"
+	AcaciaFenceGate                 Block = "acacia_fence_gate"
+	AcaciaHangingSign               Block = "acacia_hanging_sign"
+	AcaciaLeaves                    Block = "acacia_leaves"
+	AcaciaLog                       Block = "acacia_log"
+	AcaciaPlanks                    Block = "acacia_planks"
+	AcaciaPressurePlate             Block = "acacia_pressure_plate"
+	AcaciaSapling                   Block = "acacia_sapling"
+	AcaciaSign                      Block = "acacia_sign"
+	AcaciaSlab                      Block = "acacia_slab"
+	AcaciaStairs                    Block = "acacia_stairs"
+	AcaciaTrapdoor                  Block = "acacia_trapdoor"
+	AcaciaWallHangingSign           Block = "acacia_wall_hanging_sign"
+	AcaciaWallSign                  Block = "acacia_wall_sign"
+	AcaciaWood                      Block = "acacia_wood"
+	ActivatorRail                   Block = "activator_rail"
+	Air                             Block = "air"
+	Allium                          Block = "allium"
+	AmethystBlock                   Block = "amethyst_block"
+	AmethystCluster                 Block = "amethyst_cluster"
+	AncientDebris                   Block = "ancient_debris"
+	Andesite                        Block = "andesite"
+	AndesiteSlab                    Block = "andesite_slab"
+	AndesiteStairs                  Block = "andesite_stairs"
+	AndesiteWall                    Block = "andesite_wall"
+	Anvil                           Block = "anvil"
+	AttachedMelonStem               Block = "attached_melon_stem"
+	AttachedPumpkinStem             Block = "attached_pumpkin_stem"
+	Azalea                          Block = "azalea"
+	AzaleaLeaves                    Block = "azalea_leaves"
+	AzureBluet                      Block = "azure_bluet"
+	Bamboo                          Block = "bamboo"
+	BambooBlock                     Block = "bamboo_block"
+	BambooButton                    Block = "bamboo_button"
+	BambooDoor                      Block = "bamboo_door"
+	BambooFence                     Block = "bamboo_fence"
+	BambooFenceGate                 Block = "bamboo_fence_gate"
+	BambooHangingSign               Block = "bamboo_hanging_sign"
+	BambooMosaic                    Block = "bamboo_mosaic"
+	BambooMosaicSlab                Block = "bamboo_mosaic_slab"
+	BambooMosaicStairs              Block = "bamboo_mosaic_stairs"
+	BambooPlanks                    Block = "bamboo_planks"
+	BambooPressurePlate             Block = "bamboo_pressure_plate"
+	BambooSapling                   Block = "bamboo_sapling"
+	BambooSign                      Block = "bamboo_sign"
+	BambooSlab                      Block = "bamboo_slab"
+	BambooStairs                    Block = "bamboo_stairs"
+	BambooTrapdoor                  Block = "bamboo_trapdoor"
+	BambooWallHangingSign           Block = "bamboo_wall_hanging_sign"
+	BambooWallSign                  Block = "bamboo_wall_sign"
+	Barrel                          Block = "barrel"
+	Barrier                         Block = "barrier"
+	Basalt                          Block = "basalt"
+	Beacon                          Block = "beacon"
+	Bedrock                         Block = "bedrock"
+	BeeNest                         Block = "bee_nest"
+	Beehive                         Block = "beehive"
+	Beetroots                       Block = "beetroots"
+	Bell                            Block = "bell"
+	BigDripleaf                     Block = "big_dripleaf"
+	BigDripleafStem                 Block = "big_dripleaf_stem"
+	BirchButton                     Block = "birch_button"
+	BirchDoor                       Block = "birch_door"
+	BirchFence                      Block = "birch_fence"
+	BirchFenceGate                  Block = "birch_fence_gate"
+	BirchHangingSign                Block = "birch_hanging_sign"
+	BirchLeaves                     Block = "birch_leaves"
+	BirchLog                        Block = "birch_log"
+	BirchPlanks                     Block = "birch_planks"
+	BirchPressurePlate              Block = "birch_pressure_plate"
+	BirchSapling                    Block = "birch_sapling"
+	BirchSign                       Block = "birch_sign"
+	BirchSlab                       Block = "birch_slab"
+	BirchStairs                     Block = "birch_stairs"
+	BirchTrapdoor                   Block = "birch_trapdoor"
+	BirchWallHangingSign            Block = "birch_wall_hanging_sign"
+	BirchWallSign                   Block = "birch_wall_sign"
+	BirchWood                       Block = "birch_wood"
+	BlackBanner                     Block = "black_banner"
+	BlackBed                        Block = "black_bed"
+	BlackCandle                     Block = "black_candle"
+	BlackCandleCake                 Block = "black_candle_cake"
+	BlackCarpet                     Block = "black_carpet"
+	BlackConcrete                   Block = "black_concrete"
+	BlackConcretePowder             Block = "black_concrete_powder"
+	BlackGlazedTerracotta           Block = "black_glazed_terracotta"
+	BlackShulkerBox                 Block = "black_shulker_box"
+	BlackStainedGlass               Block = "black_stained_glass"
+	BlackStainedGlassPane           Block = "black_stained_glass_pane"
+	BlackTerracotta                 Block = "black_terracotta"
+	BlackWallBanner                 Block = "black_wall_banner"
+	BlackWool                       Block = "black_wool"
+	Blackstone                      Block = "blackstone"
+	BlackstoneSlab                  Block = "blackstone_slab"
+	BlackstoneStairs                Block = "blackstone_stairs"
+	BlackstoneWall                  Block = "blackstone_wall"
+	BlastFurnace                    Block = "blast_furnace"
+	BlueBanner                      Block = "blue_banner"
+	BlueBed                         Block = "blue_bed"
+	BlueCandle                      Block = "blue_candle"
+	BlueCandleCake                  Block = "blue_candle_cake"
+	BlueCarpet                      Block = "blue_carpet"
+	BlueConcrete                    Block = "blue_concrete"
+	BlueConcretePowder              Block = "blue_concrete_powder"
+	BlueGlazedTerracotta            Block = "blue_glazed_terracotta"
+	BlueIce                         Block = "blue_ice"
+	BlueOrchid                      Block = "blue_orchid"
+	BlueShulkerBox                  Block = "blue_shulker_box"
+	BlueStainedGlass                Block = "blue_stained_glass"
+	BlueStainedGlassPane            Block = "blue_stained_glass_pane"
+	BlueTerracotta                  Block = "blue_terracotta"
+	BlueWallBanner                  Block = "blue_wall_banner"
+	BlueWool                        Block = "blue_wool"
+	BoneBlock                       Block = "bone_block"
+	Bookshelf                       Block = "bookshelf"
+	BrainCoral                      Block = "brain_coral"
+	BrainCoralBlock                 Block = "brain_coral_block"
+	BrainCoralFan                   Block = "brain_coral_fan"
+	BrainCoralWallFan               Block = "brain_coral_wall_fan"
+	BrewingStand                    Block = "brewing_stand"
+	BrickSlab                       Block = "brick_slab"
+	BrickStairs                     Block = "brick_stairs"
+	BrickWall                       Block = "brick_wall"
+	Bricks                          Block = "bricks"
+	BrownBanner                     Block = "brown_banner"
+	BrownBed                        Block = "brown_bed"
+	BrownCandle                     Block = "brown_candle"
+	BrownCandleCake                 Block = "brown_candle_cake"
+	BrownCarpet                     Block = "brown_carpet"
+	BrownConcrete                   Block = "brown_concrete"
+	BrownConcretePowder             Block = "brown_concrete_powder"
+	BrownGlazedTerracotta           Block = "brown_glazed_terracotta"
+	BrownMushroom                   Block = "brown_mushroom"
+	BrownMushroomBlock              Block = "brown_mushroom_block"
+	BrownShulkerBox                 Block = "brown_shulker_box"
+	BrownStainedGlass               Block = "brown_stained_glass"
+	BrownStainedGlassPane           Block = "brown_stained_glass_pane"
+	BrownTerracotta                 Block = "brown_terracotta"
+	BrownWallBanner                 Block = "brown_wall_banner"
+	BrownWool                       Block = "brown_wool"
+	BubbleColumn                    Block = "bubble_column"
+	BubbleCoral                     Block = "bubble_coral"
+	BubbleCoralBlock                Block = "bubble_coral_block"
+	BubbleCoralFan                  Block = "bubble_coral_fan"
+	BubbleCoralWallFan              Block = "bubble_coral_wall_fan"
+	BuddingAmethyst                 Block = "budding_amethyst"
+	Cactus                          Block = "cactus"
+	Cake                            Block = "cake"
+	Calcite                         Block = "calcite"
+	CalibratedSculkSensor           Block = "calibrated_sculk_sensor"
+	Campfire                        Block = "campfire"
+	Candle                          Block = "candle"
+	CandleCake                      Block = "candle_cake"
+	Carrots                         Block = "carrots"
+	CartographyTable                Block = "cartography_table"
+	CarvedPumpkin                   Block = "carved_pumpkin"
+	Cauldron                        Block = "cauldron"
+	CaveAir                         Block = "cave_air"
+	CaveVines                       Block = "cave_vines"
+	CaveVinesPlant                  Block = "cave_vines_plant"
+	Chain                           Block = "chain"
+	ChainCommandBlock               Block = "chain_command_block"
+	CherryButton                    Block = "cherry_button"
+	CherryDoor                      Block = "cherry_door"
+	CherryFence                     Block = "cherry_fence"
+	CherryFenceGate                 Block = "cherry_fence_gate"
+	CherryHangingSign               Block = "cherry_hanging_sign"
+	CherryLeaves                    Block = "cherry_leaves"
+	CherryLog                       Block = "cherry_log"
+	CherryPlanks                    Block = "cherry_planks"
+	CherryPressurePlate             Block = "cherry_pressure_plate"
+	CherrySapling                   Block = "cherry_sapling"
+	CherrySign                      Block = "cherry_sign"
+	CherrySlab                      Block = "cherry_slab"
+	CherryStairs                    Block = "cherry_stairs"
+	CherryTrapdoor                  Block = "cherry_trapdoor"
+	CherryWallHangingSign           Block = "cherry_wall_hanging_sign"
+	CherryWallSign                  Block = "cherry_wall_sign"
+	CherryWood                      Block = "cherry_wood"
+	Chest                           Block = "chest"
+	ChippedAnvil                    Block = "chipped_anvil"
+	ChiseledBookshelf               Block = "chiseled_bookshelf"
+	ChiseledCopper                  Block = "chiseled_copper"
+	ChiseledDeepslate               Block = "chiseled_deepslate"
+	ChiseledNetherBricks            Block = "chiseled_nether_bricks"
+	ChiseledPolishedBlackstone      Block = "chiseled_polished_blackstone"
+	ChiseledQuartzBlock             Block = "chiseled_quartz_block"
+	ChiseledRedSandstone            Block = "chiseled_red_sandstone"
+	ChiseledResinBricks             Block = "chiseled_resin_bricks"
+	ChiseledSandstone               Block = "chiseled_sandstone"
+	ChiseledStoneBricks             Block = "chiseled_stone_bricks"
+	ChiseledTuff                    Block = "chiseled_tuff"
+	ChiseledTuffBricks              Block = "chiseled_tuff_bricks"
+	ChorusFlower                    Block = "chorus_flower"
+	ChorusPlant                     Block = "chorus_plant"
+	Clay                            Block = "clay"
+	ClosedEyeblossom                Block = "closed_eyeblossom"
+	CoalBlock                       Block = "coal_block"
+	CoalOre                         Block = "coal_ore"
+	CoarseDirt                      Block = "coarse_dirt"
+	CobbledDeepslate                Block = "cobbled_deepslate"
+	CobbledDeepslateSlab            Block = "cobbled_deepslate_slab"
+	CobbledDeepslateStairs          Block = "cobbled_deepslate_stairs"
+	CobbledDeepslateWall            Block = "cobbled_deepslate_wall"
+	Cobblestone                     Block = "cobblestone"
+	CobblestoneSlab                 Block = "cobblestone_slab"
+	CobblestoneStairs               Block = "cobblestone_stairs"
+	CobblestoneWall                 Block = "cobblestone_wall"
+	Cobweb                          Block = "cobweb"
+	Cocoa                           Block = "cocoa"
+	CommandBlock                    Block = "command_block"
+	Comparator                      Block = "comparator"
+	Composter                       Block = "composter"
+	Conduit                         Block = "conduit"
+	CopperBlock                     Block = "copper_block"
+	CopperBulb                      Block = "copper_bulb"
+	CopperDoor                      Block = "copper_door"
+	CopperGrate                     Block = "copper_grate"
+	CopperOre                       Block = "copper_ore"
+	CopperTrapdoor                  Block = "copper_trapdoor"
+	Cornflower                      Block = "cornflower"
+	CrackedDeepslateBricks          Block = "cracked_deepslate_bricks"
+	CrackedDeepslateTiles           Block = "cracked_deepslate_tiles"
+	CrackedNetherBricks             Block = "cracked_nether_bricks"
+	CrackedPolishedBlackstoneBricks Block = "cracked_polished_blackstone_bricks"
+	CrackedStoneBricks              Block = "cracked_stone_bricks"
+	Crafter                         Block = "crafter"
+	CraftingTable                   Block = "crafting_table"
+	CreakingHeart                   Block = "creaking_heart"
+	CreeperHead                     Block = "creeper_head"
+	CreeperWallHead                 Block = "creeper_wall_head"
+	CrimsonButton                   Block = "crimson_button"
+	CrimsonDoor                     Block = "crimson_door"
+	CrimsonFence                    Block = "crimson_fence"
+	CrimsonFenceGate                Block = "crimson_fence_gate"
+	CrimsonFungus                   Block = "crimson_fungus"
+	CrimsonHangingSign              Block = "crimson_hanging_sign"
+	CrimsonHyphae                   Block = "crimson_hyphae"
+	CrimsonNylium                   Block = "crimson_nylium"
+	CrimsonPlanks                   Block = "crimson_planks"
+	CrimsonPressurePlate            Block = "crimson_pressure_plate"
+	CrimsonRoots                    Block = "crimson_roots"
+	CrimsonSign                     Block = "crimson_sign"
+	CrimsonSlab                     Block = "crimson_slab"
+	CrimsonStairs                   Block = "crimson_stairs"
+	CrimsonStem                     Block = "crimson_stem"
+	CrimsonTrapdoor                 Block = "crimson_trapdoor"
+	CrimsonWallHangingSign          Block = "crimson_wall_hanging_sign"
+	CrimsonWallSign                 Block = "crimson_wall_sign"
+	CryingObsidian                  Block = "crying_obsidian"
+	CutCopper                       Block = "cut_copper"
+	CutCopperSlab                   Block = "cut_copper_slab"
+	CutCopperStairs                 Block = "cut_copper_stairs"
+	CutRedSandstone                 Block = "cut_red_sandstone"
+	CutRedSandstoneSlab             Block = "cut_red_sandstone_slab"
+	CutSandstone                    Block = "cut_sandstone"
+	CutSandstoneSlab                Block = "cut_sandstone_slab"
+	CyanBanner                      Block = "cyan_banner"
+	CyanBed                         Block = "cyan_bed"
+	CyanCandle                      Block = "cyan_candle"
+	CyanCandleCake                  Block = "cyan_candle_cake"
+	CyanCarpet                      Block = "cyan_carpet"
+	CyanConcrete                    Block = "cyan_concrete"
+	CyanConcretePowder              Block = "cyan_concrete_powder"
+	CyanGlazedTerracotta            Block = "cyan_glazed_terracotta"
+	CyanShulkerBox                  Block = "cyan_shulker_box"
+	CyanStainedGlass                Block = "cyan_stained_glass"
+	CyanStainedGlassPane            Block = "cyan_stained_glass_pane"
+	CyanTerracotta                  Block = "cyan_terracotta"
+	CyanWallBanner                  Block = "cyan_wall_banner"
+	CyanWool                        Block = "cyan_wool"
+	DamagedAnvil                    Block = "damaged_anvil"
+	Dandelion                       Block = "dandelion"
+	DarkOakButton                   Block = "dark_oak_button"
+	DarkOakDoor                     Block = "dark_oak_door"
+	DarkOakFence                    Block = "dark_oak_fence"
+	DarkOakFenceGate                Block = "dark_oak_fence_gate"
+	DarkOakHangingSign              Block = "dark_oak_hanging_sign"
+	DarkOakLeaves                   Block = "dark_oak_leaves"
+	DarkOakLog                      Block = "dark_oak_log"
+	DarkOakPlanks                   Block = "dark_oak_planks"
+	DarkOakPressurePlate            Block = "dark_oak_pressure_plate"
+	DarkOakSapling                  Block = "dark_oak_sapling"
+	DarkOakSign                     Block = "dark_oak_sign"
+	DarkOakSlab                     Block = "dark_oak_slab"
+	DarkOakStairs                   Block = "dark_oak_stairs"
+	DarkOakTrapdoor                 Block = "dark_oak_trapdoor"
+	DarkOakWallHangingSign          Block = "dark_oak_wall_hanging_sign"
+	DarkOakWallSign                 Block = "dark_oak_wall_sign"
+	DarkOakWood                     Block = "dark_oak_wood"
+	DarkPrismarine                  Block = "dark_prismarine"
+	DarkPrismarineSlab              Block = "dark_prismarine_slab"
+	DarkPrismarineStairs            Block = "dark_prismarine_stairs"
+	DaylightDetector                Block = "daylight_detector"
+	DeadBrainCoral                  Block = "dead_brain_coral"
+	DeadBrainCoralBlock             Block = "dead_brain_coral_block"
+	DeadBrainCoralFan               Block = "dead_brain_coral_fan"
+	DeadBrainCoralWallFan           Block = "dead_brain_coral_wall_fan"
+	DeadBubbleCoral                 Block = "dead_bubble_coral"
+	DeadBubbleCoralBlock            Block = "dead_bubble_coral_block"
+	DeadBubbleCoralFan              Block = "dead_bubble_coral_fan"
+	DeadBubbleCoralWallFan          Block = "dead_bubble_coral_wall_fan"
+	DeadBush                        Block = "dead_bush"
+	DeadFireCoral                   Block = "dead_fire_coral"
+	DeadFireCoralBlock              Block = "dead_fire_coral_block"
+	DeadFireCoralFan                Block = "dead_fire_coral_fan"
+	DeadFireCoralWallFan            Block = "dead_fire_coral_wall_fan"
+	DeadHornCoral                   Block = "dead_horn_coral"
+	DeadHornCoralBlock              Block = "dead_horn_coral_block"
+	DeadHornCoralFan                Block = "dead_horn_coral_fan"
+	DeadHornCoralWallFan            Block = "dead_horn_coral_wall_fan"
+	DeadTubeCoral                   Block = "dead_tube_coral"
+	DeadTubeCoralBlock              Block = "dead_tube_coral_block"
+	DeadTubeCoralFan                Block = "dead_tube_coral_fan"
+	DeadTubeCoralWallFan            Block = "dead_tube_coral_wall_fan"
+	DecoratedPot                    Block = "decorated_pot"
+	Deepslate                       Block = "deepslate"
+	DeepslateBrickSlab              Block = "deepslate_brick_slab"
+	DeepslateBrickStairs            Block = "deepslate_brick_stairs"
+	DeepslateBrickWall              Block = "deepslate_brick_wall"
+	DeepslateBricks                 Block = "deepslate_bricks"
+	DeepslateCoalOre                Block = "deepslate_coal_ore"
+	DeepslateCopperOre              Block = "deepslate_copper_ore"
+	DeepslateDiamondOre             Block = "deepslate_diamond_ore"
+	DeepslateEmeraldOre             Block = "deepslate_emerald_ore"
+	DeepslateGoldOre                Block = "deepslate_gold_ore"
+	DeepslateIronOre                Block = "deepslate_iron_ore"
+	DeepslateLapisOre               Block = "deepslate_lapis_ore"
+	DeepslateRedstoneOre            Block = "deepslate_redstone_ore"
+	DeepslateTileSlab               Block = "deepslate_tile_slab"
+	DeepslateTileStairs             Block = "deepslate_tile_stairs"
+	DeepslateTileWall               Block = "deepslate_tile_wall"
+	DeepslateTiles                  Block = "deepslate_tiles"
+	DetectorRail                    Block = "detector_rail"
+	DiamondBlock                    Block = "diamond_block"
+	DiamondOre                      Block = "diamond_ore"
+	Diorite                         Block = "diorite"
+	DioriteSlab                     Block = "diorite_slab"
+	DioriteStairs                   Block = "diorite_stairs"
+	DioriteWall                     Block = "diorite_wall"
+	Dirt                            Block = "dirt"
+	DirtPath                        Block = "dirt_path"
+	Dispenser                       Block = "dispenser"
+	DragonEgg                       Block = "dragon_egg"
+	DragonHead                      Block = "dragon_head"
+	DragonWallHead                  Block = "dragon_wall_head"
+	DriedKelpBlock                  Block = "dried_kelp_block"
+	DripstoneBlock                  Block = "dripstone_block"
+	Dropper                         Block = "dropper"
+	EmeraldBlock                    Block = "emerald_block"
+	EmeraldOre                      Block = "emerald_ore"
+	EnchantingTable                 Block = "enchanting_table"
+	EndGateway                      Block = "end_gateway"
+	EndPortal                       Block = "end_portal"
+	EndPortalFrame                  Block = "end_portal_frame"
+	EndRod                          Block = "end_rod"
+	EndStone                        Block = "end_stone"
+	EndStoneBrickSlab               Block = "end_stone_brick_slab"
+	EndStoneBrickStairs             Block = "end_stone_brick_stairs"
+	EndStoneBrickWall               Block = "end_stone_brick_wall"
+	EndStoneBricks                  Block = "end_stone_bricks"
+	EnderChest                      Block = "ender_chest"
+	ExposedChiseledCopper           Block = "exposed_chiseled_copper"
+	ExposedCopper                   Block = "exposed_copper"
+	ExposedCopperBulb               Block = "exposed_copper_bulb"
+	ExposedCopperDoor               Block = "exposed_copper_door"
+	ExposedCopperGrate              Block = "exposed_copper_grate"
+	ExposedCopperTrapdoor           Block = "exposed_copper_trapdoor"
+	ExposedCutCopper                Block = "exposed_cut_copper"
+	ExposedCutCopperSlab            Block = "exposed_cut_copper_slab"
+	ExposedCutCopperStairs          Block = "exposed_cut_copper_stairs"
+	Farmland                        Block = "farmland"
+	Fern                            Block = "fern"
+	Fire                            Block = "fire"
+	FireCoral                       Block = "fire_coral"
+	FireCoralBlock                  Block = "fire_coral_block"
+	FireCoralFan                    Block = "fire_coral_fan"
+	FireCoralWallFan                Block = "fire_coral_wall_fan"
+	FletchingTable                  Block = "fletching_table"
+	FlowerPot                       Block = "flower_pot"
+	FloweringAzalea                 Block = "flowering_azalea"
+	FloweringAzaleaLeaves           Block = "flowering_azalea_leaves"
+	Frogspawn                       Block = "frogspawn"
+	FrostedIce                      Block = "frosted_ice"
+	Furnace                         Block = "furnace"
+	GildedBlackstone                Block = "gilded_blackstone"
+	Glass                           Block = "glass"
+	GlassPane                       Block = "glass_pane"
+	GlowLichen                      Block = "glow_lichen"
+	Glowstone                       Block = "glowstone"
+	GoldBlock                       Block = "gold_block"
+	GoldOre                         Block = "gold_ore"
+	Granite                         Block = "granite"
+	GraniteSlab                     Block = "granite_slab"
+	GraniteStairs                   Block = "granite_stairs"
+	GraniteWall                     Block = "granite_wall"
+	GrassBlock                      Block = "grass_block"
+	Gravel                          Block = "gravel"
+	GrayBanner                      Block = "gray_banner"
+	GrayBed                         Block = "gray_bed"
+	GrayCandle                      Block = "gray_candle"
+	GrayCandleCake                  Block = "gray_candle_cake"
+	GrayCarpet                      Block = "gray_carpet"
+	GrayConcrete                    Block = "gray_concrete"
+	GrayConcretePowder              Block = "gray_concrete_powder"
+	GrayGlazedTerracotta            Block = "gray_glazed_terracotta"
+	GrayShulkerBox                  Block = "gray_shulker_box"
+	GrayStainedGlass                Block = "gray_stained_glass"
+	GrayStainedGlassPane            Block = "gray_stained_glass_pane"
+	GrayTerracotta                  Block = "gray_terracotta"
+	GrayWallBanner                  Block = "gray_wall_banner"
+	GrayWool                        Block = "gray_wool"
+	GreenBanner                     Block = "green_banner"
+	GreenBed                        Block = "green_bed"
+	GreenCandle                     Block = "green_candle"
+	GreenCandleCake                 Block = "green_candle_cake"
+	GreenCarpet                     Block = "green_carpet"
+	GreenConcrete                   Block = "green_concrete"
+	GreenConcretePowder             Block = "green_concrete_powder"
+	GreenGlazedTerracotta           Block = "green_glazed_terracotta"
+	GreenShulkerBox                 Block = "green_shulker_box"
+	GreenStainedGlass               Block = "green_stained_glass"
+	GreenStainedGlassPane           Block = "green_stained_glass_pane"
+	GreenTerracotta                 Block = "green_terracotta"
+	GreenWallBanner                 Block = "green_wall_banner"
+	GreenWool                       Block = "green_wool"
+	Grindstone                      Block = "grindstone"
+	HangingRoots                    Block = "hanging_roots"
+	HayBlock                        Block = "hay_block"
+	HeavyCore                       Block = "heavy_core"
+	HeavyWeightedPressurePlate      Block = "heavy_weighted_pressure_plate"
+	HoneyBlock                      Block = "honey_block"
+	HoneycombBlock                  Block = "honeycomb_block"
+	Hopper                          Block = "hopper"
+	HornCoral                       Block = "horn_coral"
+	HornCoralBlock                  Block = "horn_coral_block"
+	HornCoralFan                    Block = "horn_coral_fan"
+	HornCoralWallFan                Block = "horn_coral_wall_fan"
+	Ice                             Block = "ice"
+	InfestedChiseledStoneBricks     Block = "infested_chiseled_stone_bricks"
+	InfestedCobblestone             Block = "infested_cobblestone"
+	InfestedCrackedStoneBricks      Block = "infested_cracked_stone_bricks"
+	InfestedDeepslate               Block = "infested_deepslate"
+	InfestedMossyStoneBricks        Block = "infested_mossy_stone_bricks"
+	InfestedStone                   Block = "infested_stone"
+	InfestedStoneBricks             Block = "infested_stone_bricks"
+	IronBars                        Block = "iron_bars"
+	IronBlock                       Block = "iron_block"
+	IronDoor                        Block = "iron_door"
+	IronOre                         Block = "iron_ore"
+	IronTrapdoor                    Block = "iron_trapdoor"
+	JackOLantern                    Block = "jack_o_lantern"
+	Jigsaw                          Block = "jigsaw"
+	Jukebox                         Block = "jukebox"
+	JungleButton                    Block = "jungle_button"
+	JungleDoor                      Block = "jungle_door"
+	JungleFence                     Block = "jungle_fence"
+	JungleFenceGate                 Block = "jungle_fence_gate"
+	JungleHangingSign               Block = "jungle_hanging_sign"
+	JungleLeaves                    Block = "jungle_leaves"
+	JungleLog                       Block = "jungle_log"
+	JunglePlanks                    Block = "jungle_planks"
+	JunglePressurePlate             Block = "jungle_pressure_plate"
+	JungleSapling                   Block = "jungle_sapling"
+	JungleSign                      Block = "jungle_sign"
+	JungleSlab                      Block = "jungle_slab"
+	JungleStairs                    Block = "jungle_stairs"
+	JungleTrapdoor                  Block = "jungle_trapdoor"
+	JungleWallHangingSign           Block = "jungle_wall_hanging_sign"
+	JungleWallSign                  Block = "jungle_wall_sign"
+	JungleWood                      Block = "jungle_wood"
+	Kelp                            Block = "kelp"
+	KelpPlant                       Block = "kelp_plant"
+	Ladder                          Block = "ladder"
+	Lantern                         Block = "lantern"
+	LapisBlock                      Block = "lapis_block"
+	LapisOre                        Block = "lapis_ore"
+	LargeAmethystBud                Block = "large_amethyst_bud"
+	LargeFern                       Block = "large_fern"
+	Lava                            Block = "lava"
+	LavaCauldron                    Block = "lava_cauldron"
+	Lectern                         Block = "lectern"
+	Lever                           Block = "lever"
+	Light                           Block = "light"
+	LightBlueBanner                 Block = "light_blue_banner"
+	LightBlueBed                    Block = "light_blue_bed"
+	LightBlueCandle                 Block = "light_blue_candle"
+	LightBlueCandleCake             Block = "light_blue_candle_cake"
+	LightBlueCarpet                 Block = "light_blue_carpet"
+	LightBlueConcrete               Block = "light_blue_concrete"
+	LightBlueConcretePowder         Block = "light_blue_concrete_powder"
+	LightBlueGlazedTerracotta       Block = "light_blue_glazed_terracotta"
+	LightBlueShulkerBox             Block = "light_blue_shulker_box"
+	LightBlueStainedGlass           Block = "light_blue_stained_glass"
+	LightBlueStainedGlassPane       Block = "light_blue_stained_glass_pane"
+	LightBlueTerracotta             Block = "light_blue_terracotta"
+	LightBlueWallBanner             Block = "light_blue_wall_banner"
+	LightBlueWool                   Block = "light_blue_wool"
+	LightGrayBanner                 Block = "light_gray_banner"
+	LightGrayBed                    Block = "light_gray_bed"
+	LightGrayCandle                 Block = "light_gray_candle"
+	LightGrayCandleCake             Block = "light_gray_candle_cake"
+	LightGrayCarpet                 Block = "light_gray_carpet"
+	LightGrayConcrete               Block = "light_gray_concrete"
+	LightGrayConcretePowder         Block = "light_gray_concrete_powder"
+	LightGrayGlazedTerracotta       Block = "light_gray_glazed_terracotta"
+	LightGrayShulkerBox             Block = "light_gray_shulker_box"
+	LightGrayStainedGlass           Block = "light_gray_stained_glass"
+	LightGrayStainedGlassPane       Block = "light_gray_stained_glass_pane"
+	LightGrayTerracotta             Block = "light_gray_terracotta"
+	LightGrayWallBanner             Block = "light_gray_wall_banner"
+	LightGrayWool                   Block = "light_gray_wool"
+	LightWeightedPressurePlate      Block = "light_weighted_pressure_plate"
+	LightningRod                    Block = "lightning_rod"
+	Lilac                           Block = "lilac"
+	LilyOfTheValley                 Block = "lily_of_the_valley"
+	LilyPad                         Block = "lily_pad"
+	LimeBanner                      Block = "lime_banner"
+	LimeBed                         Block = "lime_bed"
+	LimeCandle                      Block = "lime_candle"
+	LimeCandleCake                  Block = "lime_candle_cake"
+	LimeCarpet                      Block = "lime_carpet"
+	LimeConcrete                    Block = "lime_concrete"
+	LimeConcretePowder              Block = "lime_concrete_powder"
+	LimeGlazedTerracotta            Block = "lime_glazed_terracotta"
+	LimeShulkerBox                  Block = "lime_shulker_box"
+	LimeStainedGlass                Block = "lime_stained_glass"
+	LimeStainedGlassPane            Block = "lime_stained_glass_pane"
+	LimeTerracotta                  Block = "lime_terracotta"
+	LimeWallBanner                  Block = "lime_wall_banner"
+	LimeWool                        Block = "lime_wool"
+	Lodestone                       Block = "lodestone"
+	Loom                            Block = "loom"
+	MagentaBanner                   Block = "magenta_banner"
+	MagentaBed                      Block = "magenta_bed"
+	MagentaCandle                   Block = "magenta_candle"
+	MagentaCandleCake               Block = "magenta_candle_cake"
+	MagentaCarpet                   Block = "magenta_carpet"
+	MagentaConcrete                 Block = "magenta_concrete"
+	MagentaConcretePowder           Block = "magenta_concrete_powder"
+	MagentaGlazedTerracotta         Block = "magenta_glazed_terracotta"
+	MagentaShulkerBox               Block = "magenta_shulker_box"
+	MagentaStainedGlass             Block = "magenta_stained_glass"
+	MagentaStainedGlassPane         Block = "magenta_stained_glass_pane"
+	MagentaTerracotta               Block = "magenta_terracotta"
+	MagentaWallBanner               Block = "magenta_wall_banner"
+	MagentaWool                     Block = "magenta_wool"
+	MagmaBlock                      Block = "magma_block"
+	MangroveButton                  Block = "mangrove_button"
+	MangroveDoor                    Block = "mangrove_door"
+	MangroveFence                   Block = "mangrove_fence"
+	MangroveFenceGate               Block = "mangrove_fence_gate"
+	MangroveHangingSign             Block = "mangrove_hanging_sign"
+	MangroveLeaves                  Block = "mangrove_leaves"
+	MangroveLog                     Block = "mangrove_log"
+	MangrovePlanks                  Block = "mangrove_planks"
+	MangrovePressurePlate           Block = "mangrove_pressure_plate"
+	MangrovePropagule               Block = "mangrove_propagule"
+	MangroveRoots                   Block = "mangrove_roots"
+	MangroveSign                    Block = "mangrove_sign"
+	MangroveSlab                    Block = "mangrove_slab"
+	MangroveStairs                  Block = "mangrove_stairs"
+	MangroveTrapdoor                Block = "mangrove_trapdoor"
+	MangroveWallHangingSign         Block = "mangrove_wall_hanging_sign"
+	MangroveWallSign                Block = "mangrove_wall_sign"
+	MangroveWood                    Block = "mangrove_wood"
+	MediumAmethystBud               Block = "medium_amethyst_bud"
+	Melon                           Block = "melon"
+	MelonStem                       Block = "melon_stem"
+	MossBlock                       Block = "moss_block"
+	MossCarpet                      Block = "moss_carpet"
+	MossyCobblestone                Block = "mossy_cobblestone"
+	MossyCobblestoneSlab            Block = "mossy_cobblestone_slab"
+	MossyCobblestoneStairs          Block = "mossy_cobblestone_stairs"
+	MossyCobblestoneWall            Block = "mossy_cobblestone_wall"
+	MossyStoneBrickSlab             Block = "mossy_stone_brick_slab"
+	MossyStoneBrickStairs           Block = "mossy_stone_brick_stairs"
+	MossyStoneBrickWall             Block = "mossy_stone_brick_wall"
+	MossyStoneBricks                Block = "mossy_stone_bricks"
+	MovingPiston                    Block = "moving_piston"
+	Mud                             Block = "mud"
+	MudBrickSlab                    Block = "mud_brick_slab"
+	MudBrickStairs                  Block = "mud_brick_stairs"
+	MudBrickWall                    Block = "mud_brick_wall"
+	MudBricks                       Block = "mud_bricks"
+	MuddyMangroveRoots              Block = "muddy_mangrove_roots"
+	MushroomStem                    Block = "mushroom_stem"
+	Mycelium                        Block = "mycelium"
+	NetherBrickFence                Block = "nether_brick_fence"
+	NetherBrickSlab                 Block = "nether_brick_slab"
+	NetherBrickStairs               Block = "nether_brick_stairs"
+	NetherBrickWall                 Block = "nether_brick_wall"
+	NetherBricks                    Block = "nether_bricks"
+	NetherGoldOre                   Block = "nether_gold_ore"
+	NetherPortal                    Block = "nether_portal"
+	NetherQuartzOre                 Block = "nether_quartz_ore"
+	NetherSprouts                   Block = "nether_sprouts"
+	NetherWart                      Block = "nether_wart"
+	NetherWartBlock                 Block = "nether_wart_block"
+	NetheriteBlock                  Block = "netherite_block"
+	Netherrack                      Block = "netherrack"
+	NoteBlock                       Block = "note_block"
+	OakButton                       Block = "oak_button"
+	OakDoor                         Block = "oak_door"
+	OakFence                        Block = "oak_fence"
+	OakFenceGate                    Block = "oak_fence_gate"
+	OakHangingSign                  Block = "oak_hanging_sign"
+	OakLeaves                       Block = "oak_leaves"
+	OakLog                          Block = "oak_log"
+	OakPlanks                       Block = "oak_planks"
+	OakPressurePlate                Block = "oak_pressure_plate"
+	OakSapling                      Block = "oak_sapling"
+	OakSign                         Block = "oak_sign"
+	OakSlab                         Block = "oak_slab"
+	OakStairs                       Block = "oak_stairs"
+	OakTrapdoor                     Block = "oak_trapdoor"
+	OakWallHangingSign              Block = "oak_wall_hanging_sign"
+	OakWallSign                     Block = "oak_wall_sign"
+	OakWood                         Block = "oak_wood"
+	Observer                        Block = "observer"
+	Obsidian                        Block = "obsidian"
+	OchreFroglight                  Block = "ochre_froglight"
+	OpenEyeblossom                  Block = "open_eyeblossom"
+	OrangeBanner                    Block = "orange_banner"
+	OrangeBed                       Block = "orange_bed"
+	OrangeCandle                    Block = "orange_candle"
+	OrangeCandleCake                Block = "orange_candle_cake"
+	OrangeCarpet                    Block = "orange_carpet"
+	OrangeConcrete                  Block = "orange_concrete"
+	OrangeConcretePowder            Block = "orange_concrete_powder"
+	OrangeGlazedTerracotta          Block = "orange_glazed_terracotta"
+	OrangeShulkerBox                Block = "orange_shulker_box"
+	OrangeStainedGlass              Block = "orange_stained_glass"
+	OrangeStainedGlassPane          Block = "orange_stained_glass_pane"
+	OrangeTerracotta                Block = "orange_terracotta"
+	OrangeTulip                     Block = "orange_tulip"
+	OrangeWallBanner                Block = "orange_wall_banner"
+	OrangeWool                      Block = "orange_wool"
+	OxeyeDaisy                      Block = "oxeye_daisy"
+	OxidizedChiseledCopper          Block = "oxidized_chiseled_copper"
+	OxidizedCopper                  Block = "oxidized_copper"
+	OxidizedCopperBulb              Block = "oxidized_copper_bulb"
+	OxidizedCopperDoor              Block = "oxidized_copper_door"
+	OxidizedCopperGrate             Block = "oxidized_copper_grate"
+	OxidizedCopperTrapdoor          Block = "oxidized_copper_trapdoor"
+	OxidizedCutCopper               Block = "oxidized_cut_copper"
+	OxidizedCutCopperSlab           Block = "oxidized_cut_copper_slab"
+	OxidizedCutCopperStairs         Block = "oxidized_cut_copper_stairs"
+	PackedIce                       Block = "packed_ice"
+	PackedMud                       Block = "packed_mud"
+	PaleHangingMoss                 Block = "pale_hanging_moss"
+	PaleMossBlock                   Block = "pale_moss_block"
+	PaleMossCarpet                  Block = "pale_moss_carpet"
+	PaleOakButton                   Block = "pale_oak_button"
+	PaleOakDoor                     Block = "pale_oak_door"
+	PaleOakFence                    Block = "pale_oak_fence"
+	PaleOakFenceGate                Block = "pale_oak_fence_gate"
+	PaleOakHangingSign              Block = "pale_oak_hanging_sign"
+	PaleOakLeaves                   Block = "pale_oak_leaves"
+	PaleOakLog                      Block = "pale_oak_log"
+	PaleOakPlanks                   Block = "pale_oak_planks"
+	PaleOakPressurePlate            Block = "pale_oak_pressure_plate"
+	PaleOakSapling                  Block = "pale_oak_sapling"
+	PaleOakSign                     Block = "pale_oak_sign"
+	PaleOakSlab                     Block = "pale_oak_slab"
+	PaleOakStairs                   Block = "pale_oak_stairs"
+	PaleOakTrapdoor                 Block = "pale_oak_trapdoor"
+	PaleOakWallHangingSign          Block = "pale_oak_wall_hanging_sign"
+	PaleOakWallSign                 Block = "pale_oak_wall_sign"
+	PaleOakWood                     Block = "pale_oak_wood"
+	PearlescentFroglight            Block = "pearlescent_froglight"
+	Peony                           Block = "peony"
+	PetrifiedOakSlab                Block = "petrified_oak_slab"
+	PiglinHead                      Block = "piglin_head"
+	PiglinWallHead                  Block = "piglin_wall_head"
+	PinkBanner                      Block = "pink_banner"
+	PinkBed                         Block = "pink_bed"
+	PinkCandle                      Block = "pink_candle"
+	PinkCandleCake                  Block = "pink_candle_cake"
+	PinkCarpet                      Block = "pink_carpet"
+	PinkConcrete                    Block = "pink_concrete"
+	PinkConcretePowder              Block = "pink_concrete_powder"
+	PinkGlazedTerracotta            Block = "pink_glazed_terracotta"
+	PinkPetals                      Block = "pink_petals"
+	PinkShulkerBox                  Block = "pink_shulker_box"
+	PinkStainedGlass                Block = "pink_stained_glass"
+	PinkStainedGlassPane            Block = "pink_stained_glass_pane"
+	PinkTerracotta                  Block = "pink_terracotta"
+	PinkTulip                       Block = "pink_tulip"
+	PinkWallBanner                  Block = "pink_wall_banner"
+	PinkWool                        Block = "pink_wool"
+	Piston                          Block = "piston"
+	PistonHead                      Block = "piston_head"
+	PitcherCrop                     Block = "pitcher_crop"
+	PitcherPlant                    Block = "pitcher_plant"
+	PlayerHead                      Block = "player_head"
+	PlayerWallHead                  Block = "player_wall_head"
+	Podzol                          Block = "podzol"
+	PointedDripstone                Block = "pointed_dripstone"
+	PolishedAndesite                Block = "polished_andesite"
+	PolishedAndesiteSlab            Block = "polished_andesite_slab"
+	PolishedAndesiteStairs          Block = "polished_andesite_stairs"
+	PolishedBasalt                  Block = "polished_basalt"
+	PolishedBlackstone              Block = "polished_blackstone"
+	PolishedBlackstoneBrickSlab     Block = "polished_blackstone_brick_slab"
+	PolishedBlackstoneBrickStairs   Block = "polished_blackstone_brick_stairs"
+	PolishedBlackstoneBrickWall     Block = "polished_blackstone_brick_wall"
+	PolishedBlackstoneBricks        Block = "polished_blackstone_bricks"
+	PolishedBlackstoneButton        Block = "polished_blackstone_button"
+	PolishedBlackstonePressurePlate Block = "polished_blackstone_pressure_plate"
+	PolishedBlackstoneSlab          Block = "polished_blackstone_slab"
+	PolishedBlackstoneStairs        Block = "polished_blackstone_stairs"
+	PolishedBlackstoneWall          Block = "polished_blackstone_wall"
+	PolishedDeepslate               Block = "polished_deepslate"
+	PolishedDeepslateSlab           Block = "polished_deepslate_slab"
+	PolishedDeepslateStairs         Block = "polished_deepslate_stairs"
+	PolishedDeepslateWall           Block = "polished_deepslate_wall"
+	PolishedDiorite                 Block = "polished_diorite"
+	PolishedDioriteSlab             Block = "polished_diorite_slab"
+	PolishedDioriteStairs           Block = "polished_diorite_stairs"
+	PolishedGranite                 Block = "polished_granite"
+	PolishedGraniteSlab             Block = "polished_granite_slab"
+	PolishedGraniteStairs           Block = "polished_granite_stairs"
+	PolishedTuff                    Block = "polished_tuff"
+	PolishedTuffSlab                Block = "polished_tuff_slab"
+	PolishedTuffStairs              Block = "polished_tuff_stairs"
+	PolishedTuffWall                Block = "polished_tuff_wall"
+	Poppy                           Block = "poppy"
+	Potatoes                        Block = "potatoes"
+	PottedAcaciaSapling             Block = "potted_acacia_sapling"
+	PottedAllium                    Block = "potted_allium"
+	PottedAzaleaBush                Block = "potted_azalea_bush"
+	PottedAzureBluet                Block = "potted_azure_bluet"
+	PottedBamboo                    Block = "potted_bamboo"
+	PottedBirchSapling              Block = "potted_birch_sapling"
+	PottedBlueOrchid                Block = "potted_blue_orchid"
+	PottedBrownMushroom             Block = "potted_brown_mushroom"
+	PottedCactus                    Block = "potted_cactus"
+	PottedCherrySapling             Block = "potted_cherry_sapling"
+	PottedClosedEyeblossom          Block = "potted_closed_eyeblossom"
+	PottedCornflower                Block = "potted_cornflower"
+	PottedCrimsonFungus             Block = "potted_crimson_fungus"
+	PottedCrimsonRoots              Block = "potted_crimson_roots"
+	PottedDandelion                 Block = "potted_dandelion"
+	PottedDarkOakSapling            Block = "potted_dark_oak_sapling"
+	PottedDeadBush                  Block = "potted_dead_bush"
+	PottedFern                      Block = "potted_fern"
+	PottedFloweringAzaleaBush       Block = "potted_flowering_azalea_bush"
+	PottedJungleSapling             Block = "potted_jungle_sapling"
+	PottedLilyOfTheValley           Block = "potted_lily_of_the_valley"
+	PottedMangrovePropagule         Block = "potted_mangrove_propagule"
+	PottedOakSapling                Block = "potted_oak_sapling"
+	PottedOpenEyeblossom            Block = "potted_open_eyeblossom"
+	PottedOrangeTulip               Block = "potted_orange_tulip"
+	PottedOxeyeDaisy                Block = "potted_oxeye_daisy"
+	PottedPaleOakSapling            Block = "potted_pale_oak_sapling"
+	PottedPinkTulip                 Block = "potted_pink_tulip"
+	PottedPoppy                     Block = "potted_poppy"
+	PottedRedMushroom               Block = "potted_red_mushroom"
+	PottedRedTulip                  Block = "potted_red_tulip"
+	PottedSpruceSapling             Block = "potted_spruce_sapling"
+	PottedTorchflower               Block = "potted_torchflower"
+	PottedWarpedFungus              Block = "potted_warped_fungus"
+	PottedWarpedRoots               Block = "potted_warped_roots"
+	PottedWhiteTulip                Block = "potted_white_tulip"
+	PottedWitherRose                Block = "potted_wither_rose"
+	PowderSnow                      Block = "powder_snow"
+	PowderSnowCauldron              Block = "powder_snow_cauldron"
+	PoweredRail                     Block = "powered_rail"
+	Prismarine                      Block = "prismarine"
+	PrismarineBrickSlab             Block = "prismarine_brick_slab"
+	PrismarineBrickStairs           Block = "prismarine_brick_stairs"
+	PrismarineBricks                Block = "prismarine_bricks"
+	PrismarineSlab                  Block = "prismarine_slab"
+	PrismarineStairs                Block = "prismarine_stairs"
+	PrismarineWall                  Block = "prismarine_wall"
+	Pumpkin                         Block = "pumpkin"
+	PumpkinStem                     Block = "pumpkin_stem"
+	PurpleBanner                    Block = "purple_banner"
+	PurpleBed                       Block = "purple_bed"
+	PurpleCandle                    Block = "purple_candle"
+	PurpleCandleCake                Block = "purple_candle_cake"
+	PurpleCarpet                    Block = "purple_carpet"
+	PurpleConcrete                  Block = "purple_concrete"
+	PurpleConcretePowder            Block = "purple_concrete_powder"
+	PurpleGlazedTerracotta          Block = "purple_glazed_terracotta"
+	PurpleShulkerBox                Block = "purple_shulker_box"
+	PurpleStainedGlass              Block = "purple_stained_glass"
+	PurpleStainedGlassPane          Block = "purple_stained_glass_pane"
+	PurpleTerracotta                Block = "purple_terracotta"
+	PurpleWallBanner                Block = "purple_wall_banner"
+	PurpleWool                      Block = "purple_wool"
+	PurpurBlock                     Block = "purpur_block"
+	PurpurPillar                    Block = "purpur_pillar"
+	PurpurSlab                      Block = "purpur_slab"
+	PurpurStairs                    Block = "purpur_stairs"
+	QuartzBlock                     Block = "quartz_block"
+	QuartzBricks                    Block = "quartz_bricks"
+	QuartzPillar                    Block = "quartz_pillar"
+	QuartzSlab                      Block = "quartz_slab"
+	QuartzStairs                    Block = "quartz_stairs"
+	Rail                            Block = "rail"
+	RawCopperBlock                  Block = "raw_copper_block"
+	RawGoldBlock                    Block = "raw_gold_block"
+	RawIronBlock                    Block = "raw_iron_block"
+	RedBanner                       Block = "red_banner"
+	RedBed                          Block = "red_bed"
+	RedCandle                       Block = "red_candle"
+	RedCandleCake                   Block = "red_candle_cake"
+	RedCarpet                       Block = "red_carpet"
+	RedConcrete                     Block = "red_concrete"
+	RedConcretePowder               Block = "red_concrete_powder"
+	RedGlazedTerracotta             Block = "red_glazed_terracotta"
+	RedMushroom                     Block = "red_mushroom"
+	RedMushroomBlock                Block = "red_mushroom_block"
+	RedNetherBrickSlab              Block = "red_nether_brick_slab"
+	RedNetherBrickStairs            Block = "red_nether_brick_stairs"
+	RedNetherBrickWall              Block = "red_nether_brick_wall"
+	RedNetherBricks                 Block = "red_nether_bricks"
+	RedSand                         Block = "red_sand"
+	RedSandstone                    Block = "red_sandstone"
+	RedSandstoneSlab                Block = "red_sandstone_slab"
+	RedSandstoneStairs              Block = "red_sandstone_stairs"
+	RedSandstoneWall                Block = "red_sandstone_wall"
+	RedShulkerBox                   Block = "red_shulker_box"
+	RedStainedGlass                 Block = "red_stained_glass"
+	RedStainedGlassPane             Block = "red_stained_glass_pane"
+	RedTerracotta                   Block = "red_terracotta"
+	RedTulip                        Block = "red_tulip"
+	RedWallBanner                   Block = "red_wall_banner"
+	RedWool                         Block = "red_wool"
+	RedstoneBlock                   Block = "redstone_block"
+	RedstoneLamp                    Block = "redstone_lamp"
+	RedstoneOre                     Block = "redstone_ore"
+	RedstoneTorch                   Block = "redstone_torch"
+	RedstoneWallTorch               Block = "redstone_wall_torch"
+	RedstoneWire                    Block = "redstone_wire"
+	ReinforcedDeepslate             Block = "reinforced_deepslate"
+	Repeater                        Block = "repeater"
+	RepeatingCommandBlock           Block = "repeating_command_block"
+	ResinBlock                      Block = "resin_block"
+	ResinBrickSlab                  Block = "resin_brick_slab"
+	ResinBrickStairs                Block = "resin_brick_stairs"
+	ResinBrickWall                  Block = "resin_brick_wall"
+	ResinBricks                     Block = "resin_bricks"
+	ResinClump                      Block = "resin_clump"
+	RespawnAnchor                   Block = "respawn_anchor"
+	RootedDirt                      Block = "rooted_dirt"
+	RoseBush                        Block = "rose_bush"
+	Sand                            Block = "sand"
+	Sandstone                       Block = "sandstone"
+	SandstoneSlab                   Block = "sandstone_slab"
+	SandstoneStairs                 Block = "sandstone_stairs"
+	SandstoneWall                   Block = "sandstone_wall"
+	Scaffolding                     Block = "scaffolding"
+	Sculk                           Block = "sculk"
+	SculkCatalyst                   Block = "sculk_catalyst"
+	SculkSensor                     Block = "sculk_sensor"
+	SculkShrieker                   Block = "sculk_shrieker"
+	SculkVein                       Block = "sculk_vein"
+	SeaLantern                      Block = "sea_lantern"
+	SeaPickle                       Block = "sea_pickle"
+	Seagrass                        Block = "seagrass"
+	ShortGrass                      Block = "short_grass"
+	Shroomlight                     Block = "shroomlight"
+	ShulkerBox                      Block = "shulker_box"
+	SkeletonSkull                   Block = "skeleton_skull"
+	SkeletonWallSkull               Block = "skeleton_wall_skull"
+	SlimeBlock                      Block = "slime_block"
+	SmallAmethystBud                Block = "small_amethyst_bud"
+	SmallDripleaf                   Block = "small_dripleaf"
+	SmithingTable                   Block = "smithing_table"
+	Smoker                          Block = "smoker"
+	SmoothBasalt                    Block = "smooth_basalt"
+	SmoothQuartz                    Block = "smooth_quartz"
+	SmoothQuartzSlab                Block = "smooth_quartz_slab"
+	SmoothQuartzStairs              Block = "smooth_quartz_stairs"
+	SmoothRedSandstone              Block = "smooth_red_sandstone"
+	SmoothRedSandstoneSlab          Block = "smooth_red_sandstone_slab"
+	SmoothRedSandstoneStairs        Block = "smooth_red_sandstone_stairs"
+	SmoothSandstone                 Block = "smooth_sandstone"
+	SmoothSandstoneSlab             Block = "smooth_sandstone_slab"
+	SmoothSandstoneStairs           Block = "smooth_sandstone_stairs"
+	SmoothStone                     Block = "smooth_stone"
+	SmoothStoneSlab                 Block = "smooth_stone_slab"
+	SnifferEgg                      Block = "sniffer_egg"
+	Snow                            Block = "snow"
+	SnowBlock                       Block = "snow_block"
+	SoulCampfire                    Block = "soul_campfire"
+	SoulFire                        Block = "soul_fire"
+	SoulLantern                     Block = "soul_lantern"
+	SoulSand                        Block = "soul_sand"
+	SoulSoil                        Block = "soul_soil"
+	SoulTorch                       Block = "soul_torch"
+	SoulWallTorch                   Block = "soul_wall_torch"
+	Spawner                         Block = "spawner"
+	Sponge                          Block = "sponge"
+	SporeBlossom                    Block = "spore_blossom"
+	SpruceButton                    Block = "spruce_button"
+	SpruceDoor                      Block = "spruce_door"
+	SpruceFence                     Block = "spruce_fence"
+	SpruceFenceGate                 Block = "spruce_fence_gate"
+	SpruceHangingSign               Block = "spruce_hanging_sign"
+	SpruceLeaves                    Block = "spruce_leaves"
+	SpruceLog                       Block = "spruce_log"
+	SprucePlanks                    Block = "spruce_planks"
+	SprucePressurePlate             Block = "spruce_pressure_plate"
+	SpruceSapling                   Block = "spruce_sapling"
+	SpruceSign                      Block = "spruce_sign"
+	SpruceSlab                      Block = "spruce_slab"
+	SpruceStairs                    Block = "spruce_stairs"
+	SpruceTrapdoor                  Block = "spruce_trapdoor"
+	SpruceWallHangingSign           Block = "spruce_wall_hanging_sign"
+	SpruceWallSign                  Block = "spruce_wall_sign"
+	SpruceWood                      Block = "spruce_wood"
+	StickyPiston                    Block = "sticky_piston"
+	Stone                           Block = "stone"
+	StoneBrickSlab                  Block = "stone_brick_slab"
+	StoneBrickStairs                Block = "stone_brick_stairs"
+	StoneBrickWall                  Block = "stone_brick_wall"
+	StoneBricks                     Block = "stone_bricks"
+	StoneButton                     Block = "stone_button"
+	StonePressurePlate              Block = "stone_pressure_plate"
+	StoneSlab                       Block = "stone_slab"
+	StoneStairs                     Block = "stone_stairs"
+	Stonecutter                     Block = "stonecutter"
+	StrippedAcaciaLog               Block = "stripped_acacia_log"
+	StrippedAcaciaWood              Block = "stripped_acacia_wood"
+	StrippedBambooBlock             Block = "stripped_bamboo_block"
+	StrippedBirchLog                Block = "stripped_birch_log"
+	StrippedBirchWood               Block = "stripped_birch_wood"
+	StrippedCherryLog               Block = "stripped_cherry_log"
+	StrippedCherryWood              Block = "stripped_cherry_wood"
+	StrippedCrimsonHyphae           Block = "stripped_crimson_hyphae"
+	StrippedCrimsonStem             Block = "stripped_crimson_stem"
+	StrippedDarkOakLog              Block = "stripped_dark_oak_log"
+	StrippedDarkOakWood             Block = "stripped_dark_oak_wood"
+	StrippedJungleLog               Block = "stripped_jungle_log"
+	StrippedJungleWood              Block = "stripped_jungle_wood"
+	StrippedMangroveLog             Block = "stripped_mangrove_log"
+	StrippedMangroveWood            Block = "stripped_mangrove_wood"
+	StrippedOakLog                  Block = "stripped_oak_log"
+	StrippedOakWood                 Block = "stripped_oak_wood"
+	StrippedPaleOakLog              Block = "stripped_pale_oak_log"
+	StrippedPaleOakWood             Block = "stripped_pale_oak_wood"
+	StrippedSpruceLog               Block = "stripped_spruce_log"
+	StrippedSpruceWood              Block = "stripped_spruce_wood"
+	StrippedWarpedHyphae            Block = "stripped_warped_hyphae"
+	StrippedWarpedStem              Block = "stripped_warped_stem"
+	StructureBlock                  Block = "structure_block"
+	StructureVoid                   Block = "structure_void"
+	SugarCane                       Block = "sugar_cane"
+	Sunflower                       Block = "sunflower"
+	SuspiciousGravel                Block = "suspicious_gravel"
+	SuspiciousSand                  Block = "suspicious_sand"
+	SweetBerryBush                  Block = "sweet_berry_bush"
+	TallGrass                       Block = "tall_grass"
+	TallSeagrass                    Block = "tall_seagrass"
+	Target                          Block = "target"
+	Terracotta                      Block = "terracotta"
+	TintedGlass                     Block = "tinted_glass"
+	TntBlock                        Block = "tnt"
+	Torch                           Block = "torch"
+	Torchflower                     Block = "torchflower"
+	TorchflowerCrop                 Block = "torchflower_crop"
+	TrappedChest                    Block = "trapped_chest"
+	TrialSpawner                    Block = "trial_spawner"
+	Tripwire                        Block = "tripwire"
+	TripwireHook                    Block = "tripwire_hook"
+	TubeCoral                       Block = "tube_coral"
+	TubeCoralBlock                  Block = "tube_coral_block"
+	TubeCoralFan                    Block = "tube_coral_fan"
+	TubeCoralWallFan                Block = "tube_coral_wall_fan"
+	Tuff                            Block = "tuff"
+	TuffBrickSlab                   Block = "tuff_brick_slab"
+	TuffBrickStairs                 Block = "tuff_brick_stairs"
+	TuffBrickWall                   Block = "tuff_brick_wall"
+	TuffBricks                      Block = "tuff_bricks"
+	TuffSlab                        Block = "tuff_slab"
+	TuffStairs                      Block = "tuff_stairs"
+	TuffWall                        Block = "tuff_wall"
+	TurtleEgg                       Block = "turtle_egg"
+	TwistingVines                   Block = "twisting_vines"
+	TwistingVinesPlant              Block = "twisting_vines_plant"
+	Vault                           Block = "vault"
+	VerdantFroglight                Block = "verdant_froglight"
+	Vine                            Block = "vine"
+	VoidAir                         Block = "void_air"
+	WallTorch                       Block = "wall_torch"
+	WarpedButton                    Block = "warped_button"
+	WarpedDoor                      Block = "warped_door"
+	WarpedFence                     Block = "warped_fence"
+	WarpedFenceGate                 Block = "warped_fence_gate"
+	WarpedFungus                    Block = "warped_fungus"
+	WarpedHangingSign               Block = "warped_hanging_sign"
+	WarpedHyphae                    Block = "warped_hyphae"
+	WarpedNylium                    Block = "warped_nylium"
+	WarpedPlanks                    Block = "warped_planks"
+	WarpedPressurePlate             Block = "warped_pressure_plate"
+	WarpedRoots                     Block = "warped_roots"
+	WarpedSign                      Block = "warped_sign"
+	WarpedSlab                      Block = "warped_slab"
+	WarpedStairs                    Block = "warped_stairs"
+	WarpedStem                      Block = "warped_stem"
+	WarpedTrapdoor                  Block = "warped_trapdoor"
+	WarpedWallHangingSign           Block = "warped_wall_hanging_sign"
+	WarpedWallSign                  Block = "warped_wall_sign"
+	WarpedWartBlock                 Block = "warped_wart_block"
+	Water                           Block = "water"
+	WaterCauldron                   Block = "water_cauldron"
+	WaxedChiseledCopper             Block = "waxed_chiseled_copper"
+	WaxedCopperBlock                Block = "waxed_copper_block"
+	WaxedCopperBulb                 Block = "waxed_copper_bulb"
+	WaxedCopperDoor                 Block = "waxed_copper_door"
+	WaxedCopperGrate                Block = "waxed_copper_grate"
+	WaxedCopperTrapdoor             Block = "waxed_copper_trapdoor"
+	WaxedCutCopper                  Block = "waxed_cut_copper"
+	WaxedCutCopperSlab              Block = "waxed_cut_copper_slab"
+	WaxedCutCopperStairs            Block = "waxed_cut_copper_stairs"
+	WaxedExposedChiseledCopper      Block = "waxed_exposed_chiseled_copper"
+	WaxedExposedCopper              Block = "waxed_exposed_copper"
+	WaxedExposedCopperBulb          Block = "waxed_exposed_copper_bulb"
+	WaxedExposedCopperDoor          Block = "waxed_exposed_copper_door"
+	WaxedExposedCopperGrate         Block = "waxed_exposed_copper_grate"
+	WaxedExposedCopperTrapdoor      Block = "waxed_exposed_copper_trapdoor"
+	WaxedExposedCutCopper           Block = "waxed_exposed_cut_copper"
+	WaxedExposedCutCopperSlab       Block = "waxed_exposed_cut_copper_slab"
+	WaxedExposedCutCopperStairs     Block = "waxed_exposed_cut_copper_stairs"
+	WaxedOxidizedChiseledCopper     Block = "waxed_oxidized_chiseled_copper"
+	WaxedOxidizedCopper             Block = "waxed_oxidized_copper"
+	WaxedOxidizedCopperBulb         Block = "waxed_oxidized_copper_bulb"
+	WaxedOxidizedCopperDoor         Block = "waxed_oxidized_copper_door"
+	WaxedOxidizedCopperGrate        Block = "waxed_oxidized_copper_grate"
+	WaxedOxidizedCopperTrapdoor     Block = "waxed_oxidized_copper_trapdoor"
+	WaxedOxidizedCutCopper          Block = "waxed_oxidized_cut_copper"
+	WaxedOxidizedCutCopperSlab      Block = "waxed_oxidized_cut_copper_slab"
+	WaxedOxidizedCutCopperStairs    Block = "waxed_oxidized_cut_copper_stairs"
+	WaxedWeatheredChiseledCopper    Block = "waxed_weathered_chiseled_copper"
+	WaxedWeatheredCopper            Block = "waxed_weathered_copper"
+	WaxedWeatheredCopperBulb        Block = "waxed_weathered_copper_bulb"
+	WaxedWeatheredCopperDoor        Block = "waxed_weathered_copper_door"
+	WaxedWeatheredCopperGrate       Block = "waxed_weathered_copper_grate"
+	WaxedWeatheredCopperTrapdoor    Block = "waxed_weathered_copper_trapdoor"
+	WaxedWeatheredCutCopper         Block = "waxed_weathered_cut_copper"
+	WaxedWeatheredCutCopperSlab     Block = "waxed_weathered_cut_copper_slab"
+	WaxedWeatheredCutCopperStairs   Block = "waxed_weathered_cut_copper_stairs"
+	WeatheredChiseledCopper         Block = "weathered_chiseled_copper"
+	WeatheredCopper                 Block = "weathered_copper"
+	WeatheredCopperBulb             Block = "weathered_copper_bulb"
+	WeatheredCopperDoor             Block = "weathered_copper_door"
+	WeatheredCopperGrate            Block = "weathered_copper_grate"
+	WeatheredCopperTrapdoor         Block = "weathered_copper_trapdoor"
+	WeatheredCutCopper              Block = "weathered_cut_copper"
+	WeatheredCutCopperSlab          Block = "weathered_cut_copper_slab"
+	WeatheredCutCopperStairs        Block = "weathered_cut_copper_stairs"
+	WeepingVines                    Block = "weeping_vines"
+	WeepingVinesPlant               Block = "weeping_vines_plant"
+	WetSponge                       Block = "wet_sponge"
+	Wheat                           Block = "wheat"
+	WhiteBanner                     Block = "white_banner"
+	WhiteBed                        Block = "white_bed"
+	WhiteCandle                     Block = "white_candle"
+	WhiteCandleCake                 Block = "white_candle_cake"
+	WhiteCarpet                     Block = "white_carpet"
+	WhiteConcrete                   Block = "white_concrete"
+	WhiteConcretePowder             Block = "white_concrete_powder"
+	WhiteGlazedTerracotta           Block = "white_glazed_terracotta"
+	WhiteShulkerBox                 Block = "white_shulker_box"
+	WhiteStainedGlass               Block = "white_stained_glass"
+	WhiteStainedGlassPane           Block = "white_stained_glass_pane"
+	WhiteTerracotta                 Block = "white_terracotta"
+	WhiteTulip                      Block = "white_tulip"
+	WhiteWallBanner                 Block = "white_wall_banner"
+	WhiteWool                       Block = "white_wool"
+	WitherRose                      Block = "wither_rose"
+	WitherSkeletonSkull             Block = "wither_skeleton_skull"
+	WitherSkeletonWallSkull         Block = "wither_skeleton_wall_skull"
+	YellowBanner                    Block = "yellow_banner"
+	YellowBed                       Block = "yellow_bed"
+	YellowCandle                    Block = "yellow_candle"
+	YellowCandleCake                Block = "yellow_candle_cake"
+	YellowCarpet                    Block = "yellow_carpet"
+	YellowConcrete                  Block = "yellow_concrete"
+	YellowConcretePowder            Block = "yellow_concrete_powder"
+	YellowGlazedTerracotta          Block = "yellow_glazed_terracotta"
+	YellowShulkerBox                Block = "yellow_shulker_box"
+	YellowStainedGlass              Block = "yellow_stained_glass"
+	YellowStainedGlassPane          Block = "yellow_stained_glass_pane"
+	YellowTerracotta                Block = "yellow_terracotta"
+	YellowWallBanner                Block = "yellow_wall_banner"
+	YellowWool                      Block = "yellow_wool"
+	ZombieHead                      Block = "zombie_head"
+	ZombieWallHead                  Block = "zombie_wall_head"
+)
+
+type Item string
+
+const (
+	AcaciaBoatItem                      Item = "acacia_boat"
+	AcaciaButtonItem                    Item = "acacia_button"
+	AcaciaChestBoatItem                 Item = "acacia_chest_boat"
+	AcaciaDoorItem                      Item = "acacia_door"
+	AcaciaFenceItem                     Item = "acacia_fence"
+	AcaciaFenceGateItem                 Item = "acacia_fence_gate"
+	AcaciaHangingSignItem               Item = "acacia_hanging_sign"
+	AcaciaLeavesItem                    Item = "acacia_leaves"
+	AcaciaLogItem                       Item = "acacia_log"
+	AcaciaPlanksItem                    Item = "acacia_planks"
+	AcaciaPressurePlateItem             Item = "acacia_pressure_plate"
+	AcaciaSaplingItem                   Item = "acacia_sapling"
+	AcaciaSignItem                      Item = "acacia_sign"
+	AcaciaSlabItem                      Item = "acacia_slab"
+	AcaciaStairsItem                    Item = "acacia_stairs"
+	AcaciaTrapdoorItem                  Item = "acacia_trapdoor"
+	AcaciaWoodItem                      Item = "acacia_wood"
+	ActivatorRailItem                   Item = "activator_rail"
+	AllaySpawnEgg                       Item = "allay_spawn_egg"
+	AlliumItem                          Item = "allium"
+	AmethystBlockItem                   Item = "amethyst_block"
+	AmethystClusterItem                 Item = "amethyst_cluster"
+	AmethystShard                       Item = "amethyst_shard"
+	AncientDebrisItem                   Item = "ancient_debris"
+	AndesiteItem                        Item = "andesite"
+	AndesiteSlabItem                    Item = "andesite_slab"
+	AndesiteStairsItem                  Item = "andesite_stairs"
+	AndesiteWallItem                    Item = "andesite_wall"
+	AnglerPotterySherd                  Item = "angler_pottery_sherd"
+	AnvilItem                           Item = "anvil"
+	Apple                               Item = "apple"
+	ArcherPotterySherd                  Item = "archer_pottery_sherd"
+	ArmadilloScute                      Item = "armadillo_scute"
+	ArmadilloSpawnEgg                   Item = "armadillo_spawn_egg"
+	ArmorStandItem                      Item = "armor_stand"
+	ArmsUpPotterySherd                  Item = "arms_up_pottery_sherd"
+	ArrowItem                           Item = "arrow"
+	AxolotlBucket                       Item = "axolotl_bucket"
+	AxolotlSpawnEgg                     Item = "axolotl_spawn_egg"
+	AzaleaItem                          Item = "azalea"
+	AzaleaLeavesItem                    Item = "azalea_leaves"
+	AzureBluetItem                      Item = "azure_bluet"
+	BakedPotato                         Item = "baked_potato"
+	BambooItem                          Item = "bamboo"
+	BambooBlockItem                     Item = "bamboo_block"
+	BambooButtonItem                    Item = "bamboo_button"
+	BambooChestRaftItem                 Item = "bamboo_chest_raft"
+	BambooDoorItem                      Item = "bamboo_door"
+	BambooFenceItem                     Item = "bamboo_fence"
+	BambooFenceGateItem                 Item = "bamboo_fence_gate"
+	BambooHangingSignItem               Item = "bamboo_hanging_sign"
+	BambooMosaicItem                    Item = "bamboo_mosaic"
+	BambooMosaicSlabItem                Item = "bamboo_mosaic_slab"
+	BambooMosaicStairsItem              Item = "bamboo_mosaic_stairs"
+	BambooPlanksItem                    Item = "bamboo_planks"
+	BambooPressurePlateItem             Item = "bamboo_pressure_plate"
+	BambooRaftItem                      Item = "bamboo_raft"
+	BambooSignItem                      Item = "bamboo_sign"
+	BambooSlabItem                      Item = "bamboo_slab"
+	BambooStairsItem                    Item = "bamboo_stairs"
+	BambooTrapdoorItem                  Item = "bamboo_trapdoor"
+	BarrelItem                          Item = "barrel"
+	BarrierItem                         Item = "barrier"
+	BasaltItem                          Item = "basalt"
+	BatSpawnEgg                         Item = "bat_spawn_egg"
+	BeaconItem                          Item = "beacon"
+	BedrockItem                         Item = "bedrock"
+	BeeNestItem                         Item = "bee_nest"
+	BeeSpawnEgg                         Item = "bee_spawn_egg"
+	Beef                                Item = "beef"
+	BeehiveItem                         Item = "beehive"
+	Beetroot                            Item = "beetroot"
+	BeetrootSeeds                       Item = "beetroot_seeds"
+	BeetrootSoup                        Item = "beetroot_soup"
+	BellItem                            Item = "bell"
+	BigDripleafItem                     Item = "big_dripleaf"
+	BirchBoatItem                       Item = "birch_boat"
+	BirchButtonItem                     Item = "birch_button"
+	BirchChestBoatItem                  Item = "birch_chest_boat"
+	BirchDoorItem                       Item = "birch_door"
+	BirchFenceItem                      Item = "birch_fence"
+	BirchFenceGateItem                  Item = "birch_fence_gate"
+	BirchHangingSignItem                Item = "birch_hanging_sign"
+	BirchLeavesItem                     Item = "birch_leaves"
+	BirchLogItem                        Item = "birch_log"
+	BirchPlanksItem                     Item = "birch_planks"
+	BirchPressurePlateItem              Item = "birch_pressure_plate"
+	BirchSaplingItem                    Item = "birch_sapling"
+	BirchSignItem                       Item = "birch_sign"
+	BirchSlabItem                       Item = "birch_slab"
+	BirchStairsItem                     Item = "birch_stairs"
+	BirchTrapdoorItem                   Item = "birch_trapdoor"
+	BirchWoodItem                       Item = "birch_wood"
+	BlackBannerItem                     Item = "black_banner"
+	BlackBedItem                        Item = "black_bed"
+	BlackBundle                         Item = "black_bundle"
+	BlackCandleItem                     Item = "black_candle"
+	BlackCarpetItem                     Item = "black_carpet"
+	BlackConcreteItem                   Item = "black_concrete"
+	BlackConcretePowderItem             Item = "black_concrete_powder"
+	BlackDye                            Item = "black_dye"
+	BlackGlazedTerracottaItem           Item = "black_glazed_terracotta"
+	BlackShulkerBoxItem                 Item = "black_shulker_box"
+	BlackStainedGlassItem               Item = "black_stained_glass"
+	BlackStainedGlassPaneItem           Item = "black_stained_glass_pane"
+	BlackTerracottaItem                 Item = "black_terracotta"
+	BlackWoolItem                       Item = "black_wool"
+	BlackstoneItem                      Item = "blackstone"
+	BlackstoneSlabItem                  Item = "blackstone_slab"
+	BlackstoneStairsItem                Item = "blackstone_stairs"
+	BlackstoneWallItem                  Item = "blackstone_wall"
+	BladePotterySherd                   Item = "blade_pottery_sherd"
+	BlastFurnaceItem                    Item = "blast_furnace"
+	BlazePowder                         Item = "blaze_powder"
+	BlazeRod                            Item = "blaze_rod"
+	BlazeSpawnEgg                       Item = "blaze_spawn_egg"
+	BlueBannerItem                      Item = "blue_banner"
+	BlueBedItem                         Item = "blue_bed"
+	BlueBundle                          Item = "blue_bundle"
+	BlueCandleItem                      Item = "blue_candle"
+	BlueCarpetItem                      Item = "blue_carpet"
+	BlueConcreteItem                    Item = "blue_concrete"
+	BlueConcretePowderItem              Item = "blue_concrete_powder"
+	BlueDye                             Item = "blue_dye"
+	BlueGlazedTerracottaItem            Item = "blue_glazed_terracotta"
+	BlueIceItem                         Item = "blue_ice"
+	BlueOrchidItem                      Item = "blue_orchid"
+	BlueShulkerBoxItem                  Item = "blue_shulker_box"
+	BlueStainedGlassItem                Item = "blue_stained_glass"
+	BlueStainedGlassPaneItem            Item = "blue_stained_glass_pane"
+	BlueTerracottaItem                  Item = "blue_terracotta"
+	BlueWoolItem                        Item = "blue_wool"
+	BoggedSpawnEgg                      Item = "bogged_spawn_egg"
+	BoltArmorTrimSmithingTemplate       Item = "bolt_armor_trim_smithing_template"
+	Bone                                Item = "bone"
+	BoneBlockItem                       Item = "bone_block"
+	BoneMeal                            Item = "bone_meal"
+	Book                                Item = "book"
+	BookshelfItem                       Item = "bookshelf"
+	BordureIndentedBannerPattern        Item = "bordure_indented_banner_pattern"
+	Bow                                 Item = "bow"
+	Bowl                                Item = "bowl"
+	BrainCoralItem                      Item = "brain_coral"
+	BrainCoralBlockItem                 Item = "brain_coral_block"
+	BrainCoralFanItem                   Item = "brain_coral_fan"
+	Bread                               Item = "bread"
+	BreezeRod                           Item = "breeze_rod"
+	BreezeSpawnEgg                      Item = "breeze_spawn_egg"
+	BrewerPotterySherd                  Item = "brewer_pottery_sherd"
+	BrewingStandItem                    Item = "brewing_stand"
+	Brick                               Item = "brick"
+	BrickSlabItem                       Item = "brick_slab"
+	BrickStairsItem                     Item = "brick_stairs"
+	BrickWallItem                       Item = "brick_wall"
+	BricksItem                          Item = "bricks"
+	BrownBannerItem                     Item = "brown_banner"
+	BrownBedItem                        Item = "brown_bed"
+	BrownBundle                         Item = "brown_bundle"
+	BrownCandleItem                     Item = "brown_candle"
+	BrownCarpetItem                     Item = "brown_carpet"
+	BrownConcreteItem                   Item = "brown_concrete"
+	BrownConcretePowderItem             Item = "brown_concrete_powder"
+	BrownDye                            Item = "brown_dye"
+	BrownGlazedTerracottaItem           Item = "brown_glazed_terracotta"
+	BrownMushroomItem                   Item = "brown_mushroom"
+	BrownMushroomBlockItem              Item = "brown_mushroom_block"
+	BrownShulkerBoxItem                 Item = "brown_shulker_box"
+	BrownStainedGlassItem               Item = "brown_stained_glass"
+	BrownStainedGlassPaneItem           Item = "brown_stained_glass_pane"
+	BrownTerracottaItem                 Item = "brown_terracotta"
+	BrownWoolItem                       Item = "brown_wool"
+	Brush                               Item = "brush"
+	BubbleCoralItem                     Item = "bubble_coral"
+	BubbleCoralBlockItem                Item = "bubble_coral_block"
+	BubbleCoralFanItem                  Item = "bubble_coral_fan"
+	Bucket                              Item = "bucket"
+	BuddingAmethystItem                 Item = "budding_amethyst"
+	Bundle                              Item = "bundle"
+	BurnPotterySherd                    Item = "burn_pottery_sherd"
+	CactusItem                          Item = "cactus"
+	CakeItem                            Item = "cake"
+	CalciteItem                         Item = "calcite"
+	CalibratedSculkSensorItem           Item = "calibrated_sculk_sensor"
+	CamelSpawnEgg                       Item = "camel_spawn_egg"
+	CampfireItem                        Item = "campfire"
+	CandleItem                          Item = "candle"
+	Carrot                              Item = "carrot"
+	CarrotOnAStick                      Item = "carrot_on_a_stick"
+	CartographyTableItem                Item = "cartography_table"
+	CarvedPumpkinItem                   Item = "carved_pumpkin"
+	CatSpawnEgg                         Item = "cat_spawn_egg"
+	CauldronItem                        Item = "cauldron"
+	CaveSpiderSpawnEgg                  Item = "cave_spider_spawn_egg"
+	ChainItem                           Item = "chain"
+	ChainCommandBlockItem               Item = "chain_command_block"
+	ChainmailBoots                      Item = "chainmail_boots"
+	ChainmailChestplate                 Item = "chainmail_chestplate"
+	ChainmailHelmet                     Item = "chainmail_helmet"
+	ChainmailLeggings                   Item = "chainmail_leggings"
+	Charcoal                            Item = "charcoal"
+	CherryBoatItem                      Item = "cherry_boat"
+	CherryButtonItem                    Item = "cherry_button"
+	CherryChestBoatItem                 Item = "cherry_chest_boat"
+	CherryDoorItem                      Item = "cherry_door"
+	CherryFenceItem                     Item = "cherry_fence"
+	CherryFenceGateItem                 Item = "cherry_fence_gate"
+	CherryHangingSignItem               Item = "cherry_hanging_sign"
+	CherryLeavesItem                    Item = "cherry_leaves"
+	CherryLogItem                       Item = "cherry_log"
+	CherryPlanksItem                    Item = "cherry_planks"
+	CherryPressurePlateItem             Item = "cherry_pressure_plate"
+	CherrySaplingItem                   Item = "cherry_sapling"
+	CherrySignItem                      Item = "cherry_sign"
+	CherrySlabItem                      Item = "cherry_slab"
+	CherryStairsItem                    Item = "cherry_stairs"
+	CherryTrapdoorItem                  Item = "cherry_trapdoor"
+	CherryWoodItem                      Item = "cherry_wood"
+	ChestItem                           Item = "chest"
+	ChestMinecartItem                   Item = "chest_minecart"
+	ChickenItem                         Item = "chicken"
+	ChickenSpawnEgg                     Item = "chicken_spawn_egg"
+	ChippedAnvilItem                    Item = "chipped_anvil"
+	ChiseledBookshelfItem               Item = "chiseled_bookshelf"
+	ChiseledCopperItem                  Item = "chiseled_copper"
+	ChiseledDeepslateItem               Item = "chiseled_deepslate"
+	ChiseledNetherBricksItem            Item = "chiseled_nether_bricks"
+	ChiseledPolishedBlackstoneItem      Item = "chiseled_polished_blackstone"
+	ChiseledQuartzBlockItem             Item = "chiseled_quartz_block"
+	ChiseledRedSandstoneItem            Item = "chiseled_red_sandstone"
+	ChiseledResinBricksItem             Item = "chiseled_resin_bricks"
+	ChiseledSandstoneItem               Item = "chiseled_sandstone"
+	ChiseledStoneBricksItem             Item = "chiseled_stone_bricks"
+	ChiseledTuffItem                    Item = "chiseled_tuff"
+	ChiseledTuffBricksItem              Item = "chiseled_tuff_bricks"
+	ChorusFlowerItem                    Item = "chorus_flower"
+	ChorusFruit                         Item = "chorus_fruit"
+	ChorusPlantItem                     Item = "chorus_plant"
+	ClayItem                            Item = "clay"
+	ClayBall                            Item = "clay_ball"
+	Clock                               Item = "clock"
+	ClosedEyeblossomItem                Item = "closed_eyeblossom"
+	Coal                                Item = "coal"
+	CoalBlockItem                       Item = "coal_block"
+	CoalOreItem                         Item = "coal_ore"
+	CoarseDirtItem                      Item = "coarse_dirt"
+	CoastArmorTrimSmithingTemplate      Item = "coast_armor_trim_smithing_template"
+	CobbledDeepslateItem                Item = "cobbled_deepslate"
+	CobbledDeepslateSlabItem            Item = "cobbled_deepslate_slab"
+	CobbledDeepslateStairsItem          Item = "cobbled_deepslate_stairs"
+	CobbledDeepslateWallItem            Item = "cobbled_deepslate_wall"
+	CobblestoneItem                     Item = "cobblestone"
+	CobblestoneSlabItem                 Item = "cobblestone_slab"
+	CobblestoneStairsItem               Item = "cobblestone_stairs"
+	CobblestoneWallItem                 Item = "cobblestone_wall"
+	CobwebItem                          Item = "cobweb"
+	CocoaBeans                          Item = "cocoa_beans"
+	CodItem                             Item = "cod"
+	CodBucket                           Item = "cod_bucket"
+	CodSpawnEgg                         Item = "cod_spawn_egg"
+	CommandBlockItem                    Item = "command_block"
+	CommandBlockMinecartItem            Item = "command_block_minecart"
+	ComparatorItem                      Item = "comparator"
+	Compass                             Item = "compass"
+	ComposterItem                       Item = "composter"
+	ConduitItem                         Item = "conduit"
+	CookedBeef                          Item = "cooked_beef"
+	CookedChicken                       Item = "cooked_chicken"
+	CookedCod                           Item = "cooked_cod"
+	CookedMutton                        Item = "cooked_mutton"
+	CookedPorkchop                      Item = "cooked_porkchop"
+	CookedRabbit                        Item = "cooked_rabbit"
+	CookedSalmon                        Item = "cooked_salmon"
+	Cookie                              Item = "cookie"
+	CopperBlockItem                     Item = "copper_block"
+	CopperBulbItem                      Item = "copper_bulb"
+	CopperDoorItem                      Item = "copper_door"
+	CopperGrateItem                     Item = "copper_grate"
+	CopperIngot                         Item = "copper_ingot"
+	CopperOreItem                       Item = "copper_ore"
+	CopperTrapdoorItem                  Item = "copper_trapdoor"
+	CornflowerItem                      Item = "cornflower"
+	CowSpawnEgg                         Item = "cow_spawn_egg"
+	CrackedDeepslateBricksItem          Item = "cracked_deepslate_bricks"
+	CrackedDeepslateTilesItem           Item = "cracked_deepslate_tiles"
+	CrackedNetherBricksItem             Item = "cracked_nether_bricks"
+	CrackedPolishedBlackstoneBricksItem Item = "cracked_polished_blackstone_bricks"
+	CrackedStoneBricksItem              Item = "cracked_stone_bricks"
+	CrafterItem                         Item = "crafter"
+	CraftingTableItem                   Item = "crafting_table"
+	CreakingHeartItem                   Item = "creaking_heart"
+	CreakingSpawnEgg                    Item = "creaking_spawn_egg"
+	CreeperBannerPattern                Item = "creeper_banner_pattern"
+	CreeperHeadItem                     Item = "creeper_head"
+	CreeperSpawnEgg                     Item = "creeper_spawn_egg"
+	CrimsonButtonItem                   Item = "crimson_button"
+	CrimsonDoorItem                     Item = "crimson_door"
+	CrimsonFenceItem                    Item = "crimson_fence"
+	CrimsonFenceGateItem                Item = "crimson_fence_gate"
+	CrimsonFungusItem                   Item = "crimson_fungus"
+	CrimsonHangingSignItem              Item = "crimson_hanging_sign"
+	CrimsonHyphaeItem                   Item = "crimson_hyphae"
+	CrimsonNyliumItem                   Item = "crimson_nylium"
+	CrimsonPlanksItem                   Item = "crimson_planks"
+	CrimsonPressurePlateItem            Item = "crimson_pressure_plate"
+	CrimsonRootsItem                    Item = "crimson_roots"
+	CrimsonSignItem                     Item = "crimson_sign"
+	CrimsonSlabItem                     Item = "crimson_slab"
+	CrimsonStairsItem                   Item = "crimson_stairs"
+	CrimsonStemItem                     Item = "crimson_stem"
+	CrimsonTrapdoorItem                 Item = "crimson_trapdoor"
+	Crossbow                            Item = "crossbow"
+	CryingObsidianItem                  Item = "crying_obsidian"
+	CutCopperItem                       Item = "cut_copper"
+	CutCopperSlabItem                   Item = "cut_copper_slab"
+	CutCopperStairsItem                 Item = "cut_copper_stairs"
+	CutRedSandstoneItem                 Item = "cut_red_sandstone"
+	CutRedSandstoneSlabItem             Item = "cut_red_sandstone_slab"
+	CutSandstoneItem                    Item = "cut_sandstone"
+	CutSandstoneSlabItem                Item = "cut_sandstone_slab"
+	CyanBannerItem                      Item = "cyan_banner"
+	CyanBedItem                         Item = "cyan_bed"
+	CyanBundle                          Item = "cyan_bundle"
+	CyanCandleItem                      Item = "cyan_candle"
+	CyanCarpetItem                      Item = "cyan_carpet"
+	CyanConcreteItem                    Item = "cyan_concrete"
+	CyanConcretePowderItem              Item = "cyan_concrete_powder"
+	CyanDye                             Item = "cyan_dye"
+	CyanGlazedTerracottaItem            Item = "cyan_glazed_terracotta"
+	CyanShulkerBoxItem                  Item = "cyan_shulker_box"
+	CyanStainedGlassItem                Item = "cyan_stained_glass"
+	CyanStainedGlassPaneItem            Item = "cyan_stained_glass_pane"
+	CyanTerracottaItem                  Item = "cyan_terracotta"
+	CyanWoolItem                        Item = "cyan_wool"
+	DamagedAnvilItem                    Item = "damaged_anvil"
+	DandelionItem                       Item = "dandelion"
+	DangerPotterySherd                  Item = "danger_pottery_sherd"
+	DarkOakBoatItem                     Item = "dark_oak_boat"
+	DarkOakButtonItem                   Item = "dark_oak_button"
+	DarkOakChestBoatItem                Item = "dark_oak_chest_boat"
+	DarkOakDoorItem                     Item = "dark_oak_door"
+	DarkOakFenceItem                    Item = "dark_oak_fence"
+	DarkOakFenceGateItem                Item = "dark_oak_fence_gate"
+	DarkOakHangingSignItem              Item = "dark_oak_hanging_sign"
+	DarkOakLeavesItem                   Item = "dark_oak_leaves"
+	DarkOakLogItem                      Item = "dark_oak_log"
+	DarkOakPlanksItem                   Item = "dark_oak_planks"
+	DarkOakPressurePlateItem            Item = "dark_oak_pressure_plate"
+	DarkOakSaplingItem                  Item = "dark_oak_sapling"
+	DarkOakSignItem                     Item = "dark_oak_sign"
+	DarkOakSlabItem                     Item = "dark_oak_slab"
+	DarkOakStairsItem                   Item = "dark_oak_stairs"
+	DarkOakTrapdoorItem                 Item = "dark_oak_trapdoor"
+	DarkOakWoodItem                     Item = "dark_oak_wood"
+	DarkPrismarineItem                  Item = "dark_prismarine"
+	DarkPrismarineSlabItem              Item = "dark_prismarine_slab"
+	DarkPrismarineStairsItem            Item = "dark_prismarine_stairs"
+	DaylightDetectorItem                Item = "daylight_detector"
+	DeadBrainCoralItem                  Item = "dead_brain_coral"
+	DeadBrainCoralBlockItem             Item = "dead_brain_coral_block"
+	DeadBrainCoralFanItem               Item = "dead_brain_coral_fan"
+	DeadBubbleCoralItem                 Item = "dead_bubble_coral"
+	DeadBubbleCoralBlockItem            Item = "dead_bubble_coral_block"
+	DeadBubbleCoralFanItem              Item = "dead_bubble_coral_fan"
+	DeadBushItem                        Item = "dead_bush"
+	DeadFireCoralItem                   Item = "dead_fire_coral"
+	DeadFireCoralBlockItem              Item = "dead_fire_coral_block"
+	DeadFireCoralFanItem                Item = "dead_fire_coral_fan"
+	DeadHornCoralItem                   Item = "dead_horn_coral"
+	DeadHornCoralBlockItem              Item = "dead_horn_coral_block"
+	DeadHornCoralFanItem                Item = "dead_horn_coral_fan"
+	DeadTubeCoralItem                   Item = "dead_tube_coral"
+	DeadTubeCoralBlockItem              Item = "dead_tube_coral_block"
+	DeadTubeCoralFanItem                Item = "dead_tube_coral_fan"
+	DebugStick                          Item = "debug_stick"
+	DecoratedPotItem                    Item = "decorated_pot"
+	DeepslateItem                       Item = "deepslate"
+	DeepslateBrickSlabItem              Item = "deepslate_brick_slab"
+	DeepslateBrickStairsItem            Item = "deepslate_brick_stairs"
+	DeepslateBrickWallItem              Item = "deepslate_brick_wall"
+	DeepslateBricksItem                 Item = "deepslate_bricks"
+	DeepslateCoalOreItem                Item = "deepslate_coal_ore"
+	DeepslateCopperOreItem              Item = "deepslate_copper_ore"
+	DeepslateDiamondOreItem             Item = "deepslate_diamond_ore"
+	DeepslateEmeraldOreItem             Item = "deepslate_emerald_ore"
+	DeepslateGoldOreItem                Item = "deepslate_gold_ore"
+	DeepslateIronOreItem                Item = "deepslate_iron_ore"
+	DeepslateLapisOreItem               Item = "deepslate_lapis_ore"
+	DeepslateRedstoneOreItem            Item = "deepslate_redstone_ore"
+	DeepslateTileSlabItem               Item = "deepslate_tile_slab"
+	DeepslateTileStairsItem             Item = "deepslate_tile_stairs"
+	DeepslateTileWallItem               Item = "deepslate_tile_wall"
+	DeepslateTilesItem                  Item = "deepslate_tiles"
+	DetectorRailItem                    Item = "detector_rail"
+	Diamond                             Item = "diamond"
+	DiamondAxe                          Item = "diamond_axe"
+	DiamondBlockItem                    Item = "diamond_block"
+	DiamondBoots                        Item = "diamond_boots"
+	DiamondChestplate                   Item = "diamond_chestplate"
+	DiamondHelmet                       Item = "diamond_helmet"
+	DiamondHoe                          Item = "diamond_hoe"
+	DiamondHorseArmor                   Item = "diamond_horse_armor"
+	DiamondLeggings                     Item = "diamond_leggings"
+	DiamondOreItem                      Item = "diamond_ore"
+	DiamondPickaxe                      Item = "diamond_pickaxe"
+	DiamondShovel                       Item = "diamond_shovel"
+	DiamondSword                        Item = "diamond_sword"
+	DioriteItem                         Item = "diorite"
+	DioriteSlabItem                     Item = "diorite_slab"
+	DioriteStairsItem                   Item = "diorite_stairs"
+	DioriteWallItem                     Item = "diorite_wall"
+	DirtItem                            Item = "dirt"
+	DirtPathItem                        Item = "dirt_path"
+	DiscFragment5                       Item = "disc_fragment_5"
+	DispenserItem                       Item = "dispenser"
+	DolphinSpawnEgg                     Item = "dolphin_spawn_egg"
+	DonkeySpawnEgg                      Item = "donkey_spawn_egg"
+	DragonBreath                        Item = "dragon_breath"
+	DragonEggItem                       Item = "dragon_egg"
+	DragonHeadItem                      Item = "dragon_head"
+	DriedKelp                           Item = "dried_kelp"
+	DriedKelpBlockItem                  Item = "dried_kelp_block"
+	DripstoneBlockItem                  Item = "dripstone_block"
+	DropperItem                         Item = "dropper"
+	DrownedSpawnEgg                     Item = "drowned_spawn_egg"
+	DuneArmorTrimSmithingTemplate       Item = "dune_armor_trim_smithing_template"
+	EchoShard                           Item = "echo_shard"
+	EggItem                             Item = "egg"
+	ElderGuardianSpawnEgg               Item = "elder_guardian_spawn_egg"
+	Elytra                              Item = "elytra"
+	Emerald                             Item = "emerald"
+	EmeraldBlockItem                    Item = "emerald_block"
+	EmeraldOreItem                      Item = "emerald_ore"
+	EnchantedBook                       Item = "enchanted_book"
+	EnchantedGoldenApple                Item = "enchanted_golden_apple"
+	EnchantingTableItem                 Item = "enchanting_table"
+	EndCrystalItem                      Item = "end_crystal"
+	EndPortalFrameItem                  Item = "end_portal_frame"
+	EndRodItem                          Item = "end_rod"
+	EndStoneItem                        Item = "end_stone"
+	EndStoneBrickSlabItem               Item = "end_stone_brick_slab"
+	EndStoneBrickStairsItem             Item = "end_stone_brick_stairs"
+	EndStoneBrickWallItem               Item = "end_stone_brick_wall"
+	EndStoneBricksItem                  Item = "end_stone_bricks"
+	EnderChestItem                      Item = "ender_chest"
+	EnderDragonSpawnEgg                 Item = "ender_dragon_spawn_egg"
+	EnderEye                            Item = "ender_eye"
+	EnderPearlItem                      Item = "ender_pearl"
+	EndermanSpawnEgg                    Item = "enderman_spawn_egg"
+	EndermiteSpawnEgg                   Item = "endermite_spawn_egg"
+	EvokerSpawnEgg                      Item = "evoker_spawn_egg"
+	ExperienceBottleItem                Item = "experience_bottle"
+	ExplorerPotterySherd                Item = "explorer_pottery_sherd"
+	ExposedChiseledCopperItem           Item = "exposed_chiseled_copper"
+	ExposedCopperItem                   Item = "exposed_copper"
+	ExposedCopperBulbItem               Item = "exposed_copper_bulb"
+	ExposedCopperDoorItem               Item = "exposed_copper_door"
+	ExposedCopperGrateItem              Item = "exposed_copper_grate"
+	ExposedCopperTrapdoorItem           Item = "exposed_copper_trapdoor"
+	ExposedCutCopperItem                Item = "exposed_cut_copper"
+	ExposedCutCopperSlabItem            Item = "exposed_cut_copper_slab"
+	ExposedCutCopperStairsItem          Item = "exposed_cut_copper_stairs"
+	EyeArmorTrimSmithingTemplate        Item = "eye_armor_trim_smithing_template"
+	FarmlandItem                        Item = "farmland"
+	Feather                             Item = "feather"
+	FermentedSpiderEye                  Item = "fermented_spider_eye"
+	FernItem                            Item = "fern"
+	FieldMasonedBannerPattern           Item = "field_masoned_banner_pattern"
+	FilledMap                           Item = "filled_map"
+	FireCharge                          Item = "fire_charge"
+	FireCoralItem                       Item = "fire_coral"
+	FireCoralBlockItem                  Item = "fire_coral_block"
+	FireCoralFanItem                    Item = "fire_coral_fan"
+	FireworkRocketItem                  Item = "firework_rocket"
+	FireworkStar                        Item = "firework_star"
+	FishingRod                          Item = "fishing_rod"
+	FletchingTableItem                  Item = "fletching_table"
+	Flint                               Item = "flint"
+	FlintAndSteel                       Item = "flint_and_steel"
+	FlowArmorTrimSmithingTemplate       Item = "flow_armor_trim_smithing_template"
+	FlowBannerPattern                   Item = "flow_banner_pattern"
+	FlowPotterySherd                    Item = "flow_pottery_sherd"
+	FlowerBannerPattern                 Item = "flower_banner_pattern"
+	FlowerPotItem                       Item = "flower_pot"
+	FloweringAzaleaItem                 Item = "flowering_azalea"
+	FloweringAzaleaLeavesItem           Item = "flowering_azalea_leaves"
+	FoxSpawnEgg                         Item = "fox_spawn_egg"
+	FriendPotterySherd                  Item = "friend_pottery_sherd"
+	FrogSpawnEgg                        Item = "frog_spawn_egg"
+	FurnaceItem                         Item = "furnace"
+	FurnaceMinecartItem                 Item = "furnace_minecart"
+	GhastSpawnEgg                       Item = "ghast_spawn_egg"
+	GhastTear                           Item = "ghast_tear"
+	GildedBlackstoneItem                Item = "gilded_blackstone"
+	GlassItem                           Item = "glass"
+	GlassBottle                         Item = "glass_bottle"
+	GlassPaneItem                       Item = "glass_pane"
+	GlisteringMelonSlice                Item = "glistering_melon_slice"
+	GlobeBannerPattern                  Item = "globe_banner_pattern"
+	GlowBerries                         Item = "glow_berries"
+	GlowInkSac                          Item = "glow_ink_sac"
+	GlowItemFrameItem                   Item = "glow_item_frame"
+	GlowLichenItem                      Item = "glow_lichen"
+	GlowSquidSpawnEgg                   Item = "glow_squid_spawn_egg"
+	GlowstoneItem                       Item = "glowstone"
+	GlowstoneDust                       Item = "glowstone_dust"
+	GoatHorn                            Item = "goat_horn"
+	GoatSpawnEgg                        Item = "goat_spawn_egg"
+	GoldBlockItem                       Item = "gold_block"
+	GoldIngot                           Item = "gold_ingot"
+	GoldNugget                          Item = "gold_nugget"
+	GoldOreItem                         Item = "gold_ore"
+	GoldenApple                         Item = "golden_apple"
+	GoldenAxe                           Item = "golden_axe"
+	GoldenBoots                         Item = "golden_boots"
+	GoldenCarrot                        Item = "golden_carrot"
+	GoldenChestplate                    Item = "golden_chestplate"
+	GoldenHelmet                        Item = "golden_helmet"
+	GoldenHoe                           Item = "golden_hoe"
+	GoldenHorseArmor                    Item = "golden_horse_armor"
+	GoldenLeggings                      Item = "golden_leggings"
+	GoldenPickaxe                       Item = "golden_pickaxe"
+	GoldenShovel                        Item = "golden_shovel"
+	GoldenSword                         Item = "golden_sword"
+	GraniteItem                         Item = "granite"
+	GraniteSlabItem                     Item = "granite_slab"
+	GraniteStairsItem                   Item = "granite_stairs"
+	GraniteWallItem                     Item = "granite_wall"
+	GrassBlockItem                      Item = "grass_block"
+	GravelItem                          Item = "gravel"
+	GrayBannerItem                      Item = "gray_banner"
+	GrayBedItem                         Item = "gray_bed"
+	GrayBundle                          Item = "gray_bundle"
+	GrayCandleItem                      Item = "gray_candle"
+	GrayCarpetItem                      Item = "gray_carpet"
+	GrayConcreteItem                    Item = "gray_concrete"
+	GrayConcretePowderItem              Item = "gray_concrete_powder"
+	GrayDye                             Item = "gray_dye"
+	GrayGlazedTerracottaItem            Item = "gray_glazed_terracotta"
+	GrayShulkerBoxItem                  Item = "gray_shulker_box"
+	GrayStainedGlassItem                Item = "gray_stained_glass"
+	GrayStainedGlassPaneItem            Item = "gray_stained_glass_pane"
+	GrayTerracottaItem                  Item = "gray_terracotta"
+	GrayWoolItem                        Item = "gray_wool"
+	GreenBannerItem                     Item = "green_banner"
+	GreenBedItem                        Item = "green_bed"
+	GreenBundle                         Item = "green_bundle"
+	GreenCandleItem                     Item = "green_candle"
+	GreenCarpetItem                     Item = "green_carpet"
+	GreenConcreteItem                   Item = "green_concrete"
+	GreenConcretePowderItem             Item = "green_concrete_powder"
+	GreenDye                            Item = "green_dye"
+	GreenGlazedTerracottaItem           Item = "green_glazed_terracotta"
+	GreenShulkerBoxItem                 Item = "green_shulker_box"
+	GreenStainedGlassItem               Item = "green_stained_glass"
+	GreenStainedGlassPaneItem           Item = "green_stained_glass_pane"
+	GreenTerracottaItem                 Item = "green_terracotta"
+	GreenWoolItem                       Item = "green_wool"
+	GrindstoneItem                      Item = "grindstone"
+	GuardianSpawnEgg                    Item = "guardian_spawn_egg"
+	Gunpowder                           Item = "gunpowder"
+	GusterBannerPattern                 Item = "guster_banner_pattern"
+	GusterPotterySherd                  Item = "guster_pottery_sherd"
+	HangingRootsItem                    Item = "hanging_roots"
+	HayBlockItem                        Item = "hay_block"
+	HeartOfTheSea                       Item = "heart_of_the_sea"
+	HeartPotterySherd                   Item = "heart_pottery_sherd"
+	HeartbreakPotterySherd              Item = "heartbreak_pottery_sherd"
+	HeavyCoreItem                       Item = "heavy_core"
+	HeavyWeightedPressurePlateItem      Item = "heavy_weighted_pressure_plate"
+	HoglinSpawnEgg                      Item = "hoglin_spawn_egg"
+	HoneyBlockItem                      Item = "honey_block"
+	HoneyBottle                         Item = "honey_bottle"
+	Honeycomb                           Item = "honeycomb"
+	HoneycombBlockItem                  Item = "honeycomb_block"
+	HopperItem                          Item = "hopper"
+	HopperMinecartItem                  Item = "hopper_minecart"
+	HornCoralItem                       Item = "horn_coral"
+	HornCoralBlockItem                  Item = "horn_coral_block"
+	HornCoralFanItem                    Item = "horn_coral_fan"
+	HorseSpawnEgg                       Item = "horse_spawn_egg"
+	HostArmorTrimSmithingTemplate       Item = "host_armor_trim_smithing_template"
+	HowlPotterySherd                    Item = "howl_pottery_sherd"
+	HuskSpawnEgg                        Item = "husk_spawn_egg"
+	IceItem                             Item = "ice"
+	InfestedChiseledStoneBricksItem     Item = "infested_chiseled_stone_bricks"
+	InfestedCobblestoneItem             Item = "infested_cobblestone"
+	InfestedCrackedStoneBricksItem      Item = "infested_cracked_stone_bricks"
+	InfestedDeepslateItem               Item = "infested_deepslate"
+	InfestedMossyStoneBricksItem        Item = "infested_mossy_stone_bricks"
+	InfestedStoneItem                   Item = "infested_stone"
+	InfestedStoneBricksItem             Item = "infested_stone_bricks"
+	InkSac                              Item = "ink_sac"
+	IronAxe                             Item = "iron_axe"
+	IronBarsItem                        Item = "iron_bars"
+	IronBlockItem                       Item = "iron_block"
+	IronBoots                           Item = "iron_boots"
+	IronChestplate                      Item = "iron_chestplate"
+	IronDoorItem                        Item = "iron_door"
+	IronGolemSpawnEgg                   Item = "iron_golem_spawn_egg"
+	IronHelmet                          Item = "iron_helmet"
+	IronHoe                             Item = "iron_hoe"
+	IronHorseArmor                      Item = "iron_horse_armor"
+	IronIngot                           Item = "iron_ingot"
+	IronLeggings                        Item = "iron_leggings"
+	IronNugget                          Item = "iron_nugget"
+	IronOreItem                         Item = "iron_ore"
+	IronPickaxe                         Item = "iron_pickaxe"
+	IronShovel                          Item = "iron_shovel"
+	IronSword                           Item = "iron_sword"
+	IronTrapdoorItem                    Item = "iron_trapdoor"
+	ItemFrameItem                       Item = "item_frame"
+	JackOLanternItem                    Item = "jack_o_lantern"
+	JigsawItem                          Item = "jigsaw"
+	JukeboxItem                         Item = "jukebox"
+	JungleBoatItem                      Item = "jungle_boat"
+	JungleButtonItem                    Item = "jungle_button"
+	JungleChestBoatItem                 Item = "jungle_chest_boat"
+	JungleDoorItem                      Item = "jungle_door"
+	JungleFenceItem                     Item = "jungle_fence"
+	JungleFenceGateItem                 Item = "jungle_fence_gate"
+	JungleHangingSignItem               Item = "jungle_hanging_sign"
+	JungleLeavesItem                    Item = "jungle_leaves"
+	JungleLogItem                       Item = "jungle_log"
+	JunglePlanksItem                    Item = "jungle_planks"
+	JunglePressurePlateItem             Item = "jungle_pressure_plate"
+	JungleSaplingItem                   Item = "jungle_sapling"
+	JungleSignItem                      Item = "jungle_sign"
+	JungleSlabItem                      Item = "jungle_slab"
+	JungleStairsItem                    Item = "jungle_stairs"
+	JungleTrapdoorItem                  Item = "jungle_trapdoor"
+	JungleWoodItem                      Item = "jungle_wood"
+	KelpItem                            Item = "kelp"
+	KnowledgeBook                       Item = "knowledge_book"
+	LadderItem                          Item = "ladder"
+	LanternItem                         Item = "lantern"
+	LapisBlockItem                      Item = "lapis_block"
+	LapisLazuli                         Item = "lapis_lazuli"
+	LapisOreItem                        Item = "lapis_ore"
+	LargeAmethystBudItem                Item = "large_amethyst_bud"
+	LargeFernItem                       Item = "large_fern"
+	LavaBucket                          Item = "lava_bucket"
+	Lead                                Item = "lead"
+	Leather                             Item = "leather"
+	LeatherBoots                        Item = "leather_boots"
+	LeatherChestplate                   Item = "leather_chestplate"
+	LeatherHelmet                       Item = "leather_helmet"
+	LeatherHorseArmor                   Item = "leather_horse_armor"
+	LeatherLeggings                     Item = "leather_leggings"
+	LecternItem                         Item = "lectern"
+	LeverItem                           Item = "lever"
+	LightItem                           Item = "light"
+	LightBlueBannerItem                 Item = "light_blue_banner"
+	LightBlueBedItem                    Item = "light_blue_bed"
+	LightBlueBundle                     Item = "light_blue_bundle"
+	LightBlueCandleItem                 Item = "light_blue_candle"
+	LightBlueCarpetItem                 Item = "light_blue_carpet"
+	LightBlueConcreteItem               Item = "light_blue_concrete"
+	LightBlueConcretePowderItem         Item = "light_blue_concrete_powder"
+	LightBlueDye                        Item = "light_blue_dye"
+	LightBlueGlazedTerracottaItem       Item = "light_blue_glazed_terracotta"
+	LightBlueShulkerBoxItem             Item = "light_blue_shulker_box"
+	LightBlueStainedGlassItem           Item = "light_blue_stained_glass"
+	LightBlueStainedGlassPaneItem       Item = "light_blue_stained_glass_pane"
+	LightBlueTerracottaItem             Item = "light_blue_terracotta"
+	LightBlueWoolItem                   Item = "light_blue_wool"
+	LightGrayBannerItem                 Item = "light_gray_banner"
+	LightGrayBedItem                    Item = "light_gray_bed"
+	LightGrayBundle                     Item = "light_gray_bundle"
+	LightGrayCandleItem                 Item = "light_gray_candle"
+	LightGrayCarpetItem                 Item = "light_gray_carpet"
+	LightGrayConcreteItem               Item = "light_gray_concrete"
+	LightGrayConcretePowderItem         Item = "light_gray_concrete_powder"
+	LightGrayDye                        Item = "light_gray_dye"
+	LightGrayGlazedTerracottaItem       Item = "light_gray_glazed_terracotta"
+	LightGrayShulkerBoxItem             Item = "light_gray_shulker_box"
+	LightGrayStainedGlassItem           Item = "light_gray_stained_glass"
+	LightGrayStainedGlassPaneItem       Item = "light_gray_stained_glass_pane"
+	LightGrayTerracottaItem             Item = "light_gray_terracotta"
+	LightGrayWoolItem                   Item = "light_gray_wool"
+	LightWeightedPressurePlateItem      Item = "light_weighted_pressure_plate"
+	LightningRodItem                    Item = "lightning_rod"
+	LilacItem                           Item = "lilac"
+	LilyOfTheValleyItem                 Item = "lily_of_the_valley"
+	LilyPadItem                         Item = "lily_pad"
+	LimeBannerItem                      Item = "lime_banner"
+	LimeBedItem                         Item = "lime_bed"
+	LimeBundle                          Item = "lime_bundle"
+	LimeCandleItem                      Item = "lime_candle"
+	LimeCarpetItem                      Item = "lime_carpet"
+	LimeConcreteItem                    Item = "lime_concrete"
+	LimeConcretePowderItem              Item = "lime_concrete_powder"
+	LimeDye                             Item = "lime_dye"
+	LimeGlazedTerracottaItem            Item = "lime_glazed_terracotta"
+	LimeShulkerBoxItem                  Item = "lime_shulker_box"
+	LimeStainedGlassItem                Item = "lime_stained_glass"
+	LimeStainedGlassPaneItem            Item = "lime_stained_glass_pane"
+	LimeTerracottaItem                  Item = "lime_terracotta"
+	LimeWoolItem                        Item = "lime_wool"
+	LingeringPotion                     Item = "lingering_potion"
+	LlamaSpawnEgg                       Item = "llama_spawn_egg"
+	LodestoneItem                       Item = "lodestone"
+	LoomItem                            Item = "loom"
+	Mace                                Item = "mace"
+	MagentaBannerItem                   Item = "magenta_banner"
+	MagentaBedItem                      Item = "magenta_bed"
+	MagentaBundle                       Item = "magenta_bundle"
+	MagentaCandleItem                   Item = "magenta_candle"
+	MagentaCarpetItem                   Item = "magenta_carpet"
+	MagentaConcreteItem                 Item = "magenta_concrete"
+	MagentaConcretePowderItem           Item = "magenta_concrete_powder"
+	MagentaDye                          Item = "magenta_dye"
+	MagentaGlazedTerracottaItem         Item = "magenta_glazed_terracotta"
+	MagentaShulkerBoxItem               Item = "magenta_shulker_box"
+	MagentaStainedGlassItem             Item = "magenta_stained_glass"
+	MagentaStainedGlassPaneItem         Item = "magenta_stained_glass_pane"
+	MagentaTerracottaItem               Item = "magenta_terracotta"
+	MagentaWoolItem                     Item = "magenta_wool"
+	MagmaBlockItem                      Item = "magma_block"
+	MagmaCream                          Item = "magma_cream"
+	MagmaCubeSpawnEgg                   Item = "magma_cube_spawn_egg"
+	MangroveBoatItem                    Item = "mangrove_boat"
+	MangroveButtonItem                  Item = "mangrove_button"
+	MangroveChestBoatItem               Item = "mangrove_chest_boat"
+	MangroveDoorItem                    Item = "mangrove_door"
+	MangroveFenceItem                   Item = "mangrove_fence"
+	MangroveFenceGateItem               Item = "mangrove_fence_gate"
+	MangroveHangingSignItem             Item = "mangrove_hanging_sign"
+	MangroveLeavesItem                  Item = "mangrove_leaves"
+	MangroveLogItem                     Item = "mangrove_log"
+	MangrovePlanksItem                  Item = "mangrove_planks"
+	MangrovePressurePlateItem           Item = "mangrove_pressure_plate"
+	MangrovePropaguleItem               Item = "mangrove_propagule"
+	MangroveRootsItem                   Item = "mangrove_roots"
+	MangroveSignItem                    Item = "mangrove_sign"
+	MangroveSlabItem                    Item = "mangrove_slab"
+	MangroveStairsItem                  Item = "mangrove_stairs"
+	MangroveTrapdoorItem                Item = "mangrove_trapdoor"
+	MangroveWoodItem                    Item = "mangrove_wood"
+	Map                                 Item = "map"
+	MediumAmethystBudItem               Item = "medium_amethyst_bud"
+	MelonItem                           Item = "melon"
+	MelonSeeds                          Item = "melon_seeds"
+	MelonSlice                          Item = "melon_slice"
+	MilkBucket                          Item = "milk_bucket"
+	MinecartItem                        Item = "minecart"
+	MinerPotterySherd                   Item = "miner_pottery_sherd"
+	MojangBannerPattern                 Item = "mojang_banner_pattern"
+	MooshroomSpawnEgg                   Item = "mooshroom_spawn_egg"
+	MossBlockItem                       Item = "moss_block"
+	MossCarpetItem                      Item = "moss_carpet"
+	MossyCobblestoneItem                Item = "mossy_cobblestone"
+	MossyCobblestoneSlabItem            Item = "mossy_cobblestone_slab"
+	MossyCobblestoneStairsItem          Item = "mossy_cobblestone_stairs"
+	MossyCobblestoneWallItem            Item = "mossy_cobblestone_wall"
+	MossyStoneBrickSlabItem             Item = "mossy_stone_brick_slab"
+	MossyStoneBrickStairsItem           Item = "mossy_stone_brick_stairs"
+	MossyStoneBrickWallItem             Item = "mossy_stone_brick_wall"
+	MossyStoneBricksItem                Item = "mossy_stone_bricks"
+	MournerPotterySherd                 Item = "mourner_pottery_sherd"
+	MudItem                             Item = "mud"
+	MudBrickSlabItem                    Item = "mud_brick_slab"
+	MudBrickStairsItem                  Item = "mud_brick_stairs"
+	MudBrickWallItem                    Item = "mud_brick_wall"
+	MudBricksItem                       Item = "mud_bricks"
+	MuddyMangroveRootsItem              Item = "muddy_mangrove_roots"
+	MuleSpawnEgg                        Item = "mule_spawn_egg"
+	MushroomStemItem                    Item = "mushroom_stem"
+	MushroomStew                        Item = "mushroom_stew"
+	MusicDisc11                         Item = "music_disc_11"
+	MusicDisc13                         Item = "music_disc_13"
+	MusicDisc5                          Item = "music_disc_5"
+	MusicDiscBlocks                     Item = "music_disc_blocks"
+	MusicDiscCat                        Item = "music_disc_cat"
+	MusicDiscChirp                      Item = "music_disc_chirp"
+	MusicDiscCreator                    Item = "music_disc_creator"
+	MusicDiscCreatorMusicBox            Item = "music_disc_creator_music_box"
+	MusicDiscFar                        Item = "music_disc_far"
+	MusicDiscMall                       Item = "music_disc_mall"
+	MusicDiscMellohi                    Item = "music_disc_mellohi"
+	MusicDiscOtherside                  Item = "music_disc_otherside"
+	MusicDiscPigstep                    Item = "music_disc_pigstep"
+	MusicDiscPrecipice                  Item = "music_disc_precipice"
+	MusicDiscRelic                      Item = "music_disc_relic"
+	MusicDiscStal                       Item = "music_disc_stal"
+	MusicDiscStrad                      Item = "music_disc_strad"
+	MusicDiscWait                       Item = "music_disc_wait"
+	MusicDiscWard                       Item = "music_disc_ward"
+	Mutton                              Item = "mutton"
+	MyceliumItem                        Item = "mycelium"
+	NameTag                             Item = "name_tag"
+	NautilusShell                       Item = "nautilus_shell"
+	NetherBrick                         Item = "nether_brick"
+	NetherBrickFenceItem                Item = "nether_brick_fence"
+	NetherBrickSlabItem                 Item = "nether_brick_slab"
+	NetherBrickStairsItem               Item = "nether_brick_stairs"
+	NetherBrickWallItem                 Item = "nether_brick_wall"
+	NetherBricksItem                    Item = "nether_bricks"
+	NetherGoldOreItem                   Item = "nether_gold_ore"
+	NetherQuartzOreItem                 Item = "nether_quartz_ore"
+	NetherSproutsItem                   Item = "nether_sprouts"
+	NetherStar                          Item = "nether_star"
+	NetherWartItem                      Item = "nether_wart"
+	NetherWartBlockItem                 Item = "nether_wart_block"
+	NetheriteAxe                        Item = "netherite_axe"
+	NetheriteBlockItem                  Item = "netherite_block"
+	NetheriteBoots                      Item = "netherite_boots"
+	NetheriteChestplate                 Item = "netherite_chestplate"
+	NetheriteHelmet                     Item = "netherite_helmet"
+	NetheriteHoe                        Item = "netherite_hoe"
+	NetheriteIngot                      Item = "netherite_ingot"
+	NetheriteLeggings                   Item = "netherite_leggings"
+	NetheritePickaxe                    Item = "netherite_pickaxe"
+	NetheriteScrap                      Item = "netherite_scrap"
+	NetheriteShovel                     Item = "netherite_shovel"
+	NetheriteSword                      Item = "netherite_sword"
+	NetheriteUpgradeSmithingTemplate    Item = "netherite_upgrade_smithing_template"
+	NetherrackItem                      Item = "netherrack"
+	NoteBlockItem                       Item = "note_block"
+	OakBoatItem                         Item = "oak_boat"
+	OakButtonItem                       Item = "oak_button"
+	OakChestBoatItem                    Item = "oak_chest_boat"
+	OakDoorItem                         Item = "oak_door"
+	OakFenceItem                        Item = "oak_fence"
+	OakFenceGateItem                    Item = "oak_fence_gate"
+	OakHangingSignItem                  Item = "oak_hanging_sign"
+	OakLeavesItem                       Item = "oak_leaves"
+	OakLogItem                          Item = "oak_log"
+	OakPlanksItem                       Item = "oak_planks"
+	OakPressurePlateItem                Item = "oak_pressure_plate"
+	OakSaplingItem                      Item = "oak_sapling"
+	OakSignItem                         Item = "oak_sign"
+	OakSlabItem                         Item = "oak_slab"
+	OakStairsItem                       Item = "oak_stairs"
+	OakTrapdoorItem                     Item = "oak_trapdoor"
+	OakWoodItem                         Item = "oak_wood"
+	ObserverItem                        Item = "observer"
+	ObsidianItem                        Item = "obsidian"
+	OcelotSpawnEgg                      Item = "ocelot_spawn_egg"
+	OchreFroglightItem                  Item = "ochre_froglight"
+	OminousBottle                       Item = "ominous_bottle"
+	OminousTrialKey                     Item = "ominous_trial_key"
+	OpenEyeblossomItem                  Item = "open_eyeblossom"
+	OrangeBannerItem                    Item = "orange_banner"
+	OrangeBedItem                       Item = "orange_bed"
+	OrangeBundle                        Item = "orange_bundle"
+	OrangeCandleItem                    Item = "orange_candle"
+	OrangeCarpetItem                    Item = "orange_carpet"
+	OrangeConcreteItem                  Item = "orange_concrete"
+	OrangeConcretePowderItem            Item = "orange_concrete_powder"
+	OrangeDye                           Item = "orange_dye"
+	OrangeGlazedTerracottaItem          Item = "orange_glazed_terracotta"
+	OrangeShulkerBoxItem                Item = "orange_shulker_box"
+	OrangeStainedGlassItem              Item = "orange_stained_glass"
+	OrangeStainedGlassPaneItem          Item = "orange_stained_glass_pane"
+	OrangeTerracottaItem                Item = "orange_terracotta"
+	OrangeTulipItem                     Item = "orange_tulip"
+	OrangeWoolItem                      Item = "orange_wool"
+	OxeyeDaisyItem                      Item = "oxeye_daisy"
+	OxidizedChiseledCopperItem          Item = "oxidized_chiseled_copper"
+	OxidizedCopperItem                  Item = "oxidized_copper"
+	OxidizedCopperBulbItem              Item = "oxidized_copper_bulb"
+	OxidizedCopperDoorItem              Item = "oxidized_copper_door"
+	OxidizedCopperGrateItem             Item = "oxidized_copper_grate"
+	OxidizedCopperTrapdoorItem          Item = "oxidized_copper_trapdoor"
+	OxidizedCutCopperItem               Item = "oxidized_cut_copper"
+	OxidizedCutCopperSlabItem           Item = "oxidized_cut_copper_slab"
+	OxidizedCutCopperStairsItem         Item = "oxidized_cut_copper_stairs"
+	PackedIceItem                       Item = "packed_ice"
+	PackedMudItem                       Item = "packed_mud"
+	PaintingItem                        Item = "painting"
+	PaleHangingMossItem                 Item = "pale_hanging_moss"
+	PaleMossBlockItem                   Item = "pale_moss_block"
+	PaleMossCarpetItem                  Item = "pale_moss_carpet"
+	PaleOakBoatItem                     Item = "pale_oak_boat"
+	PaleOakButtonItem                   Item = "pale_oak_button"
+	PaleOakChestBoatItem                Item = "pale_oak_chest_boat"
+	PaleOakDoorItem                     Item = "pale_oak_door"
+	PaleOakFenceItem                    Item = "pale_oak_fence"
+	PaleOakFenceGateItem                Item = "pale_oak_fence_gate"
+	PaleOakHangingSignItem              Item = "pale_oak_hanging_sign"
+	PaleOakLeavesItem                   Item = "pale_oak_leaves"
+	PaleOakLogItem                      Item = "pale_oak_log"
+	PaleOakPlanksItem                   Item = "pale_oak_planks"
+	PaleOakPressurePlateItem            Item = "pale_oak_pressure_plate"
+	PaleOakSaplingItem                  Item = "pale_oak_sapling"
+	PaleOakSignItem                     Item = "pale_oak_sign"
+	PaleOakSlabItem                     Item = "pale_oak_slab"
+	PaleOakStairsItem                   Item = "pale_oak_stairs"
+	PaleOakTrapdoorItem                 Item = "pale_oak_trapdoor"
+	PaleOakWoodItem                     Item = "pale_oak_wood"
+	PandaSpawnEgg                       Item = "panda_spawn_egg"
+	Paper                               Item = "paper"
+	ParrotSpawnEgg                      Item = "parrot_spawn_egg"
+	PearlescentFroglightItem            Item = "pearlescent_froglight"
+	PeonyItem                           Item = "peony"
+	PetrifiedOakSlabItem                Item = "petrified_oak_slab"
+	PhantomMembrane                     Item = "phantom_membrane"
+	PhantomSpawnEgg                     Item = "phantom_spawn_egg"
+	PigSpawnEgg                         Item = "pig_spawn_egg"
+	PiglinBannerPattern                 Item = "piglin_banner_pattern"
+	PiglinBruteSpawnEgg                 Item = "piglin_brute_spawn_egg"
+	PiglinHeadItem                      Item = "piglin_head"
+	PiglinSpawnEgg                      Item = "piglin_spawn_egg"
+	PillagerSpawnEgg                    Item = "pillager_spawn_egg"
+	PinkBannerItem                      Item = "pink_banner"
+	PinkBedItem                         Item = "pink_bed"
+	PinkBundle                          Item = "pink_bundle"
+	PinkCandleItem                      Item = "pink_candle"
+	PinkCarpetItem                      Item = "pink_carpet"
+	PinkConcreteItem                    Item = "pink_concrete"
+	PinkConcretePowderItem              Item = "pink_concrete_powder"
+	PinkDye                             Item = "pink_dye"
+	PinkGlazedTerracottaItem            Item = "pink_glazed_terracotta"
+	PinkPetalsItem                      Item = "pink_petals"
+	PinkShulkerBoxItem                  Item = "pink_shulker_box"
+	PinkStainedGlassItem                Item = "pink_stained_glass"
+	PinkStainedGlassPaneItem            Item = "pink_stained_glass_pane"
+	PinkTerracottaItem                  Item = "pink_terracotta"
+	PinkTulipItem                       Item = "pink_tulip"
+	PinkWoolItem                        Item = "pink_wool"
+	PistonItem                          Item = "piston"
+	PitcherPlantItem                    Item = "pitcher_plant"
+	PitcherPod                          Item = "pitcher_pod"
+	PlayerHeadItem                      Item = "player_head"
+	PlentyPotterySherd                  Item = "plenty_pottery_sherd"
+	PodzolItem                          Item = "podzol"
+	PointedDripstoneItem                Item = "pointed_dripstone"
+	PoisonousPotato                     Item = "poisonous_potato"
+	PolarBearSpawnEgg                   Item = "polar_bear_spawn_egg"
+	PolishedAndesiteItem                Item = "polished_andesite"
+	PolishedAndesiteSlabItem            Item = "polished_andesite_slab"
+	PolishedAndesiteStairsItem          Item = "polished_andesite_stairs"
+	PolishedBasaltItem                  Item = "polished_basalt"
+	PolishedBlackstoneItem              Item = "polished_blackstone"
+	PolishedBlackstoneBrickSlabItem     Item = "polished_blackstone_brick_slab"
+	PolishedBlackstoneBrickStairsItem   Item = "polished_blackstone_brick_stairs"
+	PolishedBlackstoneBrickWallItem     Item = "polished_blackstone_brick_wall"
+	PolishedBlackstoneBricksItem        Item = "polished_blackstone_bricks"
+	PolishedBlackstoneButtonItem        Item = "polished_blackstone_button"
+	PolishedBlackstonePressurePlateItem Item = "polished_blackstone_pressure_plate"
+	PolishedBlackstoneSlabItem          Item = "polished_blackstone_slab"
+	PolishedBlackstoneStairsItem        Item = "polished_blackstone_stairs"
+	PolishedBlackstoneWallItem          Item = "polished_blackstone_wall"
+	PolishedDeepslateItem               Item = "polished_deepslate"
+	PolishedDeepslateSlabItem           Item = "polished_deepslate_slab"
+	PolishedDeepslateStairsItem         Item = "polished_deepslate_stairs"
+	PolishedDeepslateWallItem           Item = "polished_deepslate_wall"
+	PolishedDioriteItem                 Item = "polished_diorite"
+	PolishedDioriteSlabItem             Item = "polished_diorite_slab"
+	PolishedDioriteStairsItem           Item = "polished_diorite_stairs"
+	PolishedGraniteItem                 Item = "polished_granite"
+	PolishedGraniteSlabItem             Item = "polished_granite_slab"
+	PolishedGraniteStairsItem           Item = "polished_granite_stairs"
+	PolishedTuffItem                    Item = "polished_tuff"
+	PolishedTuffSlabItem                Item = "polished_tuff_slab"
+	PolishedTuffStairsItem              Item = "polished_tuff_stairs"
+	PolishedTuffWallItem                Item = "polished_tuff_wall"
+	PoppedChorusFruit                   Item = "popped_chorus_fruit"
+	PoppyItem                           Item = "poppy"
+	Porkchop                            Item = "porkchop"
+	Potato                              Item = "potato"
+	PotionItem                          Item = "potion"
+	PowderSnowBucket                    Item = "powder_snow_bucket"
+	PoweredRailItem                     Item = "powered_rail"
+	PrismarineItem                      Item = "prismarine"
+	PrismarineBrickSlabItem             Item = "prismarine_brick_slab"
+	PrismarineBrickStairsItem           Item = "prismarine_brick_stairs"
+	PrismarineBricksItem                Item = "prismarine_bricks"
+	PrismarineCrystals                  Item = "prismarine_crystals"
+	PrismarineShard                     Item = "prismarine_shard"
+	PrismarineSlabItem                  Item = "prismarine_slab"
+	PrismarineStairsItem                Item = "prismarine_stairs"
+	PrismarineWallItem                  Item = "prismarine_wall"
+	PrizePotterySherd                   Item = "prize_pottery_sherd"
+	PufferfishItem                      Item = "pufferfish"
+	PufferfishBucket                    Item = "pufferfish_bucket"
+	PufferfishSpawnEgg                  Item = "pufferfish_spawn_egg"
+	PumpkinItem                         Item = "pumpkin"
+	PumpkinPie                          Item = "pumpkin_pie"
+	PumpkinSeeds                        Item = "pumpkin_seeds"
+	PurpleBannerItem                    Item = "purple_banner"
+	PurpleBedItem                       Item = "purple_bed"
+	PurpleBundle                        Item = "purple_bundle"
+	PurpleCandleItem                    Item = "purple_candle"
+	PurpleCarpetItem                    Item = "purple_carpet"
+	PurpleConcreteItem                  Item = "purple_concrete"
+	PurpleConcretePowderItem            Item = "purple_concrete_powder"
+	PurpleDye                           Item = "purple_dye"
+	PurpleGlazedTerracottaItem          Item = "purple_glazed_terracotta"
+	PurpleShulkerBoxItem                Item = "purple_shulker_box"
+	PurpleStainedGlassItem              Item = "purple_stained_glass"
+	PurpleStainedGlassPaneItem          Item = "purple_stained_glass_pane"
+	PurpleTerracottaItem                Item = "purple_terracotta"
+	PurpleWoolItem                      Item = "purple_wool"
+	PurpurBlockItem                     Item = "purpur_block"
+	PurpurPillarItem                    Item = "purpur_pillar"
+	PurpurSlabItem                      Item = "purpur_slab"
+	PurpurStairsItem                    Item = "purpur_stairs"
+	Quartz                              Item = "quartz"
+	QuartzBlockItem                     Item = "quartz_block"
+	QuartzBricksItem                    Item = "quartz_bricks"
+	QuartzPillarItem                    Item = "quartz_pillar"
+	QuartzSlabItem                      Item = "quartz_slab"
+	QuartzStairsItem                    Item = "quartz_stairs"
+	RabbitItem                          Item = "rabbit"
+	RabbitFoot                          Item = "rabbit_foot"
+	RabbitHide                          Item = "rabbit_hide"
+	RabbitSpawnEgg                      Item = "rabbit_spawn_egg"
+	RabbitStew                          Item = "rabbit_stew"
+	RailItem                            Item = "rail"
+	RaiserArmorTrimSmithingTemplate     Item = "raiser_armor_trim_smithing_template"
+	RavagerSpawnEgg                     Item = "ravager_spawn_egg"
+	RawCopper                           Item = "raw_copper"
+	RawCopperBlockItem                  Item = "raw_copper_block"
+	RawGold                             Item = "raw_gold"
+	RawGoldBlockItem                    Item = "raw_gold_block"
+	RawIron                             Item = "raw_iron"
+	RawIronBlockItem                    Item = "raw_iron_block"
+	RecoveryCompass                     Item = "recovery_compass"
+	RedBannerItem                       Item = "red_banner"
+	RedBedItem                          Item = "red_bed"
+	RedBundle                           Item = "red_bundle"
+	RedCandleItem                       Item = "red_candle"
+	RedCarpetItem                       Item = "red_carpet"
+	RedConcreteItem                     Item = "red_concrete"
+	RedConcretePowderItem               Item = "red_concrete_powder"
+	RedDye                              Item = "red_dye"
+	RedGlazedTerracottaItem             Item = "red_glazed_terracotta"
+	RedMushroomItem                     Item = "red_mushroom"
+	RedMushroomBlockItem                Item = "red_mushroom_block"
+	RedNetherBrickSlabItem              Item = "red_nether_brick_slab"
+	RedNetherBrickStairsItem            Item = "red_nether_brick_stairs"
+	RedNetherBrickWallItem              Item = "red_nether_brick_wall"
+	RedNetherBricksItem                 Item = "red_nether_bricks"
+	RedSandItem                         Item = "red_sand"
+	RedSandstoneItem                    Item = "red_sandstone"
+	RedSandstoneSlabItem                Item = "red_sandstone_slab"
+	RedSandstoneStairsItem              Item = "red_sandstone_stairs"
+	RedSandstoneWallItem                Item = "red_sandstone_wall"
+	RedShulkerBoxItem                   Item = "red_shulker_box"
+	RedStainedGlassItem                 Item = "red_stained_glass"
+	RedStainedGlassPaneItem             Item = "red_stained_glass_pane"
+	RedTerracottaItem                   Item = "red_terracotta"
+	RedTulipItem                        Item = "red_tulip"
+	RedWoolItem                         Item = "red_wool"
+	Redstone                            Item = "redstone"
+	RedstoneBlockItem                   Item = "redstone_block"
+	RedstoneLampItem                    Item = "redstone_lamp"
+	RedstoneOreItem                     Item = "redstone_ore"
+	RedstoneTorchItem                   Item = "redstone_torch"
+	ReinforcedDeepslateItem             Item = "reinforced_deepslate"
+	RepeaterItem                        Item = "repeater"
+	RepeatingCommandBlockItem           Item = "repeating_command_block"
+	ResinBlockItem                      Item = "resin_block"
+	ResinBrick                          Item = "resin_brick"
+	ResinBrickSlabItem                  Item = "resin_brick_slab"
+	ResinBrickStairsItem                Item = "resin_brick_stairs"
+	ResinBrickWallItem                  Item = "resin_brick_wall"
+	ResinBricksItem                     Item = "resin_bricks"
+	ResinClumpItem                      Item = "resin_clump"
+	RespawnAnchorItem                   Item = "respawn_anchor"
+	RibArmorTrimSmithingTemplate        Item = "rib_armor_trim_smithing_template"
+	RootedDirtItem                      Item = "rooted_dirt"
+	RoseBushItem                        Item = "rose_bush"
+	RottenFlesh                         Item = "rotten_flesh"
+	Saddle                              Item = "saddle"
+	SalmonItem                          Item = "salmon"
+	SalmonBucket                        Item = "salmon_bucket"
+	SalmonSpawnEgg                      Item = "salmon_spawn_egg"
+	SandItem                            Item = "sand"
+	SandstoneItem                       Item = "sandstone"
+	SandstoneSlabItem                   Item = "sandstone_slab"
+	SandstoneStairsItem                 Item = "sandstone_stairs"
+	SandstoneWallItem                   Item = "sandstone_wall"
+	ScaffoldingItem                     Item = "scaffolding"
+	ScrapePotterySherd                  Item = "scrape_pottery_sherd"
+	SculkItem                           Item = "sculk"
+	SculkCatalystItem                   Item = "sculk_catalyst"
+	SculkSensorItem                     Item = "sculk_sensor"
+	SculkShriekerItem                   Item = "sculk_shrieker"
+	SculkVeinItem                       Item = "sculk_vein"
+	SeaLanternItem                      Item = "sea_lantern"
+	SeaPickleItem                       Item = "sea_pickle"
+	SeagrassItem                        Item = "seagrass"
+	SentryArmorTrimSmithingTemplate     Item = "sentry_armor_trim_smithing_template"
+	ShaperArmorTrimSmithingTemplate     Item = "shaper_armor_trim_smithing_template"
+	SheafPotterySherd                   Item = "sheaf_pottery_sherd"
+	Shears                              Item = "shears"
+	SheepSpawnEgg                       Item = "sheep_spawn_egg"
+	ShelterPotterySherd                 Item = "shelter_pottery_sherd"
+	Shield                              Item = "shield"
+	ShortGrassItem                      Item = "short_grass"
+	ShroomlightItem                     Item = "shroomlight"
+	ShulkerBoxItem                      Item = "shulker_box"
+	ShulkerShell                        Item = "shulker_shell"
+	ShulkerSpawnEgg                     Item = "shulker_spawn_egg"
+	SilenceArmorTrimSmithingTemplate    Item = "silence_armor_trim_smithing_template"
+	SilverfishSpawnEgg                  Item = "silverfish_spawn_egg"
+	SkeletonHorseSpawnEgg               Item = "skeleton_horse_spawn_egg"
+	SkeletonSkullItem                   Item = "skeleton_skull"
+	SkeletonSpawnEgg                    Item = "skeleton_spawn_egg"
+	SkullBannerPattern                  Item = "skull_banner_pattern"
+	SkullPotterySherd                   Item = "skull_pottery_sherd"
+	SlimeBall                           Item = "slime_ball"
+	SlimeBlockItem                      Item = "slime_block"
+	SlimeSpawnEgg                       Item = "slime_spawn_egg"
+	SmallAmethystBudItem                Item = "small_amethyst_bud"
+	SmallDripleafItem                   Item = "small_dripleaf"
+	SmithingTableItem                   Item = "smithing_table"
+	SmokerItem                          Item = "smoker"
+	SmoothBasaltItem                    Item = "smooth_basalt"
+	SmoothQuartzItem                    Item = "smooth_quartz"
+	SmoothQuartzSlabItem                Item = "smooth_quartz_slab"
+	SmoothQuartzStairsItem              Item = "smooth_quartz_stairs"
+	SmoothRedSandstoneItem              Item = "smooth_red_sandstone"
+	SmoothRedSandstoneSlabItem          Item = "smooth_red_sandstone_slab"
+	SmoothRedSandstoneStairsItem        Item = "smooth_red_sandstone_stairs"
+	SmoothSandstoneItem                 Item = "smooth_sandstone"
+	SmoothSandstoneSlabItem             Item = "smooth_sandstone_slab"
+	SmoothSandstoneStairsItem           Item = "smooth_sandstone_stairs"
+	SmoothStoneItem                     Item = "smooth_stone"
+	SmoothStoneSlabItem                 Item = "smooth_stone_slab"
+	SnifferEggItem                      Item = "sniffer_egg"
+	SnifferSpawnEgg                     Item = "sniffer_spawn_egg"
+	SnortPotterySherd                   Item = "snort_pottery_sherd"
+	SnoutArmorTrimSmithingTemplate      Item = "snout_armor_trim_smithing_template"
+	SnowItem                            Item = "snow"
+	SnowBlockItem                       Item = "snow_block"
+	SnowGolemSpawnEgg                   Item = "snow_golem_spawn_egg"
+	SnowballItem                        Item = "snowball"
+	SoulCampfireItem                    Item = "soul_campfire"
+	SoulLanternItem                     Item = "soul_lantern"
+	SoulSandItem                        Item = "soul_sand"
+	SoulSoilItem                        Item = "soul_soil"
+	SoulTorchItem                       Item = "soul_torch"
+	SpawnerItem                         Item = "spawner"
+	SpectralArrowItem                   Item = "spectral_arrow"
+	SpiderEye                           Item = "spider_eye"
+	SpiderSpawnEgg                      Item = "spider_spawn_egg"
+	SpireArmorTrimSmithingTemplate      Item = "spire_armor_trim_smithing_template"
+	SplashPotion                        Item = "splash_potion"
+	SpongeItem                          Item = "sponge"
+	SporeBlossomItem                    Item = "spore_blossom"
+	SpruceBoatItem                      Item = "spruce_boat"
+	SpruceButtonItem                    Item = "spruce_button"
+	SpruceChestBoatItem                 Item = "spruce_chest_boat"
+	SpruceDoorItem                      Item = "spruce_door"
+	SpruceFenceItem                     Item = "spruce_fence"
+	SpruceFenceGateItem                 Item = "spruce_fence_gate"
+	SpruceHangingSignItem               Item = "spruce_hanging_sign"
+	SpruceLeavesItem                    Item = "spruce_leaves"
+	SpruceLogItem                       Item = "spruce_log"
+	SprucePlanksItem                    Item = "spruce_planks"
+	SprucePressurePlateItem             Item = "spruce_pressure_plate"
+	SpruceSaplingItem                   Item = "spruce_sapling"
+	SpruceSignItem                      Item = "spruce_sign"
+	SpruceSlabItem                      Item = "spruce_slab"
+	SpruceStairsItem                    Item = "spruce_stairs"
+	SpruceTrapdoorItem                  Item = "spruce_trapdoor"
+	SpruceWoodItem                      Item = "spruce_wood"
+	Spyglass                            Item = "spyglass"
+	SquidSpawnEgg                       Item = "squid_spawn_egg"
+	Stick                               Item = "stick"
+	StickyPistonItem                    Item = "sticky_piston"
+	StoneItem                           Item = "stone"
+	StoneAxe                            Item = "stone_axe"
+	StoneBrickSlabItem                  Item = "stone_brick_slab"
+	StoneBrickStairsItem                Item = "stone_brick_stairs"
+	StoneBrickWallItem                  Item = "stone_brick_wall"
+	StoneBricksItem                     Item = "stone_bricks"
+	StoneButtonItem                     Item = "stone_button"
+	StoneHoe                            Item = "stone_hoe"
+	StonePickaxe                        Item = "stone_pickaxe"
+	StonePressurePlateItem              Item = "stone_pressure_plate"
+	StoneShovel                         Item = "stone_shovel"
+	StoneSlabItem                       Item = "stone_slab"
+	StoneStairsItem                     Item = "stone_stairs"
+	StoneSword                          Item = "stone_sword"
+	StonecutterItem                     Item = "stonecutter"
+	StraySpawnEgg                       Item = "stray_spawn_egg"
+	StriderSpawnEgg                     Item = "strider_spawn_egg"
+	String                              Item = "string"
+	StrippedAcaciaLogItem               Item = "stripped_acacia_log"
+	StrippedAcaciaWoodItem              Item = "stripped_acacia_wood"
+	StrippedBambooBlockItem             Item = "stripped_bamboo_block"
+	StrippedBirchLogItem                Item = "stripped_birch_log"
+	StrippedBirchWoodItem               Item = "stripped_birch_wood"
+	StrippedCherryLogItem               Item = "stripped_cherry_log"
+	StrippedCherryWoodItem              Item = "stripped_cherry_wood"
+	StrippedCrimsonHyphaeItem           Item = "stripped_crimson_hyphae"
+	StrippedCrimsonStemItem             Item = "stripped_crimson_stem"
+	StrippedDarkOakLogItem              Item = "stripped_dark_oak_log"
+	StrippedDarkOakWoodItem             Item = "stripped_dark_oak_wood"
+	StrippedJungleLogItem               Item = "stripped_jungle_log"
+	StrippedJungleWoodItem              Item = "stripped_jungle_wood"
+	StrippedMangroveLogItem             Item = "stripped_mangrove_log"
+	StrippedMangroveWoodItem            Item = "stripped_mangrove_wood"
+	StrippedOakLogItem                  Item = "stripped_oak_log"
+	StrippedOakWoodItem                 Item = "stripped_oak_wood"
+	StrippedPaleOakLogItem              Item = "stripped_pale_oak_log"
+	StrippedPaleOakWoodItem             Item = "stripped_pale_oak_wood"
+	StrippedSpruceLogItem               Item = "stripped_spruce_log"
+	StrippedSpruceWoodItem              Item = "stripped_spruce_wood"
+	StrippedWarpedHyphaeItem            Item = "stripped_warped_hyphae"
+	StrippedWarpedStemItem              Item = "stripped_warped_stem"
+	StructureBlockItem                  Item = "structure_block"
+	StructureVoidItem                   Item = "structure_void"
+	Sugar                               Item = "sugar"
+	SugarCaneItem                       Item = "sugar_cane"
+	SunflowerItem                       Item = "sunflower"
+	SuspiciousGravelItem                Item = "suspicious_gravel"
+	SuspiciousSandItem                  Item = "suspicious_sand"
+	SuspiciousStew                      Item = "suspicious_stew"
+	SweetBerries                        Item = "sweet_berries"
+	TadpoleBucket                       Item = "tadpole_bucket"
+	TadpoleSpawnEgg                     Item = "tadpole_spawn_egg"
+	TallGrassItem                       Item = "tall_grass"
+	TargetItem                          Item = "target"
+	TerracottaItem                      Item = "terracotta"
+	TideArmorTrimSmithingTemplate       Item = "tide_armor_trim_smithing_template"
+	TintedGlassItem                     Item = "tinted_glass"
+	TippedArrow                         Item = "tipped_arrow"
+	TntItem                             Item = "tnt"
+	TntMinecartItem                     Item = "tnt_minecart"
+	TorchItem                           Item = "torch"
+	TorchflowerItem                     Item = "torchflower"
+	TorchflowerSeeds                    Item = "torchflower_seeds"
+	TotemOfUndying                      Item = "totem_of_undying"
+	TraderLlamaSpawnEgg                 Item = "trader_llama_spawn_egg"
+	TrappedChestItem                    Item = "trapped_chest"
+	TrialKey                            Item = "trial_key"
+	TrialSpawnerItem                    Item = "trial_spawner"
+	TridentItem                         Item = "trident"
+	TripwireHookItem                    Item = "tripwire_hook"
+	TropicalFishItem                    Item = "tropical_fish"
+	TropicalFishBucket                  Item = "tropical_fish_bucket"
+	TropicalFishSpawnEgg                Item = "tropical_fish_spawn_egg"
+	TubeCoralItem                       Item = "tube_coral"
+	TubeCoralBlockItem                  Item = "tube_coral_block"
+	TubeCoralFanItem                    Item = "tube_coral_fan"
+	TuffItem                            Item = "tuff"
+	TuffBrickSlabItem                   Item = "tuff_brick_slab"
+	TuffBrickStairsItem                 Item = "tuff_brick_stairs"
+	TuffBrickWallItem                   Item = "tuff_brick_wall"
+	TuffBricksItem                      Item = "tuff_bricks"
+	TuffSlabItem                        Item = "tuff_slab"
+	TuffStairsItem                      Item = "tuff_stairs"
+	TuffWallItem                        Item = "tuff_wall"
+	TurtleEggItem                       Item = "turtle_egg"
+	TurtleHelmet                        Item = "turtle_helmet"
+	TurtleScute                         Item = "turtle_scute"
+	TurtleSpawnEgg                      Item = "turtle_spawn_egg"
+	TwistingVinesItem                   Item = "twisting_vines"
+	VaultItem                           Item = "vault"
+	VerdantFroglightItem                Item = "verdant_froglight"
+	VexArmorTrimSmithingTemplate        Item = "vex_armor_trim_smithing_template"
+	VexSpawnEgg                         Item = "vex_spawn_egg"
+	VillagerSpawnEgg                    Item = "villager_spawn_egg"
+	VindicatorSpawnEgg                  Item = "vindicator_spawn_egg"
+	VineItem                            Item = "vine"
+	WanderingTraderSpawnEgg             Item = "wandering_trader_spawn_egg"
+	WardArmorTrimSmithingTemplate       Item = "ward_armor_trim_smithing_template"
+	WardenSpawnEgg                      Item = "warden_spawn_egg"
+	WarpedButtonItem                    Item = "warped_button"
+	WarpedDoorItem                      Item = "warped_door"
+	WarpedFenceItem                     Item = "warped_fence"
+	WarpedFenceGateItem                 Item = "warped_fence_gate"
+	WarpedFungusItem                    Item = "warped_fungus"
+	WarpedFungusOnAStick                Item = "warped_fungus_on_a_stick"
+	WarpedHangingSignItem               Item = "warped_hanging_sign"
+	WarpedHyphaeItem                    Item = "warped_hyphae"
+	WarpedNyliumItem                    Item = "warped_nylium"
+	WarpedPlanksItem                    Item = "warped_planks"
+	WarpedPressurePlateItem             Item = "warped_pressure_plate"
+	WarpedRootsItem                     Item = "warped_roots"
+	WarpedSignItem                      Item = "warped_sign"
+	WarpedSlabItem                      Item = "warped_slab"
+	WarpedStairsItem                    Item = "warped_stairs"
+	WarpedStemItem                      Item = "warped_stem"
+	WarpedTrapdoorItem                  Item = "warped_trapdoor"
+	WarpedWartBlockItem                 Item = "warped_wart_block"
+	WaterBucket                         Item = "water_bucket"
+	WaxedChiseledCopperItem             Item = "waxed_chiseled_copper"
+	WaxedCopperBlockItem                Item = "waxed_copper_block"
+	WaxedCopperBulbItem                 Item = "waxed_copper_bulb"
+	WaxedCopperDoorItem                 Item = "waxed_copper_door"
+	WaxedCopperGrateItem                Item = "waxed_copper_grate"
+	WaxedCopperTrapdoorItem             Item = "waxed_copper_trapdoor"
+	WaxedCutCopperItem                  Item = "waxed_cut_copper"
+	WaxedCutCopperSlabItem              Item = "waxed_cut_copper_slab"
+	WaxedCutCopperStairsItem            Item = "waxed_cut_copper_stairs"
+	WaxedExposedChiseledCopperItem      Item = "waxed_exposed_chiseled_copper"
+	WaxedExposedCopperItem              Item = "waxed_exposed_copper"
+	WaxedExposedCopperBulbItem          Item = "waxed_exposed_copper_bulb"
+	WaxedExposedCopperDoorItem          Item = "waxed_exposed_copper_door"
+	WaxedExposedCopperGrateItem         Item = "waxed_exposed_copper_grate"
+	WaxedExposedCopperTrapdoorItem      Item = "waxed_exposed_copper_trapdoor"
+	WaxedExposedCutCopperItem           Item = "waxed_exposed_cut_copper"
+	WaxedExposedCutCopperSlabItem       Item = "waxed_exposed_cut_copper_slab"
+	WaxedExposedCutCopperStairsItem     Item = "waxed_exposed_cut_copper_stairs"
+	WaxedOxidizedChiseledCopperItem     Item = "waxed_oxidized_chiseled_copper"
+	WaxedOxidizedCopperItem             Item = "waxed_oxidized_copper"
+	WaxedOxidizedCopperBulbItem         Item = "waxed_oxidized_copper_bulb"
+	WaxedOxidizedCopperDoorItem         Item = "waxed_oxidized_copper_door"
+	WaxedOxidizedCopperGrateItem        Item = "waxed_oxidized_copper_grate"
+	WaxedOxidizedCopperTrapdoorItem     Item = "waxed_oxidized_copper_trapdoor"
+	WaxedOxidizedCutCopperItem          Item = "waxed_oxidized_cut_copper"
+	WaxedOxidizedCutCopperSlabItem      Item = "waxed_oxidized_cut_copper_slab"
+	WaxedOxidizedCutCopperStairsItem    Item = "waxed_oxidized_cut_copper_stairs"
+	WaxedWeatheredChiseledCopperItem    Item = "waxed_weathered_chiseled_copper"
+	WaxedWeatheredCopperItem            Item = "waxed_weathered_copper"
+	WaxedWeatheredCopperBulbItem        Item = "waxed_weathered_copper_bulb"
+	WaxedWeatheredCopperDoorItem        Item = "waxed_weathered_copper_door"
+	WaxedWeatheredCopperGrateItem       Item = "waxed_weathered_copper_grate"
+	WaxedWeatheredCopperTrapdoorItem    Item = "waxed_weathered_copper_trapdoor"
+	WaxedWeatheredCutCopperItem         Item = "waxed_weathered_cut_copper"
+	WaxedWeatheredCutCopperSlabItem     Item = "waxed_weathered_cut_copper_slab"
+	WaxedWeatheredCutCopperStairsItem   Item = "waxed_weathered_cut_copper_stairs"
+	WayfinderArmorTrimSmithingTemplate  Item = "wayfinder_armor_trim_smithing_template"
+	WeatheredChiseledCopperItem         Item = "weathered_chiseled_copper"
+	WeatheredCopperItem                 Item = "weathered_copper"
+	WeatheredCopperBulbItem             Item = "weathered_copper_bulb"
+	WeatheredCopperDoorItem             Item = "weathered_copper_door"
+	WeatheredCopperGrateItem            Item = "weathered_copper_grate"
+	WeatheredCopperTrapdoorItem         Item = "weathered_copper_trapdoor"
+	WeatheredCutCopperItem              Item = "weathered_cut_copper"
+	WeatheredCutCopperSlabItem          Item = "weathered_cut_copper_slab"
+	WeatheredCutCopperStairsItem        Item = "weathered_cut_copper_stairs"
+	WeepingVinesItem                    Item = "weeping_vines"
+	WetSpongeItem                       Item = "wet_sponge"
+	WheatItem                           Item = "wheat"
+	WheatSeeds                          Item = "wheat_seeds"
+	WhiteBannerItem                     Item = "white_banner"
+	WhiteBedItem                        Item = "white_bed"
+	WhiteBundle                         Item = "white_bundle"
+	WhiteCandleItem                     Item = "white_candle"
+	WhiteCarpetItem                     Item = "white_carpet"
+	WhiteConcreteItem                   Item = "white_concrete"
+	WhiteConcretePowderItem             Item = "white_concrete_powder"
+	WhiteDye                            Item = "white_dye"
+	WhiteGlazedTerracottaItem           Item = "white_glazed_terracotta"
+	WhiteShulkerBoxItem                 Item = "white_shulker_box"
+	WhiteStainedGlassItem               Item = "white_stained_glass"
+	WhiteStainedGlassPaneItem           Item = "white_stained_glass_pane"
+	WhiteTerracottaItem                 Item = "white_terracotta"
+	WhiteTulipItem                      Item = "white_tulip"
+	WhiteWoolItem                       Item = "white_wool"
+	WildArmorTrimSmithingTemplate       Item = "wild_armor_trim_smithing_template"
+	WindChargeItem                      Item = "wind_charge"
+	WitchSpawnEgg                       Item = "witch_spawn_egg"
+	WitherRoseItem                      Item = "wither_rose"
+	WitherSkeletonSkullItem             Item = "wither_skeleton_skull"
+	WitherSkeletonSpawnEgg              Item = "wither_skeleton_spawn_egg"
+	WitherSpawnEgg                      Item = "wither_spawn_egg"
+	WolfArmor                           Item = "wolf_armor"
+	WolfSpawnEgg                        Item = "wolf_spawn_egg"
+	WoodenAxe                           Item = "wooden_axe"
+	WoodenHoe                           Item = "wooden_hoe"
+	WoodenPickaxe                       Item = "wooden_pickaxe"
+	WoodenShovel                        Item = "wooden_shovel"
+	WoodenSword                         Item = "wooden_sword"
+	WritableBook                        Item = "writable_book"
+	WrittenBook                         Item = "written_book"
+	YellowBannerItem                    Item = "yellow_banner"
+	YellowBedItem                       Item = "yellow_bed"
+	YellowBundle                        Item = "yellow_bundle"
+	YellowCandleItem                    Item = "yellow_candle"
+	YellowCarpetItem                    Item = "yellow_carpet"
+	YellowConcreteItem                  Item = "yellow_concrete"
+	YellowConcretePowderItem            Item = "yellow_concrete_powder"
+	YellowDye                           Item = "yellow_dye"
+	YellowGlazedTerracottaItem          Item = "yellow_glazed_terracotta"
+	YellowShulkerBoxItem                Item = "yellow_shulker_box"
+	YellowStainedGlassItem              Item = "yellow_stained_glass"
+	YellowStainedGlassPaneItem          Item = "yellow_stained_glass_pane"
+	YellowTerracottaItem                Item = "yellow_terracotta"
+	YellowWoolItem                      Item = "yellow_wool"
+	ZoglinSpawnEgg                      Item = "zoglin_spawn_egg"
+	ZombieHeadItem                      Item = "zombie_head"
+	ZombieHorseSpawnEgg                 Item = "zombie_horse_spawn_egg"
+	ZombieSpawnEgg                      Item = "zombie_spawn_egg"
+	ZombieVillagerSpawnEgg              Item = "zombie_villager_spawn_egg"
+	ZombifiedPiglinSpawnEgg             Item = "zombified_piglin_spawn_egg"
+)
+
+type Sound string
+
+const (
+	AmbientBasaltDeltasAdditions               Sound = "ambient.basalt_deltas.additions"
+	AmbientBasaltDeltasLoop                    Sound = "ambient.basalt_deltas.loop"
+	AmbientBasaltDeltasMood                    Sound = "ambient.basalt_deltas.mood"
+	AmbientCave                                Sound = "ambient.cave"
+	AmbientCrimsonForestAdditions              Sound = "ambient.crimson_forest.additions"
+	AmbientCrimsonForestLoop                   Sound = "ambient.crimson_forest.loop"
+	AmbientCrimsonForestMood                   Sound = "ambient.crimson_forest.mood"
+	AmbientNetherWastesAdditions               Sound = "ambient.nether_wastes.additions"
+	AmbientNetherWastesLoop                    Sound = "ambient.nether_wastes.loop"
+	AmbientNetherWastesMood                    Sound = "ambient.nether_wastes.mood"
+	AmbientSoulSandValleyAdditions             Sound = "ambient.soul_sand_valley.additions"
+	AmbientSoulSandValleyLoop                  Sound = "ambient.soul_sand_valley.loop"
+	AmbientSoulSandValleyMood                  Sound = "ambient.soul_sand_valley.mood"
+	AmbientUnderwaterEnter                     Sound = "ambient.underwater.enter"
+	AmbientUnderwaterExit                      Sound = "ambient.underwater.exit"
+	AmbientUnderwaterLoop                      Sound = "ambient.underwater.loop"
+	AmbientUnderwaterLoopAdditions             Sound = "ambient.underwater.loop.additions"
+	AmbientUnderwaterLoopAdditionsRare         Sound = "ambient.underwater.loop.additions.rare"
+	AmbientUnderwaterLoopAdditionsUltraRare    Sound = "ambient.underwater.loop.additions.ultra_rare"
+	AmbientWarpedForestAdditions               Sound = "ambient.warped_forest.additions"
+	AmbientWarpedForestLoop                    Sound = "ambient.warped_forest.loop"
+	AmbientWarpedForestMood                    Sound = "ambient.warped_forest.mood"
+	BlockAmethystBlockBreak                    Sound = "block.amethyst_block.break"
+	BlockAmethystBlockChime                    Sound = "block.amethyst_block.chime"
+	BlockAmethystBlockFall                     Sound = "block.amethyst_block.fall"
+	BlockAmethystBlockHit                      Sound = "block.amethyst_block.hit"
+	BlockAmethystBlockPlace                    Sound = "block.amethyst_block.place"
+	BlockAmethystBlockResonate                 Sound = "block.amethyst_block.resonate"
+	BlockAmethystBlockStep                     Sound = "block.amethyst_block.step"
+	BlockAmethystClusterBreak                  Sound = "block.amethyst_cluster.break"
+	BlockAmethystClusterFall                   Sound = "block.amethyst_cluster.fall"
+	BlockAmethystClusterHit                    Sound = "block.amethyst_cluster.hit"
+	BlockAmethystClusterPlace                  Sound = "block.amethyst_cluster.place"
+	BlockAmethystClusterStep                   Sound = "block.amethyst_cluster.step"
+	BlockAncientDebrisBreak                    Sound = "block.ancient_debris.break"
+	BlockAncientDebrisFall                     Sound = "block.ancient_debris.fall"
+	BlockAncientDebrisHit                      Sound = "block.ancient_debris.hit"
+	BlockAncientDebrisPlace                    Sound = "block.ancient_debris.place"
+	BlockAncientDebrisStep                     Sound = "block.ancient_debris.step"
+	BlockAnvilBreak                            Sound = "block.anvil.break"
+	BlockAnvilDestroy                          Sound = "block.anvil.destroy"
+	BlockAnvilFall                             Sound = "block.anvil.fall"
+	BlockAnvilHit                              Sound = "block.anvil.hit"
+	BlockAnvilLand                             Sound = "block.anvil.land"
+	BlockAnvilPlace                            Sound = "block.anvil.place"
+	BlockAnvilStep                             Sound = "block.anvil.step"
+	BlockAnvilUse                              Sound = "block.anvil.use"
+	BlockAzaleaBreak                           Sound = "block.azalea.break"
+	BlockAzaleaFall                            Sound = "block.azalea.fall"
+	BlockAzaleaHit                             Sound = "block.azalea.hit"
+	BlockAzaleaPlace                           Sound = "block.azalea.place"
+	BlockAzaleaStep                            Sound = "block.azalea.step"
+	BlockAzaleaLeavesBreak                     Sound = "block.azalea_leaves.break"
+	BlockAzaleaLeavesFall                      Sound = "block.azalea_leaves.fall"
+	BlockAzaleaLeavesHit                       Sound = "block.azalea_leaves.hit"
+	BlockAzaleaLeavesPlace                     Sound = "block.azalea_leaves.place"
+	BlockAzaleaLeavesStep                      Sound = "block.azalea_leaves.step"
+	BlockBambooBreak                           Sound = "block.bamboo.break"
+	BlockBambooFall                            Sound = "block.bamboo.fall"
+	BlockBambooHit                             Sound = "block.bamboo.hit"
+	BlockBambooPlace                           Sound = "block.bamboo.place"
+	BlockBambooStep                            Sound = "block.bamboo.step"
+	BlockBambooSaplingBreak                    Sound = "block.bamboo_sapling.break"
+	BlockBambooSaplingHit                      Sound = "block.bamboo_sapling.hit"
+	BlockBambooSaplingPlace                    Sound = "block.bamboo_sapling.place"
+	BlockBambooWoodBreak                       Sound = "block.bamboo_wood.break"
+	BlockBambooWoodFall                        Sound = "block.bamboo_wood.fall"
+	BlockBambooWoodHit                         Sound = "block.bamboo_wood.hit"
+	BlockBambooWoodPlace                       Sound = "block.bamboo_wood.place"
+	BlockBambooWoodStep                        Sound = "block.bamboo_wood.step"
+	BlockBambooWoodButtonClickOff              Sound = "block.bamboo_wood_button.click_off"
+	BlockBambooWoodButtonClickOn               Sound = "block.bamboo_wood_button.click_on"
+	BlockBambooWoodDoorClose                   Sound = "block.bamboo_wood_door.close"
+	BlockBambooWoodDoorOpen                    Sound = "block.bamboo_wood_door.open"
+	BlockBambooWoodFenceGateClose              Sound = "block.bamboo_wood_fence_gate.close"
+	BlockBambooWoodFenceGateOpen               Sound = "block.bamboo_wood_fence_gate.open"
+	BlockBambooWoodHangingSignBreak            Sound = "block.bamboo_wood_hanging_sign.break"
+	BlockBambooWoodHangingSignFall             Sound = "block.bamboo_wood_hanging_sign.fall"
+	BlockBambooWoodHangingSignHit              Sound = "block.bamboo_wood_hanging_sign.hit"
+	BlockBambooWoodHangingSignPlace            Sound = "block.bamboo_wood_hanging_sign.place"
+	BlockBambooWoodHangingSignStep             Sound = "block.bamboo_wood_hanging_sign.step"
+	BlockBambooWoodPressurePlateClickOff       Sound = "block.bamboo_wood_pressure_plate.click_off"
+	BlockBambooWoodPressurePlateClickOn        Sound = "block.bamboo_wood_pressure_plate.click_on"
+	BlockBambooWoodTrapdoorClose               Sound = "block.bamboo_wood_trapdoor.close"
+	BlockBambooWoodTrapdoorOpen                Sound = "block.bamboo_wood_trapdoor.open"
+	BlockBarrelClose                           Sound = "block.barrel.close"
+	BlockBarrelOpen                            Sound = "block.barrel.open"
+	BlockBasaltBreak                           Sound = "block.basalt.break"
+	BlockBasaltFall                            Sound = "block.basalt.fall"
+	BlockBasaltHit                             Sound = "block.basalt.hit"
+	BlockBasaltPlace                           Sound = "block.basalt.place"
+	BlockBasaltStep                            Sound = "block.basalt.step"
+	BlockBeaconActivate                        Sound = "block.beacon.activate"
+	BlockBeaconAmbient                         Sound = "block.beacon.ambient"
+	BlockBeaconDeactivate                      Sound = "block.beacon.deactivate"
+	BlockBeaconPowerSelect                     Sound = "block.beacon.power_select"
+	BlockBeehiveDrip                           Sound = "block.beehive.drip"
+	BlockBeehiveEnter                          Sound = "block.beehive.enter"
+	BlockBeehiveExit                           Sound = "block.beehive.exit"
+	BlockBeehiveShear                          Sound = "block.beehive.shear"
+	BlockBeehiveWork                           Sound = "block.beehive.work"
+	BlockBellResonate                          Sound = "block.bell.resonate"
+	BlockBellUse                               Sound = "block.bell.use"
+	BlockBigDripleafBreak                      Sound = "block.big_dripleaf.break"
+	BlockBigDripleafFall                       Sound = "block.big_dripleaf.fall"
+	BlockBigDripleafHit                        Sound = "block.big_dripleaf.hit"
+	BlockBigDripleafPlace                      Sound = "block.big_dripleaf.place"
+	BlockBigDripleafStep                       Sound = "block.big_dripleaf.step"
+	BlockBigDripleafTiltDown                   Sound = "block.big_dripleaf.tilt_down"
+	BlockBigDripleafTiltUp                     Sound = "block.big_dripleaf.tilt_up"
+	BlockBlastfurnaceFireCrackle               Sound = "block.blastfurnace.fire_crackle"
+	BlockBoneBlockBreak                        Sound = "block.bone_block.break"
+	BlockBoneBlockFall                         Sound = "block.bone_block.fall"
+	BlockBoneBlockHit                          Sound = "block.bone_block.hit"
+	BlockBoneBlockPlace                        Sound = "block.bone_block.place"
+	BlockBoneBlockStep                         Sound = "block.bone_block.step"
+	BlockBrewingStandBrew                      Sound = "block.brewing_stand.brew"
+	BlockBubbleColumnBubblePop                 Sound = "block.bubble_column.bubble_pop"
+	BlockBubbleColumnUpwardsAmbient            Sound = "block.bubble_column.upwards_ambient"
+	BlockBubbleColumnUpwardsInside             Sound = "block.bubble_column.upwards_inside"
+	BlockBubbleColumnWhirlpoolAmbient          Sound = "block.bubble_column.whirlpool_ambient"
+	BlockBubbleColumnWhirlpoolInside           Sound = "block.bubble_column.whirlpool_inside"
+	BlockCakeAddCandle                         Sound = "block.cake.add_candle"
+	BlockCalciteBreak                          Sound = "block.calcite.break"
+	BlockCalciteFall                           Sound = "block.calcite.fall"
+	BlockCalciteHit                            Sound = "block.calcite.hit"
+	BlockCalcitePlace                          Sound = "block.calcite.place"
+	BlockCalciteStep                           Sound = "block.calcite.step"
+	BlockCampfireCrackle                       Sound = "block.campfire.crackle"
+	BlockCandleAmbient                         Sound = "block.candle.ambient"
+	BlockCandleBreak                           Sound = "block.candle.break"
+	BlockCandleExtinguish                      Sound = "block.candle.extinguish"
+	BlockCandleFall                            Sound = "block.candle.fall"
+	BlockCandleHit                             Sound = "block.candle.hit"
+	BlockCandlePlace                           Sound = "block.candle.place"
+	BlockCandleStep                            Sound = "block.candle.step"
+	BlockCaveVinesBreak                        Sound = "block.cave_vines.break"
+	BlockCaveVinesFall                         Sound = "block.cave_vines.fall"
+	BlockCaveVinesHit                          Sound = "block.cave_vines.hit"
+	BlockCaveVinesPickBerries                  Sound = "block.cave_vines.pick_berries"
+	BlockCaveVinesPlace                        Sound = "block.cave_vines.place"
+	BlockCaveVinesStep                         Sound = "block.cave_vines.step"
+	BlockChainBreak                            Sound = "block.chain.break"
+	BlockChainFall                             Sound = "block.chain.fall"
+	BlockChainHit                              Sound = "block.chain.hit"
+	BlockChainPlace                            Sound = "block.chain.place"
+	BlockChainStep                             Sound = "block.chain.step"
+	BlockCherryLeavesBreak                     Sound = "block.cherry_leaves.break"
+	BlockCherryLeavesFall                      Sound = "block.cherry_leaves.fall"
+	BlockCherryLeavesHit                       Sound = "block.cherry_leaves.hit"
+	BlockCherryLeavesPlace                     Sound = "block.cherry_leaves.place"
+	BlockCherryLeavesStep                      Sound = "block.cherry_leaves.step"
+	BlockCherrySaplingBreak                    Sound = "block.cherry_sapling.break"
+	BlockCherrySaplingFall                     Sound = "block.cherry_sapling.fall"
+	BlockCherrySaplingHit                      Sound = "block.cherry_sapling.hit"
+	BlockCherrySaplingPlace                    Sound = "block.cherry_sapling.place"
+	BlockCherrySaplingStep                     Sound = "block.cherry_sapling.step"
+	BlockCherryWoodBreak                       Sound = "block.cherry_wood.break"
+	BlockCherryWoodFall                        Sound = "block.cherry_wood.fall"
+	BlockCherryWoodHit                         Sound = "block.cherry_wood.hit"
+	BlockCherryWoodPlace                       Sound = "block.cherry_wood.place"
+	BlockCherryWoodStep                        Sound = "block.cherry_wood.step"
+	BlockCherryWoodButtonClickOff              Sound = "block.cherry_wood_button.click_off"
+	BlockCherryWoodButtonClickOn               Sound = "block.cherry_wood_button.click_on"
+	BlockCherryWoodDoorClose                   Sound = "block.cherry_wood_door.close"
+	BlockCherryWoodDoorOpen                    Sound = "block.cherry_wood_door.open"
+	BlockCherryWoodFenceGateClose              Sound = "block.cherry_wood_fence_gate.close"
+	BlockCherryWoodFenceGateOpen               Sound = "block.cherry_wood_fence_gate.open"
+	BlockCherryWoodHangingSignBreak            Sound = "block.cherry_wood_hanging_sign.break"
+	BlockCherryWoodHangingSignFall             Sound = "block.cherry_wood_hanging_sign.fall"
+	BlockCherryWoodHangingSignHit              Sound = "block.cherry_wood_hanging_sign.hit"
+	BlockCherryWoodHangingSignPlace            Sound = "block.cherry_wood_hanging_sign.place"
+	BlockCherryWoodHangingSignStep             Sound = "block.cherry_wood_hanging_sign.step"
+	BlockCherryWoodPressurePlateClickOff       Sound = "block.cherry_wood_pressure_plate.click_off"
+	BlockCherryWoodPressurePlateClickOn        Sound = "block.cherry_wood_pressure_plate.click_on"
+	BlockCherryWoodTrapdoorClose               Sound = "block.cherry_wood_trapdoor.close"
+	BlockCherryWoodTrapdoorOpen                Sound = "block.cherry_wood_trapdoor.open"
+	BlockChestClose                            Sound = "block.chest.close"
+	BlockChestLocked                           Sound = "block.chest.locked"
+	BlockChestOpen                             Sound = "block.chest.open"
+	BlockChiseledBookshelfBreak                Sound = "block.chiseled_bookshelf.break"
+	BlockChiseledBookshelfFall                 Sound = "block.chiseled_bookshelf.fall"
+	BlockChiseledBookshelfHit                  Sound = "block.chiseled_bookshelf.hit"
+	BlockChiseledBookshelfInsert               Sound = "block.chiseled_bookshelf.insert"
+	BlockChiseledBookshelfInsertEnchanted      Sound = "block.chiseled_bookshelf.insert.enchanted"
+	BlockChiseledBookshelfPickup               Sound = "block.chiseled_bookshelf.pickup"
+	BlockChiseledBookshelfPickupEnchanted      Sound = "block.chiseled_bookshelf.pickup.enchanted"
+	BlockChiseledBookshelfPlace                Sound = "block.chiseled_bookshelf.place"
+	BlockChiseledBookshelfStep                 Sound = "block.chiseled_bookshelf.step"
+	BlockChorusFlowerDeath                     Sound = "block.chorus_flower.death"
+	BlockChorusFlowerGrow                      Sound = "block.chorus_flower.grow"
+	BlockComparatorClick                       Sound = "block.comparator.click"
+	BlockComposterEmpty                        Sound = "block.composter.empty"
+	BlockComposterFill                         Sound = "block.composter.fill"
+	BlockComposterFillSuccess                  Sound = "block.composter.fill_success"
+	BlockComposterReady                        Sound = "block.composter.ready"
+	BlockConduitActivate                       Sound = "block.conduit.activate"
+	BlockConduitAmbient                        Sound = "block.conduit.ambient"
+	BlockConduitAmbientShort                   Sound = "block.conduit.ambient.short"
+	BlockConduitAttackTarget                   Sound = "block.conduit.attack.target"
+	BlockConduitDeactivate                     Sound = "block.conduit.deactivate"
+	BlockCopperBreak                           Sound = "block.copper.break"
+	BlockCopperFall                            Sound = "block.copper.fall"
+	BlockCopperHit                             Sound = "block.copper.hit"
+	BlockCopperPlace                           Sound = "block.copper.place"
+	BlockCopperStep                            Sound = "block.copper.step"
+	BlockCopperBulbBreak                       Sound = "block.copper_bulb.break"
+	BlockCopperBulbFall                        Sound = "block.copper_bulb.fall"
+	BlockCopperBulbHit                         Sound = "block.copper_bulb.hit"
+	BlockCopperBulbPlace                       Sound = "block.copper_bulb.place"
+	BlockCopperBulbStep                        Sound = "block.copper_bulb.step"
+	BlockCopperBulbTurnOff                     Sound = "block.copper_bulb.turn_off"
+	BlockCopperBulbTurnOn                      Sound = "block.copper_bulb.turn_on"
+	BlockCopperDoorClose                       Sound = "block.copper_door.close"
+	BlockCopperDoorOpen                        Sound = "block.copper_door.open"
+	BlockCopperGrateBreak                      Sound = "block.copper_grate.break"
+	BlockCopperGrateFall                       Sound = "block.copper_grate.fall"
+	BlockCopperGrateHit                        Sound = "block.copper_grate.hit"
+	BlockCopperGratePlace                      Sound = "block.copper_grate.place"
+	BlockCopperGrateStep                       Sound = "block.copper_grate.step"
+	BlockCopperTrapdoorClose                   Sound = "block.copper_trapdoor.close"
+	BlockCopperTrapdoorOpen                    Sound = "block.copper_trapdoor.open"
+	BlockCoralBlockBreak                       Sound = "block.coral_block.break"
+	BlockCoralBlockFall                        Sound = "block.coral_block.fall"
+	BlockCoralBlockHit                         Sound = "block.coral_block.hit"
+	BlockCoralBlockPlace                       Sound = "block.coral_block.place"
+	BlockCoralBlockStep                        Sound = "block.coral_block.step"
+	BlockCrafterBreak                          Sound = "block.crafter.break"
+	BlockCrafterCraft                          Sound = "block.crafter.craft"
+	BlockCrafterFail                           Sound = "block.crafter.fail"
+	BlockCrafterFall                           Sound = "block.crafter.fall"
+	BlockCrafterHit                            Sound = "block.crafter.hit"
+	BlockCrafterPlace                          Sound = "block.crafter.place"
+	BlockCrafterStep                           Sound = "block.crafter.step"
+	BlockCreakingHeartBreak                    Sound = "block.creaking_heart.break"
+	BlockCreakingHeartFall                     Sound = "block.creaking_heart.fall"
+	BlockCreakingHeartHit                      Sound = "block.creaking_heart.hit"
+	BlockCreakingHeartHurt                     Sound = "block.creaking_heart.hurt"
+	BlockCreakingHeartIdle                     Sound = "block.creaking_heart.idle"
+	BlockCreakingHeartPlace                    Sound = "block.creaking_heart.place"
+	BlockCreakingHeartSpawn                    Sound = "block.creaking_heart.spawn"
+	BlockCreakingHeartStep                     Sound = "block.creaking_heart.step"
+	BlockCropBreak                             Sound = "block.crop.break"
+	BlockDecoratedPotBreak                     Sound = "block.decorated_pot.break"
+	BlockDecoratedPotFall                      Sound = "block.decorated_pot.fall"
+	BlockDecoratedPotHit                       Sound = "block.decorated_pot.hit"
+	BlockDecoratedPotInsert                    Sound = "block.decorated_pot.insert"
+	BlockDecoratedPotInsertFail                Sound = "block.decorated_pot.insert_fail"
+	BlockDecoratedPotPlace                     Sound = "block.decorated_pot.place"
+	BlockDecoratedPotShatter                   Sound = "block.decorated_pot.shatter"
+	BlockDecoratedPotStep                      Sound = "block.decorated_pot.step"
+	BlockDeepslateBreak                        Sound = "block.deepslate.break"
+	BlockDeepslateFall                         Sound = "block.deepslate.fall"
+	BlockDeepslateHit                          Sound = "block.deepslate.hit"
+	BlockDeepslatePlace                        Sound = "block.deepslate.place"
+	BlockDeepslateStep                         Sound = "block.deepslate.step"
+	BlockDeepslateBricksBreak                  Sound = "block.deepslate_bricks.break"
+	BlockDeepslateBricksFall                   Sound = "block.deepslate_bricks.fall"
+	BlockDeepslateBricksHit                    Sound = "block.deepslate_bricks.hit"
+	BlockDeepslateBricksPlace                  Sound = "block.deepslate_bricks.place"
+	BlockDeepslateBricksStep                   Sound = "block.deepslate_bricks.step"
+	BlockDeepslateTilesBreak                   Sound = "block.deepslate_tiles.break"
+	BlockDeepslateTilesFall                    Sound = "block.deepslate_tiles.fall"
+	BlockDeepslateTilesHit                     Sound = "block.deepslate_tiles.hit"
+	BlockDeepslateTilesPlace                   Sound = "block.deepslate_tiles.place"
+	BlockDeepslateTilesStep                    Sound = "block.deepslate_tiles.step"
+	BlockDispenserDispense                     Sound = "block.dispenser.dispense"
+	BlockDispenserFail                         Sound = "block.dispenser.fail"
+	BlockDispenserLaunch                       Sound = "block.dispenser.launch"
+	BlockDripstoneBlockBreak                   Sound = "block.dripstone_block.break"
+	BlockDripstoneBlockFall                    Sound = "block.dripstone_block.fall"
+	BlockDripstoneBlockHit                     Sound = "block.dripstone_block.hit"
+	BlockDripstoneBlockPlace                   Sound = "block.dripstone_block.place"
+	BlockDripstoneBlockStep                    Sound = "block.dripstone_block.step"
+	BlockEnchantmentTableUse                   Sound = "block.enchantment_table.use"
+	BlockEndGatewaySpawn                       Sound = "block.end_gateway.spawn"
+	BlockEndPortalSpawn                        Sound = "block.end_portal.spawn"
+	BlockEndPortalFrameFill                    Sound = "block.end_portal_frame.fill"
+	BlockEnderChestClose                       Sound = "block.ender_chest.close"
+	BlockEnderChestOpen                        Sound = "block.ender_chest.open"
+	BlockEyeblossomClose                       Sound = "block.eyeblossom.close"
+	BlockEyeblossomCloseLong                   Sound = "block.eyeblossom.close_long"
+	BlockEyeblossomIdle                        Sound = "block.eyeblossom.idle"
+	BlockEyeblossomOpen                        Sound = "block.eyeblossom.open"
+	BlockEyeblossomOpenLong                    Sound = "block.eyeblossom.open_long"
+	BlockFenceGateClose                        Sound = "block.fence_gate.close"
+	BlockFenceGateOpen                         Sound = "block.fence_gate.open"
+	BlockFireAmbient                           Sound = "block.fire.ambient"
+	BlockFireExtinguish                        Sound = "block.fire.extinguish"
+	BlockFloweringAzaleaBreak                  Sound = "block.flowering_azalea.break"
+	BlockFloweringAzaleaFall                   Sound = "block.flowering_azalea.fall"
+	BlockFloweringAzaleaHit                    Sound = "block.flowering_azalea.hit"
+	BlockFloweringAzaleaPlace                  Sound = "block.flowering_azalea.place"
+	BlockFloweringAzaleaStep                   Sound = "block.flowering_azalea.step"
+	BlockFroglightBreak                        Sound = "block.froglight.break"
+	BlockFroglightFall                         Sound = "block.froglight.fall"
+	BlockFroglightHit                          Sound = "block.froglight.hit"
+	BlockFroglightPlace                        Sound = "block.froglight.place"
+	BlockFroglightStep                         Sound = "block.froglight.step"
+	BlockFrogspawnBreak                        Sound = "block.frogspawn.break"
+	BlockFrogspawnFall                         Sound = "block.frogspawn.fall"
+	BlockFrogspawnHatch                        Sound = "block.frogspawn.hatch"
+	BlockFrogspawnHit                          Sound = "block.frogspawn.hit"
+	BlockFrogspawnPlace                        Sound = "block.frogspawn.place"
+	BlockFrogspawnStep                         Sound = "block.frogspawn.step"
+	BlockFungusBreak                           Sound = "block.fungus.break"
+	BlockFungusFall                            Sound = "block.fungus.fall"
+	BlockFungusHit                             Sound = "block.fungus.hit"
+	BlockFungusPlace                           Sound = "block.fungus.place"
+	BlockFungusStep                            Sound = "block.fungus.step"
+	BlockFurnaceFireCrackle                    Sound = "block.furnace.fire_crackle"
+	BlockGildedBlackstoneBreak                 Sound = "block.gilded_blackstone.break"
+	BlockGildedBlackstoneFall                  Sound = "block.gilded_blackstone.fall"
+	BlockGildedBlackstoneHit                   Sound = "block.gilded_blackstone.hit"
+	BlockGildedBlackstonePlace                 Sound = "block.gilded_blackstone.place"
+	BlockGildedBlackstoneStep                  Sound = "block.gilded_blackstone.step"
+	BlockGlassBreak                            Sound = "block.glass.break"
+	BlockGlassFall                             Sound = "block.glass.fall"
+	BlockGlassHit                              Sound = "block.glass.hit"
+	BlockGlassPlace                            Sound = "block.glass.place"
+	BlockGlassStep                             Sound = "block.glass.step"
+	BlockGlowLichenBreak                       Sound = "block.glow_lichen.break"
+	BlockGlowLichenFall                        Sound = "block.glow_lichen.fall"
+	BlockGlowLichenHit                         Sound = "block.glow_lichen.hit"
+	BlockGlowLichenPlace                       Sound = "block.glow_lichen.place"
+	BlockGlowLichenStep                        Sound = "block.glow_lichen.step"
+	BlockGrassBreak                            Sound = "block.grass.break"
+	BlockGrassFall                             Sound = "block.grass.fall"
+	BlockGrassHit                              Sound = "block.grass.hit"
+	BlockGrassPlace                            Sound = "block.grass.place"
+	BlockGrassStep                             Sound = "block.grass.step"
+	BlockGravelBreak                           Sound = "block.gravel.break"
+	BlockGravelFall                            Sound = "block.gravel.fall"
+	BlockGravelHit                             Sound = "block.gravel.hit"
+	BlockGravelPlace                           Sound = "block.gravel.place"
+	BlockGravelStep                            Sound = "block.gravel.step"
+	BlockGrindstoneUse                         Sound = "block.grindstone.use"
+	BlockHangingRootsBreak                     Sound = "block.hanging_roots.break"
+	BlockHangingRootsFall                      Sound = "block.hanging_roots.fall"
+	BlockHangingRootsHit                       Sound = "block.hanging_roots.hit"
+	BlockHangingRootsPlace                     Sound = "block.hanging_roots.place"
+	BlockHangingRootsStep                      Sound = "block.hanging_roots.step"
+	BlockHangingSignBreak                      Sound = "block.hanging_sign.break"
+	BlockHangingSignFall                       Sound = "block.hanging_sign.fall"
+	BlockHangingSignHit                        Sound = "block.hanging_sign.hit"
+	BlockHangingSignPlace                      Sound = "block.hanging_sign.place"
+	BlockHangingSignStep                       Sound = "block.hanging_sign.step"
+	BlockHeavyCoreBreak                        Sound = "block.heavy_core.break"
+	BlockHeavyCoreFall                         Sound = "block.heavy_core.fall"
+	BlockHeavyCoreHit                          Sound = "block.heavy_core.hit"
+	BlockHeavyCorePlace                        Sound = "block.heavy_core.place"
+	BlockHeavyCoreStep                         Sound = "block.heavy_core.step"
+	BlockHoneyBlockBreak                       Sound = "block.honey_block.break"
+	BlockHoneyBlockFall                        Sound = "block.honey_block.fall"
+	BlockHoneyBlockHit                         Sound = "block.honey_block.hit"
+	BlockHoneyBlockPlace                       Sound = "block.honey_block.place"
+	BlockHoneyBlockSlide                       Sound = "block.honey_block.slide"
+	BlockHoneyBlockStep                        Sound = "block.honey_block.step"
+	BlockIronDoorClose                         Sound = "block.iron_door.close"
+	BlockIronDoorOpen                          Sound = "block.iron_door.open"
+	BlockIronTrapdoorClose                     Sound = "block.iron_trapdoor.close"
+	BlockIronTrapdoorOpen                      Sound = "block.iron_trapdoor.open"
+	BlockLadderBreak                           Sound = "block.ladder.break"
+	BlockLadderFall                            Sound = "block.ladder.fall"
+	BlockLadderHit                             Sound = "block.ladder.hit"
+	BlockLadderPlace                           Sound = "block.ladder.place"
+	BlockLadderStep                            Sound = "block.ladder.step"
+	BlockLanternBreak                          Sound = "block.lantern.break"
+	BlockLanternFall                           Sound = "block.lantern.fall"
+	BlockLanternHit                            Sound = "block.lantern.hit"
+	BlockLanternPlace                          Sound = "block.lantern.place"
+	BlockLanternStep                           Sound = "block.lantern.step"
+	BlockLargeAmethystBudBreak                 Sound = "block.large_amethyst_bud.break"
+	BlockLargeAmethystBudPlace                 Sound = "block.large_amethyst_bud.place"
+	BlockLavaAmbient                           Sound = "block.lava.ambient"
+	BlockLavaExtinguish                        Sound = "block.lava.extinguish"
+	BlockLavaPop                               Sound = "block.lava.pop"
+	BlockLeverClick                            Sound = "block.lever.click"
+	BlockLilyPadPlace                          Sound = "block.lily_pad.place"
+	BlockLodestoneBreak                        Sound = "block.lodestone.break"
+	BlockLodestoneFall                         Sound = "block.lodestone.fall"
+	BlockLodestoneHit                          Sound = "block.lodestone.hit"
+	BlockLodestonePlace                        Sound = "block.lodestone.place"
+	BlockLodestoneStep                         Sound = "block.lodestone.step"
+	BlockMangroveRootsBreak                    Sound = "block.mangrove_roots.break"
+	BlockMangroveRootsFall                     Sound = "block.mangrove_roots.fall"
+	BlockMangroveRootsHit                      Sound = "block.mangrove_roots.hit"
+	BlockMangroveRootsPlace                    Sound = "block.mangrove_roots.place"
+	BlockMangroveRootsStep                     Sound = "block.mangrove_roots.step"
+	BlockMediumAmethystBudBreak                Sound = "block.medium_amethyst_bud.break"
+	BlockMediumAmethystBudPlace                Sound = "block.medium_amethyst_bud.place"
+	BlockMetalBreak                            Sound = "block.metal.break"
+	BlockMetalFall                             Sound = "block.metal.fall"
+	BlockMetalHit                              Sound = "block.metal.hit"
+	BlockMetalPlace                            Sound = "block.metal.place"
+	BlockMetalStep                             Sound = "block.metal.step"
+	BlockMetalPressurePlateClickOff            Sound = "block.metal_pressure_plate.click_off"
+	BlockMetalPressurePlateClickOn             Sound = "block.metal_pressure_plate.click_on"
+	BlockMossBreak                             Sound = "block.moss.break"
+	BlockMossFall                              Sound = "block.moss.fall"
+	BlockMossHit                               Sound = "block.moss.hit"
+	BlockMossPlace                             Sound = "block.moss.place"
+	BlockMossStep                              Sound = "block.moss.step"
+	BlockMossCarpetBreak                       Sound = "block.moss_carpet.break"
+	BlockMossCarpetFall                        Sound = "block.moss_carpet.fall"
+	BlockMossCarpetHit                         Sound = "block.moss_carpet.hit"
+	BlockMossCarpetPlace                       Sound = "block.moss_carpet.place"
+	BlockMossCarpetStep                        Sound = "block.moss_carpet.step"
+	BlockMudBreak                              Sound = "block.mud.break"
+	BlockMudFall                               Sound = "block.mud.fall"
+	BlockMudHit                                Sound = "block.mud.hit"
+	BlockMudPlace                              Sound = "block.mud.place"
+	BlockMudStep                               Sound = "block.mud.step"
+	BlockMudBricksBreak                        Sound = "block.mud_bricks.break"
+	BlockMudBricksFall                         Sound = "block.mud_bricks.fall"
+	BlockMudBricksHit                          Sound = "block.mud_bricks.hit"
+	BlockMudBricksPlace                        Sound = "block.mud_bricks.place"
+	BlockMudBricksStep                         Sound = "block.mud_bricks.step"
+	BlockMuddyMangroveRootsBreak               Sound = "block.muddy_mangrove_roots.break"
+	BlockMuddyMangroveRootsFall                Sound = "block.muddy_mangrove_roots.fall"
+	BlockMuddyMangroveRootsHit                 Sound = "block.muddy_mangrove_roots.hit"
+	BlockMuddyMangroveRootsPlace               Sound = "block.muddy_mangrove_roots.place"
+	BlockMuddyMangroveRootsStep                Sound = "block.muddy_mangrove_roots.step"
+	BlockNetherBricksBreak                     Sound = "block.nether_bricks.break"
+	BlockNetherBricksFall                      Sound = "block.nether_bricks.fall"
+	BlockNetherBricksHit                       Sound = "block.nether_bricks.hit"
+	BlockNetherBricksPlace                     Sound = "block.nether_bricks.place"
+	BlockNetherBricksStep                      Sound = "block.nether_bricks.step"
+	BlockNetherGoldOreBreak                    Sound = "block.nether_gold_ore.break"
+	BlockNetherGoldOreFall                     Sound = "block.nether_gold_ore.fall"
+	BlockNetherGoldOreHit                      Sound = "block.nether_gold_ore.hit"
+	BlockNetherGoldOrePlace                    Sound = "block.nether_gold_ore.place"
+	BlockNetherGoldOreStep                     Sound = "block.nether_gold_ore.step"
+	BlockNetherOreBreak                        Sound = "block.nether_ore.break"
+	BlockNetherOreFall                         Sound = "block.nether_ore.fall"
+	BlockNetherOreHit                          Sound = "block.nether_ore.hit"
+	BlockNetherOrePlace                        Sound = "block.nether_ore.place"
+	BlockNetherOreStep                         Sound = "block.nether_ore.step"
+	BlockNetherSproutsBreak                    Sound = "block.nether_sprouts.break"
+	BlockNetherSproutsFall                     Sound = "block.nether_sprouts.fall"
+	BlockNetherSproutsHit                      Sound = "block.nether_sprouts.hit"
+	BlockNetherSproutsPlace                    Sound = "block.nether_sprouts.place"
+	BlockNetherSproutsStep                     Sound = "block.nether_sprouts.step"
+	BlockNetherWartBreak                       Sound = "block.nether_wart.break"
+	BlockNetherWoodBreak                       Sound = "block.nether_wood.break"
+	BlockNetherWoodFall                        Sound = "block.nether_wood.fall"
+	BlockNetherWoodHit                         Sound = "block.nether_wood.hit"
+	BlockNetherWoodPlace                       Sound = "block.nether_wood.place"
+	BlockNetherWoodStep                        Sound = "block.nether_wood.step"
+	BlockNetherWoodButtonClickOff              Sound = "block.nether_wood_button.click_off"
+	BlockNetherWoodButtonClickOn               Sound = "block.nether_wood_button.click_on"
+	BlockNetherWoodDoorClose                   Sound = "block.nether_wood_door.close"
+	BlockNetherWoodDoorOpen                    Sound = "block.nether_wood_door.open"
+	BlockNetherWoodFenceGateClose              Sound = "block.nether_wood_fence_gate.close"
+	BlockNetherWoodFenceGateOpen               Sound = "block.nether_wood_fence_gate.open"
+	BlockNetherWoodHangingSignBreak            Sound = "block.nether_wood_hanging_sign.break"
+	BlockNetherWoodHangingSignFall             Sound = "block.nether_wood_hanging_sign.fall"
+	BlockNetherWoodHangingSignHit              Sound = "block.nether_wood_hanging_sign.hit"
+	BlockNetherWoodHangingSignPlace            Sound = "block.nether_wood_hanging_sign.place"
+	BlockNetherWoodHangingSignStep             Sound = "block.nether_wood_hanging_sign.step"
+	BlockNetherWoodPressurePlateClickOff       Sound = "block.nether_wood_pressure_plate.click_off"
+	BlockNetherWoodPressurePlateClickOn        Sound = "block.nether_wood_pressure_plate.click_on"
+	BlockNetherWoodTrapdoorClose               Sound = "block.nether_wood_trapdoor.close"
+	BlockNetherWoodTrapdoorOpen                Sound = "block.nether_wood_trapdoor.open"
+	BlockNetheriteBlockBreak                   Sound = "block.netherite_block.break"
+	BlockNetheriteBlockFall                    Sound = "block.netherite_block.fall"
+	BlockNetheriteBlockHit                     Sound = "block.netherite_block.hit"
+	BlockNetheriteBlockPlace                   Sound = "block.netherite_block.place"
+	BlockNetheriteBlockStep                    Sound = "block.netherite_block.step"
+	BlockNetherrackBreak                       Sound = "block.netherrack.break"
+	BlockNetherrackFall                        Sound = "block.netherrack.fall"
+	BlockNetherrackHit                         Sound = "block.netherrack.hit"
+	BlockNetherrackPlace                       Sound = "block.netherrack.place"
+	BlockNetherrackStep                        Sound = "block.netherrack.step"
+	BlockNoteBlockBanjo                        Sound = "block.note_block.banjo"
+	BlockNoteBlockBasedrum                     Sound = "block.note_block.basedrum"
+	BlockNoteBlockBass                         Sound = "block.note_block.bass"
+	BlockNoteBlockBell                         Sound = "block.note_block.bell"
+	BlockNoteBlockBit                          Sound = "block.note_block.bit"
+	BlockNoteBlockChime                        Sound = "block.note_block.chime"
+	BlockNoteBlockCowBell                      Sound = "block.note_block.cow_bell"
+	BlockNoteBlockDidgeridoo                   Sound = "block.note_block.didgeridoo"
+	BlockNoteBlockFlute                        Sound = "block.note_block.flute"
+	BlockNoteBlockGuitar                       Sound = "block.note_block.guitar"
+	BlockNoteBlockHarp                         Sound = "block.note_block.harp"
+	BlockNoteBlockHat                          Sound = "block.note_block.hat"
+	BlockNoteBlockImitateCreeper               Sound = "block.note_block.imitate.creeper"
+	BlockNoteBlockImitateEnderDragon           Sound = "block.note_block.imitate.ender_dragon"
+	BlockNoteBlockImitatePiglin                Sound = "block.note_block.imitate.piglin"
+	BlockNoteBlockImitateSkeleton              Sound = "block.note_block.imitate.skeleton"
+	BlockNoteBlockImitateWitherSkeleton        Sound = "block.note_block.imitate.wither_skeleton"
+	BlockNoteBlockImitateZombie                Sound = "block.note_block.imitate.zombie"
+	BlockNoteBlockIronXylophone                Sound = "block.note_block.iron_xylophone"
+	BlockNoteBlockPling                        Sound = "block.note_block.pling"
+	BlockNoteBlockSnare                        Sound = "block.note_block.snare"
+	BlockNoteBlockXylophone                    Sound = "block.note_block.xylophone"
+	BlockNyliumBreak                           Sound = "block.nylium.break"
+	BlockNyliumFall                            Sound = "block.nylium.fall"
+	BlockNyliumHit                             Sound = "block.nylium.hit"
+	BlockNyliumPlace                           Sound = "block.nylium.place"
+	BlockNyliumStep                            Sound = "block.nylium.step"
+	BlockPackedMudBreak                        Sound = "block.packed_mud.break"
+	BlockPackedMudFall                         Sound = "block.packed_mud.fall"
+	BlockPackedMudHit                          Sound = "block.packed_mud.hit"
+	BlockPackedMudPlace                        Sound = "block.packed_mud.place"
+	BlockPackedMudStep                         Sound = "block.packed_mud.step"
+	BlockPaleHangingMossIdle                   Sound = "block.pale_hanging_moss.idle"
+	BlockPinkPetalsBreak                       Sound = "block.pink_petals.break"
+	BlockPinkPetalsFall                        Sound = "block.pink_petals.fall"
+	BlockPinkPetalsHit                         Sound = "block.pink_petals.hit"
+	BlockPinkPetalsPlace                       Sound = "block.pink_petals.place"
+	BlockPinkPetalsStep                        Sound = "block.pink_petals.step"
+	BlockPistonContract                        Sound = "block.piston.contract"
+	BlockPistonExtend                          Sound = "block.piston.extend"
+	BlockPointedDripstoneBreak                 Sound = "block.pointed_dripstone.break"
+	BlockPointedDripstoneDripLava              Sound = "block.pointed_dripstone.drip_lava"
+	BlockPointedDripstoneDripLavaIntoCauldron  Sound = "block.pointed_dripstone.drip_lava_into_cauldron"
+	BlockPointedDripstoneDripWater             Sound = "block.pointed_dripstone.drip_water"
+	BlockPointedDripstoneDripWaterIntoCauldron Sound = "block.pointed_dripstone.drip_water_into_cauldron"
+	BlockPointedDripstoneFall                  Sound = "block.pointed_dripstone.fall"
+	BlockPointedDripstoneHit                   Sound = "block.pointed_dripstone.hit"
+	BlockPointedDripstoneLand                  Sound = "block.pointed_dripstone.land"
+	BlockPointedDripstonePlace                 Sound = "block.pointed_dripstone.place"
+	BlockPointedDripstoneStep                  Sound = "block.pointed_dripstone.step"
+	BlockPolishedDeepslateBreak                Sound = "block.polished_deepslate.break"
+	BlockPolishedDeepslateFall                 Sound = "block.polished_deepslate.fall"
+	BlockPolishedDeepslateHit                  Sound = "block.polished_deepslate.hit"
+	BlockPolishedDeepslatePlace                Sound = "block.polished_deepslate.place"
+	BlockPolishedDeepslateStep                 Sound = "block.polished_deepslate.step"
+	BlockPolishedTuffBreak                     Sound = "block.polished_tuff.break"
+	BlockPolishedTuffFall                      Sound = "block.polished_tuff.fall"
+	BlockPolishedTuffHit                       Sound = "block.polished_tuff.hit"
+	BlockPolishedTuffPlace                     Sound = "block.polished_tuff.place"
+	BlockPolishedTuffStep                      Sound = "block.polished_tuff.step"
+	BlockPortalAmbient                         Sound = "block.portal.ambient"
+	BlockPortalTravel                          Sound = "block.portal.travel"
+	BlockPortalTrigger                         Sound = "block.portal.trigger"
+	BlockPowderSnowBreak                       Sound = "block.powder_snow.break"
+	BlockPowderSnowFall                        Sound = "block.powder_snow.fall"
+	BlockPowderSnowHit                         Sound = "block.powder_snow.hit"
+	BlockPowderSnowPlace                       Sound = "block.powder_snow.place"
+	BlockPowderSnowStep                        Sound = "block.powder_snow.step"
+	BlockPumpkinCarve                          Sound = "block.pumpkin.carve"
+	BlockRedstoneTorchBurnout                  Sound = "block.redstone_torch.burnout"
+	BlockResinBreak                            Sound = "block.resin.break"
+	BlockResinPlace                            Sound = "block.resin.place"
+	BlockResinStep                             Sound = "block.resin.step"
+	BlockResinBricksBreak                      Sound = "block.resin_bricks.break"
+	BlockResinBricksFall                       Sound = "block.resin_bricks.fall"
+	BlockResinBricksHit                        Sound = "block.resin_bricks.hit"
+	BlockResinBricksPlace                      Sound = "block.resin_bricks.place"
+	BlockResinBricksStep                       Sound = "block.resin_bricks.step"
+	BlockRespawnAnchorAmbient                  Sound = "block.respawn_anchor.ambient"
+	BlockRespawnAnchorCharge                   Sound = "block.respawn_anchor.charge"
+	BlockRespawnAnchorDeplete                  Sound = "block.respawn_anchor.deplete"
+	BlockRespawnAnchorSetSpawn                 Sound = "block.respawn_anchor.set_spawn"
+	BlockRootedDirtBreak                       Sound = "block.rooted_dirt.break"
+	BlockRootedDirtFall                        Sound = "block.rooted_dirt.fall"
+	BlockRootedDirtHit                         Sound = "block.rooted_dirt.hit"
+	BlockRootedDirtPlace                       Sound = "block.rooted_dirt.place"
+	BlockRootedDirtStep                        Sound = "block.rooted_dirt.step"
+	BlockRootsBreak                            Sound = "block.roots.break"
+	BlockRootsFall                             Sound = "block.roots.fall"
+	BlockRootsHit                              Sound = "block.roots.hit"
+	BlockRootsPlace                            Sound = "block.roots.place"
+	BlockRootsStep                             Sound = "block.roots.step"
+	BlockSandBreak                             Sound = "block.sand.break"
+	BlockSandFall                              Sound = "block.sand.fall"
+	BlockSandHit                               Sound = "block.sand.hit"
+	BlockSandPlace                             Sound = "block.sand.place"
+	BlockSandStep                              Sound = "block.sand.step"
+	BlockScaffoldingBreak                      Sound = "block.scaffolding.break"
+	BlockScaffoldingFall                       Sound = "block.scaffolding.fall"
+	BlockScaffoldingHit                        Sound = "block.scaffolding.hit"
+	BlockScaffoldingPlace                      Sound = "block.scaffolding.place"
+	BlockScaffoldingStep                       Sound = "block.scaffolding.step"
+	BlockSculkBreak                            Sound = "block.sculk.break"
+	BlockSculkCharge                           Sound = "block.sculk.charge"
+	BlockSculkFall                             Sound = "block.sculk.fall"
+	BlockSculkHit                              Sound = "block.sculk.hit"
+	BlockSculkPlace                            Sound = "block.sculk.place"
+	BlockSculkSpread                           Sound = "block.sculk.spread"
+	BlockSculkStep                             Sound = "block.sculk.step"
+	BlockSculkCatalystBloom                    Sound = "block.sculk_catalyst.bloom"
+	BlockSculkCatalystBreak                    Sound = "block.sculk_catalyst.break"
+	BlockSculkCatalystFall                     Sound = "block.sculk_catalyst.fall"
+	BlockSculkCatalystHit                      Sound = "block.sculk_catalyst.hit"
+	BlockSculkCatalystPlace                    Sound = "block.sculk_catalyst.place"
+	BlockSculkCatalystStep                     Sound = "block.sculk_catalyst.step"
+	BlockSculkSensorBreak                      Sound = "block.sculk_sensor.break"
+	BlockSculkSensorClicking                   Sound = "block.sculk_sensor.clicking"
+	BlockSculkSensorClickingStop               Sound = "block.sculk_sensor.clicking_stop"
+	BlockSculkSensorFall                       Sound = "block.sculk_sensor.fall"
+	BlockSculkSensorHit                        Sound = "block.sculk_sensor.hit"
+	BlockSculkSensorPlace                      Sound = "block.sculk_sensor.place"
+	BlockSculkSensorStep                       Sound = "block.sculk_sensor.step"
+	BlockSculkShriekerBreak                    Sound = "block.sculk_shrieker.break"
+	BlockSculkShriekerFall                     Sound = "block.sculk_shrieker.fall"
+	BlockSculkShriekerHit                      Sound = "block.sculk_shrieker.hit"
+	BlockSculkShriekerPlace                    Sound = "block.sculk_shrieker.place"
+	BlockSculkShriekerShriek                   Sound = "block.sculk_shrieker.shriek"
+	BlockSculkShriekerStep                     Sound = "block.sculk_shrieker.step"
+	BlockSculkVeinBreak                        Sound = "block.sculk_vein.break"
+	BlockSculkVeinFall                         Sound = "block.sculk_vein.fall"
+	BlockSculkVeinHit                          Sound = "block.sculk_vein.hit"
+	BlockSculkVeinPlace                        Sound = "block.sculk_vein.place"
+	BlockSculkVeinStep                         Sound = "block.sculk_vein.step"
+	BlockShroomlightBreak                      Sound = "block.shroomlight.break"
+	BlockShroomlightFall                       Sound = "block.shroomlight.fall"
+	BlockShroomlightHit                        Sound = "block.shroomlight.hit"
+	BlockShroomlightPlace                      Sound = "block.shroomlight.place"
+	BlockShroomlightStep                       Sound = "block.shroomlight.step"
+	BlockShulkerBoxClose                       Sound = "block.shulker_box.close"
+	BlockShulkerBoxOpen                        Sound = "block.shulker_box.open"
+	BlockSlimeBlockBreak                       Sound = "block.slime_block.break"
+	BlockSlimeBlockFall                        Sound = "block.slime_block.fall"
+	BlockSlimeBlockHit                         Sound = "block.slime_block.hit"
+	BlockSlimeBlockPlace                       Sound = "block.slime_block.place"
+	BlockSlimeBlockStep                        Sound = "block.slime_block.step"
+	BlockSmallAmethystBudBreak                 Sound = "block.small_amethyst_bud.break"
+	BlockSmallAmethystBudPlace                 Sound = "block.small_amethyst_bud.place"
+	BlockSmallDripleafBreak                    Sound = "block.small_dripleaf.break"
+	BlockSmallDripleafFall                     Sound = "block.small_dripleaf.fall"
+	BlockSmallDripleafHit                      Sound = "block.small_dripleaf.hit"
+	BlockSmallDripleafPlace                    Sound = "block.small_dripleaf.place"
+	BlockSmallDripleafStep                     Sound = "block.small_dripleaf.step"
+	BlockSmithingTableUse                      Sound = "block.smithing_table.use"
+	BlockSmokerSmoke                           Sound = "block.smoker.smoke"
+	BlockSnifferEggCrack                       Sound = "block.sniffer_egg.crack"
+	BlockSnifferEggHatch                       Sound = "block.sniffer_egg.hatch"
+	BlockSnifferEggPlop                        Sound = "block.sniffer_egg.plop"
+	BlockSnowBreak                             Sound = "block.snow.break"
+	BlockSnowFall                              Sound = "block.snow.fall"
+	BlockSnowHit                               Sound = "block.snow.hit"
+	BlockSnowPlace                             Sound = "block.snow.place"
+	BlockSnowStep                              Sound = "block.snow.step"
+	BlockSoulSandBreak                         Sound = "block.soul_sand.break"
+	BlockSoulSandFall                          Sound = "block.soul_sand.fall"
+	BlockSoulSandHit                           Sound = "block.soul_sand.hit"
+	BlockSoulSandPlace                         Sound = "block.soul_sand.place"
+	BlockSoulSandStep                          Sound = "block.soul_sand.step"
+	BlockSoulSoilBreak                         Sound = "block.soul_soil.break"
+	BlockSoulSoilFall                          Sound = "block.soul_soil.fall"
+	BlockSoulSoilHit                           Sound = "block.soul_soil.hit"
+	BlockSoulSoilPlace                         Sound = "block.soul_soil.place"
+	BlockSoulSoilStep                          Sound = "block.soul_soil.step"
+	BlockSpawnerBreak                          Sound = "block.spawner.break"
+	BlockSpawnerFall                           Sound = "block.spawner.fall"
+	BlockSpawnerHit                            Sound = "block.spawner.hit"
+	BlockSpawnerPlace                          Sound = "block.spawner.place"
+	BlockSpawnerStep                           Sound = "block.spawner.step"
+	BlockSpongeAbsorb                          Sound = "block.sponge.absorb"
+	BlockSpongeBreak                           Sound = "block.sponge.break"
+	BlockSpongeFall                            Sound = "block.sponge.fall"
+	BlockSpongeHit                             Sound = "block.sponge.hit"
+	BlockSpongePlace                           Sound = "block.sponge.place"
+	BlockSpongeStep                            Sound = "block.sponge.step"
+	BlockSporeBlossomBreak                     Sound = "block.spore_blossom.break"
+	BlockSporeBlossomFall                      Sound = "block.spore_blossom.fall"
+	BlockSporeBlossomHit                       Sound = "block.spore_blossom.hit"
+	BlockSporeBlossomPlace                     Sound = "block.spore_blossom.place"
+	BlockSporeBlossomStep                      Sound = "block.spore_blossom.step"
+	BlockStemBreak                             Sound = "block.stem.break"
+	BlockStemFall                              Sound = "block.stem.fall"
+	BlockStemHit                               Sound = "block.stem.hit"
+	BlockStemPlace                             Sound = "block.stem.place"
+	BlockStemStep                              Sound = "block.stem.step"
+	BlockStoneBreak                            Sound = "block.stone.break"
+	BlockStoneFall                             Sound = "block.stone.fall"
+	BlockStoneHit                              Sound = "block.stone.hit"
+	BlockStonePlace                            Sound = "block.stone.place"
+	BlockStoneStep                             Sound = "block.stone.step"
+	BlockStoneButtonClickOff                   Sound = "block.stone_button.click_off"
+	BlockStoneButtonClickOn                    Sound = "block.stone_button.click_on"
+	BlockStonePressurePlateClickOff            Sound = "block.stone_pressure_plate.click_off"
+	BlockStonePressurePlateClickOn             Sound = "block.stone_pressure_plate.click_on"
+	BlockSuspiciousGravelBreak                 Sound = "block.suspicious_gravel.break"
+	BlockSuspiciousGravelFall                  Sound = "block.suspicious_gravel.fall"
+	BlockSuspiciousGravelHit                   Sound = "block.suspicious_gravel.hit"
+	BlockSuspiciousGravelPlace                 Sound = "block.suspicious_gravel.place"
+	BlockSuspiciousGravelStep                  Sound = "block.suspicious_gravel.step"
+	BlockSuspiciousSandBreak                   Sound = "block.suspicious_sand.break"
+	BlockSuspiciousSandFall                    Sound = "block.suspicious_sand.fall"
+	BlockSuspiciousSandHit                     Sound = "block.suspicious_sand.hit"
+	BlockSuspiciousSandPlace                   Sound = "block.suspicious_sand.place"
+	BlockSuspiciousSandStep                    Sound = "block.suspicious_sand.step"
+	BlockSweetBerryBushBreak                   Sound = "block.sweet_berry_bush.break"
+	BlockSweetBerryBushPickBerries             Sound = "block.sweet_berry_bush.pick_berries"
+	BlockSweetBerryBushPlace                   Sound = "block.sweet_berry_bush.place"
+	BlockTrialSpawnerAboutToSpawnItem          Sound = "block.trial_spawner.about_to_spawn_item"
+	BlockTrialSpawnerAmbient                   Sound = "block.trial_spawner.ambient"
+	BlockTrialSpawnerAmbientOminous            Sound = "block.trial_spawner.ambient_ominous"
+	BlockTrialSpawnerBreak                     Sound = "block.trial_spawner.break"
+	BlockTrialSpawnerCloseShutter              Sound = "block.trial_spawner.close_shutter"
+	BlockTrialSpawnerDetectPlayer              Sound = "block.trial_spawner.detect_player"
+	BlockTrialSpawnerEjectItem                 Sound = "block.trial_spawner.eject_item"
+	BlockTrialSpawnerFall                      Sound = "block.trial_spawner.fall"
+	BlockTrialSpawnerHit                       Sound = "block.trial_spawner.hit"
+	BlockTrialSpawnerOminousActivate           Sound = "block.trial_spawner.ominous_activate"
+	BlockTrialSpawnerOpenShutter               Sound = "block.trial_spawner.open_shutter"
+	BlockTrialSpawnerPlace                     Sound = "block.trial_spawner.place"
+	BlockTrialSpawnerSpawnItem                 Sound = "block.trial_spawner.spawn_item"
+	BlockTrialSpawnerSpawnItemBegin            Sound = "block.trial_spawner.spawn_item_begin"
+	BlockTrialSpawnerSpawnMob                  Sound = "block.trial_spawner.spawn_mob"
+	BlockTrialSpawnerStep                      Sound = "block.trial_spawner.step"
+	BlockTripwireAttach                        Sound = "block.tripwire.attach"
+	BlockTripwireClickOff                      Sound = "block.tripwire.click_off"
+	BlockTripwireClickOn                       Sound = "block.tripwire.click_on"
+	BlockTripwireDetach                        Sound = "block.tripwire.detach"
+	BlockTuffBreak                             Sound = "block.tuff.break"
+	BlockTuffFall                              Sound = "block.tuff.fall"
+	BlockTuffHit                               Sound = "block.tuff.hit"
+	BlockTuffPlace                             Sound = "block.tuff.place"
+	BlockTuffStep                              Sound = "block.tuff.step"
+	BlockTuffBricksBreak                       Sound = "block.tuff_bricks.break"
+	BlockTuffBricksFall                        Sound = "block.tuff_bricks.fall"
+	BlockTuffBricksHit                         Sound = "block.tuff_bricks.hit"
+	BlockTuffBricksPlace                       Sound = "block.tuff_bricks.place"
+	BlockTuffBricksStep                        Sound = "block.tuff_bricks.step"
+	BlockVaultActivate                         Sound = "block.vault.activate"
+	BlockVaultAmbient                          Sound = "block.vault.ambient"
+	BlockVaultBreak                            Sound = "block.vault.break"
+	BlockVaultCloseShutter                     Sound = "block.vault.close_shutter"
+	BlockVaultDeactivate                       Sound = "block.vault.deactivate"
+	BlockVaultEjectItem                        Sound = "block.vault.eject_item"
+	BlockVaultFall                             Sound = "block.vault.fall"
+	BlockVaultHit                              Sound = "block.vault.hit"
+	BlockVaultInsertItem                       Sound = "block.vault.insert_item"
+	BlockVaultInsertItemFail                   Sound = "block.vault.insert_item_fail"
+	BlockVaultOpenShutter                      Sound = "block.vault.open_shutter"
+	BlockVaultPlace                            Sound = "block.vault.place"
+	BlockVaultRejectRewardedPlayer             Sound = "block.vault.reject_rewarded_player"
+	BlockVaultStep                             Sound = "block.vault.step"
+	BlockWartBlockBreak                        Sound = "block.wart_block.break"
+	BlockWartBlockFall                         Sound = "block.wart_block.fall"
+	BlockWartBlockHit                          Sound = "block.wart_block.hit"
+	BlockWartBlockPlace                        Sound = "block.wart_block.place"
+	BlockWartBlockStep                         Sound = "block.wart_block.step"
+	BlockWaterAmbient                          Sound = "block.water.ambient"
+	BlockWeepingVinesBreak                     Sound = "block.weeping_vines.break"
+	BlockWeepingVinesFall                      Sound = "block.weeping_vines.fall"
+	BlockWeepingVinesHit                       Sound = "block.weeping_vines.hit"
+	BlockWeepingVinesPlace                     Sound = "block.weeping_vines.place"
+	BlockWeepingVinesStep                      Sound = "block.weeping_vines.step"
+	BlockWetGrassBreak                         Sound = "block.wet_grass.break"
+	BlockWetGrassFall                          Sound = "block.wet_grass.fall"
+	BlockWetGrassHit                           Sound = "block.wet_grass.hit"
+	BlockWetGrassPlace                         Sound = "block.wet_grass.place"
+	BlockWetGrassStep                          Sound = "block.wet_grass.step"
+	BlockWetSpongeBreak                        Sound = "block.wet_sponge.break"
+	BlockWetSpongeDries                        Sound = "block.wet_sponge.dries"
+	BlockWetSpongeFall                         Sound = "block.wet_sponge.fall"
+	BlockWetSpongeHit                          Sound = "block.wet_sponge.hit"
+	BlockWetSpongePlace                        Sound = "block.wet_sponge.place"
+	BlockWetSpongeStep                         Sound = "block.wet_sponge.step"
+	BlockWoodBreak                             Sound = "block.wood.break"
+	BlockWoodFall                              Sound = "block.wood.fall"
+	BlockWoodHit                               Sound = "block.wood.hit"
+	BlockWoodPlace                             Sound = "block.wood.place"
+	BlockWoodStep                              Sound = "block.wood.step"
+	BlockWoodenButtonClickOff                  Sound = "block.wooden_button.click_off"
+	BlockWoodenButtonClickOn                   Sound = "block.wooden_button.click_on"
+	BlockWoodenDoorClose                       Sound = "block.wooden_door.close"
+	BlockWoodenDoorOpen                        Sound = "block.wooden_door.open"
+	BlockWoodenPressurePlateClickOff           Sound = "block.wooden_pressure_plate.click_off"
+	BlockWoodenPressurePlateClickOn            Sound = "block.wooden_pressure_plate.click_on"
+	BlockWoodenTrapdoorClose                   Sound = "block.wooden_trapdoor.close"
+	BlockWoodenTrapdoorOpen                    Sound = "block.wooden_trapdoor.open"
+	BlockWoolBreak                             Sound = "block.wool.break"
+	BlockWoolFall                              Sound = "block.wool.fall"
+	BlockWoolHit                               Sound = "block.wool.hit"
+	BlockWoolPlace                             Sound = "block.wool.place"
+	BlockWoolStep                              Sound = "block.wool.step"
+	EnchantThornsHit                           Sound = "enchant.thorns.hit"
+	EntityAllayAmbientWithItem                 Sound = "entity.allay.ambient_with_item"
+	EntityAllayAmbientWithoutItem              Sound = "entity.allay.ambient_without_item"
+	EntityAllayDeath                           Sound = "entity.allay.death"
+	EntityAllayHurt                            Sound = "entity.allay.hurt"
+	EntityAllayItemGiven                       Sound = "entity.allay.item_given"
+	EntityAllayItemTaken                       Sound = "entity.allay.item_taken"
+	EntityAllayItemThrown                      Sound = "entity.allay.item_thrown"
+	EntityArmadilloAmbient                     Sound = "entity.armadillo.ambient"
+	EntityArmadilloBrush                       Sound = "entity.armadillo.brush"
+	EntityArmadilloDeath                       Sound = "entity.armadillo.death"
+	EntityArmadilloEat                         Sound = "entity.armadillo.eat"
+	EntityArmadilloHurt                        Sound = "entity.armadillo.hurt"
+	EntityArmadilloHurtReduced                 Sound = "entity.armadillo.hurt_reduced"
+	EntityArmadilloLand                        Sound = "entity.armadillo.land"
+	EntityArmadilloPeek                        Sound = "entity.armadillo.peek"
+	EntityArmadilloRoll                        Sound = "entity.armadillo.roll"
+	EntityArmadilloScuteDrop                   Sound = "entity.armadillo.scute_drop"
+	EntityArmadilloStep                        Sound = "entity.armadillo.step"
+	EntityArmadilloUnrollFinish                Sound = "entity.armadillo.unroll_finish"
+	EntityArmadilloUnrollStart                 Sound = "entity.armadillo.unroll_start"
+	EntityArmorStandBreak                      Sound = "entity.armor_stand.break"
+	EntityArmorStandFall                       Sound = "entity.armor_stand.fall"
+	EntityArmorStandHit                        Sound = "entity.armor_stand.hit"
+	EntityArmorStandPlace                      Sound = "entity.armor_stand.place"
+	EntityArrowHit                             Sound = "entity.arrow.hit"
+	EntityArrowHitPlayer                       Sound = "entity.arrow.hit_player"
+	EntityArrowShoot                           Sound = "entity.arrow.shoot"
+	EntityAxolotlAttack                        Sound = "entity.axolotl.attack"
+	EntityAxolotlDeath                         Sound = "entity.axolotl.death"
+	EntityAxolotlHurt                          Sound = "entity.axolotl.hurt"
+	EntityAxolotlIdleAir                       Sound = "entity.axolotl.idle_air"
+	EntityAxolotlIdleWater                     Sound = "entity.axolotl.idle_water"
+	EntityAxolotlSplash                        Sound = "entity.axolotl.splash"
+	EntityAxolotlSwim                          Sound = "entity.axolotl.swim"
+	EntityBatAmbient                           Sound = "entity.bat.ambient"
+	EntityBatDeath                             Sound = "entity.bat.death"
+	EntityBatHurt                              Sound = "entity.bat.hurt"
+	EntityBatLoop                              Sound = "entity.bat.loop"
+	EntityBatTakeoff                           Sound = "entity.bat.takeoff"
+	EntityBeeDeath                             Sound = "entity.bee.death"
+	EntityBeeHurt                              Sound = "entity.bee.hurt"
+	EntityBeeLoop                              Sound = "entity.bee.loop"
+	EntityBeeLoopAggressive                    Sound = "entity.bee.loop_aggressive"
+	EntityBeePollinate                         Sound = "entity.bee.pollinate"
+	EntityBeeSting                             Sound = "entity.bee.sting"
+	EntityBlazeAmbient                         Sound = "entity.blaze.ambient"
+	EntityBlazeBurn                            Sound = "entity.blaze.burn"
+	EntityBlazeDeath                           Sound = "entity.blaze.death"
+	EntityBlazeHurt                            Sound = "entity.blaze.hurt"
+	EntityBlazeShoot                           Sound = "entity.blaze.shoot"
+	EntityBoatPaddleLand                       Sound = "entity.boat.paddle_land"
+	EntityBoatPaddleWater                      Sound = "entity.boat.paddle_water"
+	EntityBoggedAmbient                        Sound = "entity.bogged.ambient"
+	EntityBoggedDeath                          Sound = "entity.bogged.death"
+	EntityBoggedHurt                           Sound = "entity.bogged.hurt"
+	EntityBoggedShear                          Sound = "entity.bogged.shear"
+	EntityBoggedStep                           Sound = "entity.bogged.step"
+	EntityBreezeCharge                         Sound = "entity.breeze.charge"
+	EntityBreezeDeath                          Sound = "entity.breeze.death"
+	EntityBreezeDeflect                        Sound = "entity.breeze.deflect"
+	EntityBreezeHurt                           Sound = "entity.breeze.hurt"
+	EntityBreezeIdleAir                        Sound = "entity.breeze.idle_air"
+	EntityBreezeIdleGround                     Sound = "entity.breeze.idle_ground"
+	EntityBreezeInhale                         Sound = "entity.breeze.inhale"
+	EntityBreezeJump                           Sound = "entity.breeze.jump"
+	EntityBreezeLand                           Sound = "entity.breeze.land"
+	EntityBreezeShoot                          Sound = "entity.breeze.shoot"
+	EntityBreezeSlide                          Sound = "entity.breeze.slide"
+	EntityBreezeWhirl                          Sound = "entity.breeze.whirl"
+	EntityBreezeWindBurst                      Sound = "entity.breeze.wind_burst"
+	EntityCamelAmbient                         Sound = "entity.camel.ambient"
+	EntityCamelDash                            Sound = "entity.camel.dash"
+	EntityCamelDashReady                       Sound = "entity.camel.dash_ready"
+	EntityCamelDeath                           Sound = "entity.camel.death"
+	EntityCamelEat                             Sound = "entity.camel.eat"
+	EntityCamelHurt                            Sound = "entity.camel.hurt"
+	EntityCamelSaddle                          Sound = "entity.camel.saddle"
+	EntityCamelSit                             Sound = "entity.camel.sit"
+	EntityCamelStand                           Sound = "entity.camel.stand"
+	EntityCamelStep                            Sound = "entity.camel.step"
+	EntityCamelStepSand                        Sound = "entity.camel.step_sand"
+	EntityCatAmbient                           Sound = "entity.cat.ambient"
+	EntityCatBegForFood                        Sound = "entity.cat.beg_for_food"
+	EntityCatDeath                             Sound = "entity.cat.death"
+	EntityCatEat                               Sound = "entity.cat.eat"
+	EntityCatHiss                              Sound = "entity.cat.hiss"
+	EntityCatHurt                              Sound = "entity.cat.hurt"
+	EntityCatPurr                              Sound = "entity.cat.purr"
+	EntityCatPurreow                           Sound = "entity.cat.purreow"
+	EntityCatStrayAmbient                      Sound = "entity.cat.stray_ambient"
+	EntityChickenAmbient                       Sound = "entity.chicken.ambient"
+	EntityChickenDeath                         Sound = "entity.chicken.death"
+	EntityChickenEgg                           Sound = "entity.chicken.egg"
+	EntityChickenHurt                          Sound = "entity.chicken.hurt"
+	EntityChickenStep                          Sound = "entity.chicken.step"
+	EntityCodAmbient                           Sound = "entity.cod.ambient"
+	EntityCodDeath                             Sound = "entity.cod.death"
+	EntityCodFlop                              Sound = "entity.cod.flop"
+	EntityCodHurt                              Sound = "entity.cod.hurt"
+	EntityCowAmbient                           Sound = "entity.cow.ambient"
+	EntityCowDeath                             Sound = "entity.cow.death"
+	EntityCowHurt                              Sound = "entity.cow.hurt"
+	EntityCowMilk                              Sound = "entity.cow.milk"
+	EntityCowStep                              Sound = "entity.cow.step"
+	EntityCreakingActivate                     Sound = "entity.creaking.activate"
+	EntityCreakingAmbient                      Sound = "entity.creaking.ambient"
+	EntityCreakingAttack                       Sound = "entity.creaking.attack"
+	EntityCreakingDeactivate                   Sound = "entity.creaking.deactivate"
+	EntityCreakingDeath                        Sound = "entity.creaking.death"
+	EntityCreakingFreeze                       Sound = "entity.creaking.freeze"
+	EntityCreakingSpawn                        Sound = "entity.creaking.spawn"
+	EntityCreakingStep                         Sound = "entity.creaking.step"
+	EntityCreakingSway                         Sound = "entity.creaking.sway"
+	EntityCreakingTwitch                       Sound = "entity.creaking.twitch"
+	EntityCreakingUnfreeze                     Sound = "entity.creaking.unfreeze"
+	EntityCreeperDeath                         Sound = "entity.creeper.death"
+	EntityCreeperHurt                          Sound = "entity.creeper.hurt"
+	EntityCreeperPrimed                        Sound = "entity.creeper.primed"
+	EntityDolphinAmbient                       Sound = "entity.dolphin.ambient"
+	EntityDolphinAmbientWater                  Sound = "entity.dolphin.ambient_water"
+	EntityDolphinAttack                        Sound = "entity.dolphin.attack"
+	EntityDolphinDeath                         Sound = "entity.dolphin.death"
+	EntityDolphinEat                           Sound = "entity.dolphin.eat"
+	EntityDolphinHurt                          Sound = "entity.dolphin.hurt"
+	EntityDolphinJump                          Sound = "entity.dolphin.jump"
+	EntityDolphinPlay                          Sound = "entity.dolphin.play"
+	EntityDolphinSplash                        Sound = "entity.dolphin.splash"
+	EntityDolphinSwim                          Sound = "entity.dolphin.swim"
+	EntityDonkeyAmbient                        Sound = "entity.donkey.ambient"
+	EntityDonkeyAngry                          Sound = "entity.donkey.angry"
+	EntityDonkeyChest                          Sound = "entity.donkey.chest"
+	EntityDonkeyDeath                          Sound = "entity.donkey.death"
+	EntityDonkeyEat                            Sound = "entity.donkey.eat"
+	EntityDonkeyHurt                           Sound = "entity.donkey.hurt"
+	EntityDonkeyJump                           Sound = "entity.donkey.jump"
+	EntityDragonFireballExplode                Sound = "entity.dragon_fireball.explode"
+	EntityDrownedAmbient                       Sound = "entity.drowned.ambient"
+	EntityDrownedAmbientWater                  Sound = "entity.drowned.ambient_water"
+	EntityDrownedDeath                         Sound = "entity.drowned.death"
+	EntityDrownedDeathWater                    Sound = "entity.drowned.death_water"
+	EntityDrownedHurt                          Sound = "entity.drowned.hurt"
+	EntityDrownedHurtWater                     Sound = "entity.drowned.hurt_water"
+	EntityDrownedShoot                         Sound = "entity.drowned.shoot"
+	EntityDrownedStep                          Sound = "entity.drowned.step"
+	EntityDrownedSwim                          Sound = "entity.drowned.swim"
+	EntityEggThrow                             Sound = "entity.egg.throw"
+	EntityElderGuardianAmbient                 Sound = "entity.elder_guardian.ambient"
+	EntityElderGuardianAmbientLand             Sound = "entity.elder_guardian.ambient_land"
+	EntityElderGuardianCurse                   Sound = "entity.elder_guardian.curse"
+	EntityElderGuardianDeath                   Sound = "entity.elder_guardian.death"
+	EntityElderGuardianDeathLand               Sound = "entity.elder_guardian.death_land"
+	EntityElderGuardianFlop                    Sound = "entity.elder_guardian.flop"
+	EntityElderGuardianHurt                    Sound = "entity.elder_guardian.hurt"
+	EntityElderGuardianHurtLand                Sound = "entity.elder_guardian.hurt_land"
+	EntityEnderDragonAmbient                   Sound = "entity.ender_dragon.ambient"
+	EntityEnderDragonDeath                     Sound = "entity.ender_dragon.death"
+	EntityEnderDragonFlap                      Sound = "entity.ender_dragon.flap"
+	EntityEnderDragonGrowl                     Sound = "entity.ender_dragon.growl"
+	EntityEnderDragonHurt                      Sound = "entity.ender_dragon.hurt"
+	EntityEnderDragonShoot                     Sound = "entity.ender_dragon.shoot"
+	EntityEnderEyeDeath                        Sound = "entity.ender_eye.death"
+	EntityEnderEyeLaunch                       Sound = "entity.ender_eye.launch"
+	EntityEnderPearlThrow                      Sound = "entity.ender_pearl.throw"
+	EntityEndermanAmbient                      Sound = "entity.enderman.ambient"
+	EntityEndermanDeath                        Sound = "entity.enderman.death"
+	EntityEndermanHurt                         Sound = "entity.enderman.hurt"
+	EntityEndermanScream                       Sound = "entity.enderman.scream"
+	EntityEndermanStare                        Sound = "entity.enderman.stare"
+	EntityEndermanTeleport                     Sound = "entity.enderman.teleport"
+	EntityEndermiteAmbient                     Sound = "entity.endermite.ambient"
+	EntityEndermiteDeath                       Sound = "entity.endermite.death"
+	EntityEndermiteHurt                        Sound = "entity.endermite.hurt"
+	EntityEndermiteStep                        Sound = "entity.endermite.step"
+	EntityEvokerAmbient                        Sound = "entity.evoker.ambient"
+	EntityEvokerCastSpell                      Sound = "entity.evoker.cast_spell"
+	EntityEvokerCelebrate                      Sound = "entity.evoker.celebrate"
+	EntityEvokerDeath                          Sound = "entity.evoker.death"
+	EntityEvokerHurt                           Sound = "entity.evoker.hurt"
+	EntityEvokerPrepareAttack                  Sound = "entity.evoker.prepare_attack"
+	EntityEvokerPrepareSummon                  Sound = "entity.evoker.prepare_summon"
+	EntityEvokerPrepareWololo                  Sound = "entity.evoker.prepare_wololo"
+	EntityEvokerFangsAttack                    Sound = "entity.evoker_fangs.attack"
+	EntityExperienceBottleThrow                Sound = "entity.experience_bottle.throw"
+	EntityExperienceOrbPickup                  Sound = "entity.experience_orb.pickup"
+	EntityFireworkRocketBlast                  Sound = "entity.firework_rocket.blast"
+	EntityFireworkRocketBlastFar               Sound = "entity.firework_rocket.blast_far"
+	EntityFireworkRocketLargeBlast             Sound = "entity.firework_rocket.large_blast"
+	EntityFireworkRocketLargeBlastFar          Sound = "entity.firework_rocket.large_blast_far"
+	EntityFireworkRocketLaunch                 Sound = "entity.firework_rocket.launch"
+	EntityFireworkRocketShoot                  Sound = "entity.firework_rocket.shoot"
+	EntityFireworkRocketTwinkle                Sound = "entity.firework_rocket.twinkle"
+	EntityFireworkRocketTwinkleFar             Sound = "entity.firework_rocket.twinkle_far"
+	EntityFishSwim                             Sound = "entity.fish.swim"
+	EntityFishingBobberRetrieve                Sound = "entity.fishing_bobber.retrieve"
+	EntityFishingBobberSplash                  Sound = "entity.fishing_bobber.splash"
+	EntityFishingBobberThrow                   Sound = "entity.fishing_bobber.throw"
+	EntityFoxAggro                             Sound = "entity.fox.aggro"
+	EntityFoxAmbient                           Sound = "entity.fox.ambient"
+	EntityFoxBite                              Sound = "entity.fox.bite"
+	EntityFoxDeath                             Sound = "entity.fox.death"
+	EntityFoxEat                               Sound = "entity.fox.eat"
+	EntityFoxHurt                              Sound = "entity.fox.hurt"
+	EntityFoxScreech                           Sound = "entity.fox.screech"
+	EntityFoxSleep                             Sound = "entity.fox.sleep"
+	EntityFoxSniff                             Sound = "entity.fox.sniff"
+	EntityFoxSpit                              Sound = "entity.fox.spit"
+	EntityFoxTeleport                          Sound = "entity.fox.teleport"
+	EntityFrogAmbient                          Sound = "entity.frog.ambient"
+	EntityFrogDeath                            Sound = "entity.frog.death"
+	EntityFrogEat                              Sound = "entity.frog.eat"
+	EntityFrogHurt                             Sound = "entity.frog.hurt"
+	EntityFrogLaySpawn                         Sound = "entity.frog.lay_spawn"
+	EntityFrogLongJump                         Sound = "entity.frog.long_jump"
+	EntityFrogStep                             Sound = "entity.frog.step"
+	EntityFrogTongue                           Sound = "entity.frog.tongue"
+	EntityGenericBigFall                       Sound = "entity.generic.big_fall"
+	EntityGenericBurn                          Sound = "entity.generic.burn"
+	EntityGenericDeath                         Sound = "entity.generic.death"
+	EntityGenericDrink                         Sound = "entity.generic.drink"
+	EntityGenericEat                           Sound = "entity.generic.eat"
+	EntityGenericExplode                       Sound = "entity.generic.explode"
+	EntityGenericExtinguishFire                Sound = "entity.generic.extinguish_fire"
+	EntityGenericHurt                          Sound = "entity.generic.hurt"
+	EntityGenericSmallFall                     Sound = "entity.generic.small_fall"
+	EntityGenericSplash                        Sound = "entity.generic.splash"
+	EntityGenericSwim                          Sound = "entity.generic.swim"
+	EntityGenericWindBurst                     Sound = "entity.generic.wind_burst"
+	EntityGhastAmbient                         Sound = "entity.ghast.ambient"
+	EntityGhastDeath                           Sound = "entity.ghast.death"
+	EntityGhastHurt                            Sound = "entity.ghast.hurt"
+	EntityGhastScream                          Sound = "entity.ghast.scream"
+	EntityGhastShoot                           Sound = "entity.ghast.shoot"
+	EntityGhastWarn                            Sound = "entity.ghast.warn"
+	EntityGlowItemFrameAddItem                 Sound = "entity.glow_item_frame.add_item"
+	EntityGlowItemFrameBreak                   Sound = "entity.glow_item_frame.break"
+	EntityGlowItemFramePlace                   Sound = "entity.glow_item_frame.place"
+	EntityGlowItemFrameRemoveItem              Sound = "entity.glow_item_frame.remove_item"
+	EntityGlowItemFrameRotateItem              Sound = "entity.glow_item_frame.rotate_item"
+	EntityGlowSquidAmbient                     Sound = "entity.glow_squid.ambient"
+	EntityGlowSquidDeath                       Sound = "entity.glow_squid.death"
+	EntityGlowSquidHurt                        Sound = "entity.glow_squid.hurt"
+	EntityGlowSquidSquirt                      Sound = "entity.glow_squid.squirt"
+	EntityGoatAmbient                          Sound = "entity.goat.ambient"
+	EntityGoatDeath                            Sound = "entity.goat.death"
+	EntityGoatEat                              Sound = "entity.goat.eat"
+	EntityGoatHornBreak                        Sound = "entity.goat.horn_break"
+	EntityGoatHurt                             Sound = "entity.goat.hurt"
+	EntityGoatLongJump                         Sound = "entity.goat.long_jump"
+	EntityGoatMilk                             Sound = "entity.goat.milk"
+	EntityGoatPrepareRam                       Sound = "entity.goat.prepare_ram"
+	EntityGoatRamImpact                        Sound = "entity.goat.ram_impact"
+	EntityGoatScreamingAmbient                 Sound = "entity.goat.screaming.ambient"
+	EntityGoatScreamingDeath                   Sound = "entity.goat.screaming.death"
+	EntityGoatScreamingEat                     Sound = "entity.goat.screaming.eat"
+	EntityGoatScreamingHurt                    Sound = "entity.goat.screaming.hurt"
+	EntityGoatScreamingLongJump                Sound = "entity.goat.screaming.long_jump"
+	EntityGoatScreamingMilk                    Sound = "entity.goat.screaming.milk"
+	EntityGoatScreamingPrepareRam              Sound = "entity.goat.screaming.prepare_ram"
+	EntityGoatScreamingRamImpact               Sound = "entity.goat.screaming.ram_impact"
+	EntityGoatStep                             Sound = "entity.goat.step"
+	EntityGuardianAmbient                      Sound = "entity.guardian.ambient"
+	EntityGuardianAmbientLand                  Sound = "entity.guardian.ambient_land"
+	EntityGuardianAttack                       Sound = "entity.guardian.attack"
+	EntityGuardianDeath                        Sound = "entity.guardian.death"
+	EntityGuardianDeathLand                    Sound = "entity.guardian.death_land"
+	EntityGuardianFlop                         Sound = "entity.guardian.flop"
+	EntityGuardianHurt                         Sound = "entity.guardian.hurt"
+	EntityGuardianHurtLand                     Sound = "entity.guardian.hurt_land"
+	EntityHoglinAmbient                        Sound = "entity.hoglin.ambient"
+	EntityHoglinAngry                          Sound = "entity.hoglin.angry"
+	EntityHoglinAttack                         Sound = "entity.hoglin.attack"
+	EntityHoglinConvertedToZombified           Sound = "entity.hoglin.converted_to_zombified"
+	EntityHoglinDeath                          Sound = "entity.hoglin.death"
+	EntityHoglinHurt                           Sound = "entity.hoglin.hurt"
+	EntityHoglinRetreat                        Sound = "entity.hoglin.retreat"
+	EntityHoglinStep                           Sound = "entity.hoglin.step"
+	EntityHorseAmbient                         Sound = "entity.horse.ambient"
+	EntityHorseAngry                           Sound = "entity.horse.angry"
+	EntityHorseArmor                           Sound = "entity.horse.armor"
+	EntityHorseBreathe                         Sound = "entity.horse.breathe"
+	EntityHorseDeath                           Sound = "entity.horse.death"
+	EntityHorseEat                             Sound = "entity.horse.eat"
+	EntityHorseGallop                          Sound = "entity.horse.gallop"
+	EntityHorseHurt                            Sound = "entity.horse.hurt"
+	EntityHorseJump                            Sound = "entity.horse.jump"
+	EntityHorseLand                            Sound = "entity.horse.land"
+	EntityHorseSaddle                          Sound = "entity.horse.saddle"
+	EntityHorseStep                            Sound = "entity.horse.step"
+	EntityHorseStepWood                        Sound = "entity.horse.step_wood"
+	EntityHostileBigFall                       Sound = "entity.hostile.big_fall"
+	EntityHostileDeath                         Sound = "entity.hostile.death"
+	EntityHostileHurt                          Sound = "entity.hostile.hurt"
+	EntityHostileSmallFall                     Sound = "entity.hostile.small_fall"
+	EntityHostileSplash                        Sound = "entity.hostile.splash"
+	EntityHostileSwim                          Sound = "entity.hostile.swim"
+	EntityHuskAmbient                          Sound = "entity.husk.ambient"
+	EntityHuskConvertedToZombie                Sound = "entity.husk.converted_to_zombie"
+	EntityHuskDeath                            Sound = "entity.husk.death"
+	EntityHuskHurt                             Sound = "entity.husk.hurt"
+	EntityHuskStep                             Sound = "entity.husk.step"
+	EntityIllusionerAmbient                    Sound = "entity.illusioner.ambient"
+	EntityIllusionerCastSpell                  Sound = "entity.illusioner.cast_spell"
+	EntityIllusionerDeath                      Sound = "entity.illusioner.death"
+	EntityIllusionerHurt                       Sound = "entity.illusioner.hurt"
+	EntityIllusionerMirrorMove                 Sound = "entity.illusioner.mirror_move"
+	EntityIllusionerPrepareBlindness           Sound = "entity.illusioner.prepare_blindness"
+	EntityIllusionerPrepareMirror              Sound = "entity.illusioner.prepare_mirror"
+	EntityIronGolemAttack                      Sound = "entity.iron_golem.attack"
+	EntityIronGolemDamage                      Sound = "entity.iron_golem.damage"
+	EntityIronGolemDeath                       Sound = "entity.iron_golem.death"
+	EntityIronGolemHurt                        Sound = "entity.iron_golem.hurt"
+	EntityIronGolemRepair                      Sound = "entity.iron_golem.repair"
+	EntityIronGolemStep                        Sound = "entity.iron_golem.step"
+	EntityItemBreak                            Sound = "entity.item.break"
+	EntityItemPickup                           Sound = "entity.item.pickup"
+	EntityItemFrameAddItem                     Sound = "entity.item_frame.add_item"
+	EntityItemFrameBreak                       Sound = "entity.item_frame.break"
+	EntityItemFramePlace                       Sound = "entity.item_frame.place"
+	EntityItemFrameRemoveItem                  Sound = "entity.item_frame.remove_item"
+	EntityItemFrameRotateItem                  Sound = "entity.item_frame.rotate_item"
+	EntityLeashKnotBreak                       Sound = "entity.leash_knot.break"
+	EntityLeashKnotPlace                       Sound = "entity.leash_knot.place"
+	EntityLightningBoltImpact                  Sound = "entity.lightning_bolt.impact"
+	EntityLightningBoltThunder                 Sound = "entity.lightning_bolt.thunder"
+	EntityLingeringPotionThrow                 Sound = "entity.lingering_potion.throw"
+	EntityLlamaAmbient                         Sound = "entity.llama.ambient"
+	EntityLlamaAngry                           Sound = "entity.llama.angry"
+	EntityLlamaChest                           Sound = "entity.llama.chest"
+	EntityLlamaDeath                           Sound = "entity.llama.death"
+	EntityLlamaEat                             Sound = "entity.llama.eat"
+	EntityLlamaHurt                            Sound = "entity.llama.hurt"
+	EntityLlamaSpit                            Sound = "entity.llama.spit"
+	EntityLlamaStep                            Sound = "entity.llama.step"
+	EntityLlamaSwag                            Sound = "entity.llama.swag"
+	EntityMagmaCubeDeath                       Sound = "entity.magma_cube.death"
+	EntityMagmaCubeDeathSmall                  Sound = "entity.magma_cube.death_small"
+	EntityMagmaCubeHurt                        Sound = "entity.magma_cube.hurt"
+	EntityMagmaCubeHurtSmall                   Sound = "entity.magma_cube.hurt_small"
+	EntityMagmaCubeJump                        Sound = "entity.magma_cube.jump"
+	EntityMagmaCubeSquish                      Sound = "entity.magma_cube.squish"
+	EntityMagmaCubeSquishSmall                 Sound = "entity.magma_cube.squish_small"
+	EntityMinecartInside                       Sound = "entity.minecart.inside"
+	EntityMinecartInsideUnderwater             Sound = "entity.minecart.inside.underwater"
+	EntityMinecartRiding                       Sound = "entity.minecart.riding"
+	EntityMooshroomConvert                     Sound = "entity.mooshroom.convert"
+	EntityMooshroomEat                         Sound = "entity.mooshroom.eat"
+	EntityMooshroomMilk                        Sound = "entity.mooshroom.milk"
+	EntityMooshroomShear                       Sound = "entity.mooshroom.shear"
+	EntityMooshroomSuspiciousMilk              Sound = "entity.mooshroom.suspicious_milk"
+	EntityMuleAmbient                          Sound = "entity.mule.ambient"
+	EntityMuleAngry                            Sound = "entity.mule.angry"
+	EntityMuleChest                            Sound = "entity.mule.chest"
+	EntityMuleDeath                            Sound = "entity.mule.death"
+	EntityMuleEat                              Sound = "entity.mule.eat"
+	EntityMuleHurt                             Sound = "entity.mule.hurt"
+	EntityMuleJump                             Sound = "entity.mule.jump"
+	EntityOcelotAmbient                        Sound = "entity.ocelot.ambient"
+	EntityOcelotDeath                          Sound = "entity.ocelot.death"
+	EntityOcelotHurt                           Sound = "entity.ocelot.hurt"
+	EntityPaintingBreak                        Sound = "entity.painting.break"
+	EntityPaintingPlace                        Sound = "entity.painting.place"
+	EntityPandaAggressiveAmbient               Sound = "entity.panda.aggressive_ambient"
+	EntityPandaAmbient                         Sound = "entity.panda.ambient"
+	EntityPandaBite                            Sound = "entity.panda.bite"
+	EntityPandaCantBreed                       Sound = "entity.panda.cant_breed"
+	EntityPandaDeath                           Sound = "entity.panda.death"
+	EntityPandaEat                             Sound = "entity.panda.eat"
+	EntityPandaHurt                            Sound = "entity.panda.hurt"
+	EntityPandaPreSneeze                       Sound = "entity.panda.pre_sneeze"
+	EntityPandaSneeze                          Sound = "entity.panda.sneeze"
+	EntityPandaStep                            Sound = "entity.panda.step"
+	EntityPandaWorriedAmbient                  Sound = "entity.panda.worried_ambient"
+	EntityParrotAmbient                        Sound = "entity.parrot.ambient"
+	EntityParrotDeath                          Sound = "entity.parrot.death"
+	EntityParrotEat                            Sound = "entity.parrot.eat"
+	EntityParrotFly                            Sound = "entity.parrot.fly"
+	EntityParrotHurt                           Sound = "entity.parrot.hurt"
+	EntityParrotImitateBlaze                   Sound = "entity.parrot.imitate.blaze"
+	EntityParrotImitateBogged                  Sound = "entity.parrot.imitate.bogged"
+	EntityParrotImitateBreeze                  Sound = "entity.parrot.imitate.breeze"
+	EntityParrotImitateCreaking                Sound = "entity.parrot.imitate.creaking"
+	EntityParrotImitateCreeper                 Sound = "entity.parrot.imitate.creeper"
+	EntityParrotImitateDrowned                 Sound = "entity.parrot.imitate.drowned"
+	EntityParrotImitateElderGuardian           Sound = "entity.parrot.imitate.elder_guardian"
+	EntityParrotImitateEnderDragon             Sound = "entity.parrot.imitate.ender_dragon"
+	EntityParrotImitateEndermite               Sound = "entity.parrot.imitate.endermite"
+	EntityParrotImitateEvoker                  Sound = "entity.parrot.imitate.evoker"
+	EntityParrotImitateGhast                   Sound = "entity.parrot.imitate.ghast"
+	EntityParrotImitateGuardian                Sound = "entity.parrot.imitate.guardian"
+	EntityParrotImitateHoglin                  Sound = "entity.parrot.imitate.hoglin"
+	EntityParrotImitateHusk                    Sound = "entity.parrot.imitate.husk"
+	EntityParrotImitateIllusioner              Sound = "entity.parrot.imitate.illusioner"
+	EntityParrotImitateMagmaCube               Sound = "entity.parrot.imitate.magma_cube"
+	EntityParrotImitatePhantom                 Sound = "entity.parrot.imitate.phantom"
+	EntityParrotImitatePiglin                  Sound = "entity.parrot.imitate.piglin"
+	EntityParrotImitatePiglinBrute             Sound = "entity.parrot.imitate.piglin_brute"
+	EntityParrotImitatePillager                Sound = "entity.parrot.imitate.pillager"
+	EntityParrotImitateRavager                 Sound = "entity.parrot.imitate.ravager"
+	EntityParrotImitateShulker                 Sound = "entity.parrot.imitate.shulker"
+	EntityParrotImitateSilverfish              Sound = "entity.parrot.imitate.silverfish"
+	EntityParrotImitateSkeleton                Sound = "entity.parrot.imitate.skeleton"
+	EntityParrotImitateSlime                   Sound = "entity.parrot.imitate.slime"
+	EntityParrotImitateSpider                  Sound = "entity.parrot.imitate.spider"
+	EntityParrotImitateStray                   Sound = "entity.parrot.imitate.stray"
+	EntityParrotImitateVex                     Sound = "entity.parrot.imitate.vex"
+	EntityParrotImitateVindicator              Sound = "entity.parrot.imitate.vindicator"
+	EntityParrotImitateWarden                  Sound = "entity.parrot.imitate.warden"
+	EntityParrotImitateWitch                   Sound = "entity.parrot.imitate.witch"
+	EntityParrotImitateWither                  Sound = "entity.parrot.imitate.wither"
+	EntityParrotImitateWitherSkeleton          Sound = "entity.parrot.imitate.wither_skeleton"
+	EntityParrotImitateZoglin                  Sound = "entity.parrot.imitate.zoglin"
+	EntityParrotImitateZombie                  Sound = "entity.parrot.imitate.zombie"
+	EntityParrotImitateZombieVillager          Sound = "entity.parrot.imitate.zombie_villager"
+	EntityParrotStep                           Sound = "entity.parrot.step"
+	EntityPhantomAmbient                       Sound = "entity.phantom.ambient"
+	EntityPhantomBite                          Sound = "entity.phantom.bite"
+	EntityPhantomDeath                         Sound = "entity.phantom.death"
+	EntityPhantomFlap                          Sound = "entity.phantom.flap"
+	EntityPhantomHurt                          Sound = "entity.phantom.hurt"
+	EntityPhantomSwoop                         Sound = "entity.phantom.swoop"
+	EntityPigAmbient                           Sound = "entity.pig.ambient"
+	EntityPigDeath                             Sound = "entity.pig.death"
+	EntityPigHurt                              Sound = "entity.pig.hurt"
+	EntityPigSaddle                            Sound = "entity.pig.saddle"
+	EntityPigStep                              Sound = "entity.pig.step"
+	EntityPiglinAdmiringItem                   Sound = "entity.piglin.admiring_item"
+	EntityPiglinAmbient                        Sound = "entity.piglin.ambient"
+	EntityPiglinAngry                          Sound = "entity.piglin.angry"
+	EntityPiglinCelebrate                      Sound = "entity.piglin.celebrate"
+	EntityPiglinConvertedToZombified           Sound = "entity.piglin.converted_to_zombified"
+	EntityPiglinDeath                          Sound = "entity.piglin.death"
+	EntityPiglinHurt                           Sound = "entity.piglin.hurt"
+	EntityPiglinJealous                        Sound = "entity.piglin.jealous"
+	EntityPiglinRetreat                        Sound = "entity.piglin.retreat"
+	EntityPiglinStep                           Sound = "entity.piglin.step"
+	EntityPiglinBruteAmbient                   Sound = "entity.piglin_brute.ambient"
+	EntityPiglinBruteAngry                     Sound = "entity.piglin_brute.angry"
+	EntityPiglinBruteConvertedToZombified      Sound = "entity.piglin_brute.converted_to_zombified"
+	EntityPiglinBruteDeath                     Sound = "entity.piglin_brute.death"
+	EntityPiglinBruteHurt                      Sound = "entity.piglin_brute.hurt"
+	EntityPiglinBruteStep                      Sound = "entity.piglin_brute.step"
+	EntityPillagerAmbient                      Sound = "entity.pillager.ambient"
+	EntityPillagerCelebrate                    Sound = "entity.pillager.celebrate"
+	EntityPillagerDeath                        Sound = "entity.pillager.death"
+	EntityPillagerHurt                         Sound = "entity.pillager.hurt"
+	EntityPlayerAttackCrit                     Sound = "entity.player.attack.crit"
+	EntityPlayerAttackKnockback                Sound = "entity.player.attack.knockback"
+	EntityPlayerAttackNodamage                 Sound = "entity.player.attack.nodamage"
+	EntityPlayerAttackStrong                   Sound = "entity.player.attack.strong"
+	EntityPlayerAttackSweep                    Sound = "entity.player.attack.sweep"
+	EntityPlayerAttackWeak                     Sound = "entity.player.attack.weak"
+	EntityPlayerBigFall                        Sound = "entity.player.big_fall"
+	EntityPlayerBreath                         Sound = "entity.player.breath"
+	EntityPlayerBurp                           Sound = "entity.player.burp"
+	EntityPlayerDeath                          Sound = "entity.player.death"
+	EntityPlayerHurt                           Sound = "entity.player.hurt"
+	EntityPlayerHurtDrown                      Sound = "entity.player.hurt_drown"
+	EntityPlayerHurtFreeze                     Sound = "entity.player.hurt_freeze"
+	EntityPlayerHurtOnFire                     Sound = "entity.player.hurt_on_fire"
+	EntityPlayerHurtSweetBerryBush             Sound = "entity.player.hurt_sweet_berry_bush"
+	EntityPlayerLevelup                        Sound = "entity.player.levelup"
+	EntityPlayerSmallFall                      Sound = "entity.player.small_fall"
+	EntityPlayerSplash                         Sound = "entity.player.splash"
+	EntityPlayerSplashHighSpeed                Sound = "entity.player.splash.high_speed"
+	EntityPlayerSwim                           Sound = "entity.player.swim"
+	EntityPlayerTeleport                       Sound = "entity.player.teleport"
+	EntityPolarBearAmbient                     Sound = "entity.polar_bear.ambient"
+	EntityPolarBearAmbientBaby                 Sound = "entity.polar_bear.ambient_baby"
+	EntityPolarBearDeath                       Sound = "entity.polar_bear.death"
+	EntityPolarBearHurt                        Sound = "entity.polar_bear.hurt"
+	EntityPolarBearStep                        Sound = "entity.polar_bear.step"
+	EntityPolarBearWarning                     Sound = "entity.polar_bear.warning"
+	EntityPufferFishAmbient                    Sound = "entity.puffer_fish.ambient"
+	EntityPufferFishBlowOut                    Sound = "entity.puffer_fish.blow_out"
+	EntityPufferFishBlowUp                     Sound = "entity.puffer_fish.blow_up"
+	EntityPufferFishDeath                      Sound = "entity.puffer_fish.death"
+	EntityPufferFishFlop                       Sound = "entity.puffer_fish.flop"
+	EntityPufferFishHurt                       Sound = "entity.puffer_fish.hurt"
+	EntityPufferFishSting                      Sound = "entity.puffer_fish.sting"
+	EntityRabbitAmbient                        Sound = "entity.rabbit.ambient"
+	EntityRabbitAttack                         Sound = "entity.rabbit.attack"
+	EntityRabbitDeath                          Sound = "entity.rabbit.death"
+	EntityRabbitHurt                           Sound = "entity.rabbit.hurt"
+	EntityRabbitJump                           Sound = "entity.rabbit.jump"
+	EntityRavagerAmbient                       Sound = "entity.ravager.ambient"
+	EntityRavagerAttack                        Sound = "entity.ravager.attack"
+	EntityRavagerCelebrate                     Sound = "entity.ravager.celebrate"
+	EntityRavagerDeath                         Sound = "entity.ravager.death"
+	EntityRavagerHurt                          Sound = "entity.ravager.hurt"
+	EntityRavagerRoar                          Sound = "entity.ravager.roar"
+	EntityRavagerStep                          Sound = "entity.ravager.step"
+	EntityRavagerStunned                       Sound = "entity.ravager.stunned"
+	EntitySalmonAmbient                        Sound = "entity.salmon.ambient"
+	EntitySalmonDeath                          Sound = "entity.salmon.death"
+	EntitySalmonFlop                           Sound = "entity.salmon.flop"
+	EntitySalmonHurt                           Sound = "entity.salmon.hurt"
+	EntitySheepAmbient                         Sound = "entity.sheep.ambient"
+	EntitySheepDeath                           Sound = "entity.sheep.death"
+	EntitySheepHurt                            Sound = "entity.sheep.hurt"
+	EntitySheepShear                           Sound = "entity.sheep.shear"
+	EntitySheepStep                            Sound = "entity.sheep.step"
+	EntityShulkerAmbient                       Sound = "entity.shulker.ambient"
+	EntityShulkerClose                         Sound = "entity.shulker.close"
+	EntityShulkerDeath                         Sound = "entity.shulker.death"
+	EntityShulkerHurt                          Sound = "entity.shulker.hurt"
+	EntityShulkerHurtClosed                    Sound = "entity.shulker.hurt_closed"
+	EntityShulkerOpen                          Sound = "entity.shulker.open"
+	EntityShulkerShoot                         Sound = "entity.shulker.shoot"
+	EntityShulkerTeleport                      Sound = "entity.shulker.teleport"
+	EntityShulkerBulletHit                     Sound = "entity.shulker_bullet.hit"
+	EntityShulkerBulletHurt                    Sound = "entity.shulker_bullet.hurt"
+	EntitySilverfishAmbient                    Sound = "entity.silverfish.ambient"
+	EntitySilverfishDeath                      Sound = "entity.silverfish.death"
+	EntitySilverfishHurt                       Sound = "entity.silverfish.hurt"
+	EntitySilverfishStep                       Sound = "entity.silverfish.step"
+	EntitySkeletonAmbient                      Sound = "entity.skeleton.ambient"
+	EntitySkeletonConvertedToStray             Sound = "entity.skeleton.converted_to_stray"
+	EntitySkeletonDeath                        Sound = "entity.skeleton.death"
+	EntitySkeletonHurt                         Sound = "entity.skeleton.hurt"
+	EntitySkeletonShoot                        Sound = "entity.skeleton.shoot"
+	EntitySkeletonStep                         Sound = "entity.skeleton.step"
+	EntitySkeletonHorseAmbient                 Sound = "entity.skeleton_horse.ambient"
+	EntitySkeletonHorseAmbientWater            Sound = "entity.skeleton_horse.ambient_water"
+	EntitySkeletonHorseDeath                   Sound = "entity.skeleton_horse.death"
+	EntitySkeletonHorseGallopWater             Sound = "entity.skeleton_horse.gallop_water"
+	EntitySkeletonHorseHurt                    Sound = "entity.skeleton_horse.hurt"
+	EntitySkeletonHorseJumpWater               Sound = "entity.skeleton_horse.jump_water"
+	EntitySkeletonHorseStepWater               Sound = "entity.skeleton_horse.step_water"
+	EntitySkeletonHorseSwim                    Sound = "entity.skeleton_horse.swim"
+	EntitySlimeAttack                          Sound = "entity.slime.attack"
+	EntitySlimeDeath                           Sound = "entity.slime.death"
+	EntitySlimeDeathSmall                      Sound = "entity.slime.death_small"
+	EntitySlimeHurt                            Sound = "entity.slime.hurt"
+	EntitySlimeHurtSmall                       Sound = "entity.slime.hurt_small"
+	EntitySlimeJump                            Sound = "entity.slime.jump"
+	EntitySlimeJumpSmall                       Sound = "entity.slime.jump_small"
+	EntitySlimeSquish                          Sound = "entity.slime.squish"
+	EntitySlimeSquishSmall                     Sound = "entity.slime.squish_small"
+	EntitySnifferDeath                         Sound = "entity.sniffer.death"
+	EntitySnifferDigging                       Sound = "entity.sniffer.digging"
+	EntitySnifferDiggingStop                   Sound = "entity.sniffer.digging_stop"
+	EntitySnifferDropSeed                      Sound = "entity.sniffer.drop_seed"
+	EntitySnifferEat                           Sound = "entity.sniffer.eat"
+	EntitySnifferHappy                         Sound = "entity.sniffer.happy"
+	EntitySnifferHurt                          Sound = "entity.sniffer.hurt"
+	EntitySnifferIdle                          Sound = "entity.sniffer.idle"
+	EntitySnifferScenting                      Sound = "entity.sniffer.scenting"
+	EntitySnifferSearching                     Sound = "entity.sniffer.searching"
+	EntitySnifferSniffing                      Sound = "entity.sniffer.sniffing"
+	EntitySnifferStep                          Sound = "entity.sniffer.step"
+	EntitySnowGolemAmbient                     Sound = "entity.snow_golem.ambient"
+	EntitySnowGolemDeath                       Sound = "entity.snow_golem.death"
+	EntitySnowGolemHurt                        Sound = "entity.snow_golem.hurt"
+	EntitySnowGolemShear                       Sound = "entity.snow_golem.shear"
+	EntitySnowGolemShoot                       Sound = "entity.snow_golem.shoot"
+	EntitySnowballThrow                        Sound = "entity.snowball.throw"
+	EntitySpiderAmbient                        Sound = "entity.spider.ambient"
+	EntitySpiderDeath                          Sound = "entity.spider.death"
+	EntitySpiderHurt                           Sound = "entity.spider.hurt"
+	EntitySpiderStep                           Sound = "entity.spider.step"
+	EntitySplashPotionBreak                    Sound = "entity.splash_potion.break"
+	EntitySplashPotionThrow                    Sound = "entity.splash_potion.throw"
+	EntitySquidAmbient                         Sound = "entity.squid.ambient"
+	EntitySquidDeath                           Sound = "entity.squid.death"
+	EntitySquidHurt                            Sound = "entity.squid.hurt"
+	EntitySquidSquirt                          Sound = "entity.squid.squirt"
+	EntityStrayAmbient                         Sound = "entity.stray.ambient"
+	EntityStrayDeath                           Sound = "entity.stray.death"
+	EntityStrayHurt                            Sound = "entity.stray.hurt"
+	EntityStrayStep                            Sound = "entity.stray.step"
+	EntityStriderAmbient                       Sound = "entity.strider.ambient"
+	EntityStriderDeath                         Sound = "entity.strider.death"
+	EntityStriderEat                           Sound = "entity.strider.eat"
+	EntityStriderHappy                         Sound = "entity.strider.happy"
+	EntityStriderHurt                          Sound = "entity.strider.hurt"
+	EntityStriderRetreat                       Sound = "entity.strider.retreat"
+	EntityStriderSaddle                        Sound = "entity.strider.saddle"
+	EntityStriderStep                          Sound = "entity.strider.step"
+	EntityStriderStepLava                      Sound = "entity.strider.step_lava"
+	EntityTadpoleDeath                         Sound = "entity.tadpole.death"
+	EntityTadpoleFlop                          Sound = "entity.tadpole.flop"
+	EntityTadpoleGrowUp                        Sound = "entity.tadpole.grow_up"
+	EntityTadpoleHurt                          Sound = "entity.tadpole.hurt"
+	EntityTntPrimed                            Sound = "entity.tnt.primed"
+	EntityTropicalFishAmbient                  Sound = "entity.tropical_fish.ambient"
+	EntityTropicalFishDeath                    Sound = "entity.tropical_fish.death"
+	EntityTropicalFishFlop                     Sound = "entity.tropical_fish.flop"
+	EntityTropicalFishHurt                     Sound = "entity.tropical_fish.hurt"
+	EntityTurtleAmbientLand                    Sound = "entity.turtle.ambient_land"
+	EntityTurtleDeath                          Sound = "entity.turtle.death"
+	EntityTurtleDeathBaby                      Sound = "entity.turtle.death_baby"
+	EntityTurtleEggBreak                       Sound = "entity.turtle.egg_break"
+	EntityTurtleEggCrack                       Sound = "entity.turtle.egg_crack"
+	EntityTurtleEggHatch                       Sound = "entity.turtle.egg_hatch"
+	EntityTurtleHurt                           Sound = "entity.turtle.hurt"
+	EntityTurtleHurtBaby                       Sound = "entity.turtle.hurt_baby"
+	EntityTurtleLayEgg                         Sound = "entity.turtle.lay_egg"
+	EntityTurtleShamble                        Sound = "entity.turtle.shamble"
+	EntityTurtleShambleBaby                    Sound = "entity.turtle.shamble_baby"
+	EntityTurtleSwim                           Sound = "entity.turtle.swim"
+	EntityVexAmbient                           Sound = "entity.vex.ambient"
+	EntityVexCharge                            Sound = "entity.vex.charge"
+	EntityVexDeath                             Sound = "entity.vex.death"
+	EntityVexHurt                              Sound = "entity.vex.hurt"
+	EntityVillagerAmbient                      Sound = "entity.villager.ambient"
+	EntityVillagerCelebrate                    Sound = "entity.villager.celebrate"
+	EntityVillagerDeath                        Sound = "entity.villager.death"
+	EntityVillagerHurt                         Sound = "entity.villager.hurt"
+	EntityVillagerNo                           Sound = "entity.villager.no"
+	EntityVillagerTrade                        Sound = "entity.villager.trade"
+	EntityVillagerWorkArmorer                  Sound = "entity.villager.work_armorer"
+	EntityVillagerWorkButcher                  Sound = "entity.villager.work_butcher"
+	EntityVillagerWorkCartographer             Sound = "entity.villager.work_cartographer"
+	EntityVillagerWorkCleric                   Sound = "entity.villager.work_cleric"
+	EntityVillagerWorkFarmer                   Sound = "entity.villager.work_farmer"
+	EntityVillagerWorkFisherman                Sound = "entity.villager.work_fisherman"
+	EntityVillagerWorkFletcher                 Sound = "entity.villager.work_fletcher"
+	EntityVillagerWorkLeatherworker            Sound = "entity.villager.work_leatherworker"
+	EntityVillagerWorkLibrarian                Sound = "entity.villager.work_librarian"
+	EntityVillagerWorkMason                    Sound = "entity.villager.work_mason"
+	EntityVillagerWorkShepherd                 Sound = "entity.villager.work_shepherd"
+	EntityVillagerWorkToolsmith                Sound = "entity.villager.work_toolsmith"
+	EntityVillagerWorkWeaponsmith              Sound = "entity.villager.work_weaponsmith"
+	EntityVillagerYes                          Sound = "entity.villager.yes"
+	EntityVindicatorAmbient                    Sound = "entity.vindicator.ambient"
+	EntityVindicatorCelebrate                  Sound = "entity.vindicator.celebrate"
+	EntityVindicatorDeath                      Sound = "entity.vindicator.death"
+	EntityVindicatorHurt                       Sound = "entity.vindicator.hurt"
+	EntityWanderingTraderAmbient               Sound = "entity.wandering_trader.ambient"
+	EntityWanderingTraderDeath                 Sound = "entity.wandering_trader.death"
+	EntityWanderingTraderDisappeared           Sound = "entity.wandering_trader.disappeared"
+	EntityWanderingTraderDrinkMilk             Sound = "entity.wandering_trader.drink_milk"
+	EntityWanderingTraderDrinkPotion           Sound = "entity.wandering_trader.drink_potion"
+	EntityWanderingTraderHurt                  Sound = "entity.wandering_trader.hurt"
+	EntityWanderingTraderNo                    Sound = "entity.wandering_trader.no"
+	EntityWanderingTraderReappeared            Sound = "entity.wandering_trader.reappeared"
+	EntityWanderingTraderTrade                 Sound = "entity.wandering_trader.trade"
+	EntityWanderingTraderYes                   Sound = "entity.wandering_trader.yes"
+	EntityWardenAgitated                       Sound = "entity.warden.agitated"
+	EntityWardenAmbient                        Sound = "entity.warden.ambient"
+	EntityWardenAngry                          Sound = "entity.warden.angry"
+	EntityWardenAttackImpact                   Sound = "entity.warden.attack_impact"
+	EntityWardenDeath                          Sound = "entity.warden.death"
+	EntityWardenDig                            Sound = "entity.warden.dig"
+	EntityWardenEmerge                         Sound = "entity.warden.emerge"
+	EntityWardenHeartbeat                      Sound = "entity.warden.heartbeat"
+	EntityWardenHurt                           Sound = "entity.warden.hurt"
+	EntityWardenListening                      Sound = "entity.warden.listening"
+	EntityWardenListeningAngry                 Sound = "entity.warden.listening_angry"
+	EntityWardenNearbyClose                    Sound = "entity.warden.nearby_close"
+	EntityWardenNearbyCloser                   Sound = "entity.warden.nearby_closer"
+	EntityWardenNearbyClosest                  Sound = "entity.warden.nearby_closest"
+	EntityWardenRoar                           Sound = "entity.warden.roar"
+	EntityWardenSniff                          Sound = "entity.warden.sniff"
+	EntityWardenSonicBoom                      Sound = "entity.warden.sonic_boom"
+	EntityWardenSonicCharge                    Sound = "entity.warden.sonic_charge"
+	EntityWardenStep                           Sound = "entity.warden.step"
+	EntityWardenTendrilClicks                  Sound = "entity.warden.tendril_clicks"
+	EntityWindChargeThrow                      Sound = "entity.wind_charge.throw"
+	EntityWindChargeWindBurst                  Sound = "entity.wind_charge.wind_burst"
+	EntityWitchAmbient                         Sound = "entity.witch.ambient"
+	EntityWitchCelebrate                       Sound = "entity.witch.celebrate"
+	EntityWitchDeath                           Sound = "entity.witch.death"
+	EntityWitchDrink                           Sound = "entity.witch.drink"
+	EntityWitchHurt                            Sound = "entity.witch.hurt"
+	EntityWitchThrow                           Sound = "entity.witch.throw"
+	EntityWitherAmbient                        Sound = "entity.wither.ambient"
+	EntityWitherBreakBlock                     Sound = "entity.wither.break_block"
+	EntityWitherDeath                          Sound = "entity.wither.death"
+	EntityWitherHurt                           Sound = "entity.wither.hurt"
+	EntityWitherShoot                          Sound = "entity.wither.shoot"
+	EntityWitherSpawn                          Sound = "entity.wither.spawn"
+	EntityWitherSkeletonAmbient                Sound = "entity.wither_skeleton.ambient"
+	EntityWitherSkeletonDeath                  Sound = "entity.wither_skeleton.death"
+	EntityWitherSkeletonHurt                   Sound = "entity.wither_skeleton.hurt"
+	EntityWitherSkeletonStep                   Sound = "entity.wither_skeleton.step"
+	EntityWolfAmbient                          Sound = "entity.wolf.ambient"
+	EntityWolfDeath                            Sound = "entity.wolf.death"
+	EntityWolfGrowl                            Sound = "entity.wolf.growl"
+	EntityWolfHowl                             Sound = "entity.wolf.howl"
+	EntityWolfHurt                             Sound = "entity.wolf.hurt"
+	EntityWolfPant                             Sound = "entity.wolf.pant"
+	EntityWolfShake                            Sound = "entity.wolf.shake"
+	EntityWolfStep                             Sound = "entity.wolf.step"
+	EntityWolfWhine                            Sound = "entity.wolf.whine"
+	EntityZoglinAmbient                        Sound = "entity.zoglin.ambient"
+	EntityZoglinAngry                          Sound = "entity.zoglin.angry"
+	EntityZoglinAttack                         Sound = "entity.zoglin.attack"
+	EntityZoglinDeath                          Sound = "entity.zoglin.death"
+	EntityZoglinHurt                           Sound = "entity.zoglin.hurt"
+	EntityZoglinStep                           Sound = "entity.zoglin.step"
+	EntityZombieAmbient                        Sound = "entity.zombie.ambient"
+	EntityZombieAttackIronDoor                 Sound = "entity.zombie.attack_iron_door"
+	EntityZombieAttackWoodenDoor               Sound = "entity.zombie.attack_wooden_door"
+	EntityZombieBreakWoodenDoor                Sound = "entity.zombie.break_wooden_door"
+	EntityZombieConvertedToDrowned             Sound = "entity.zombie.converted_to_drowned"
+	EntityZombieDeath                          Sound = "entity.zombie.death"
+	EntityZombieDestroyEgg                     Sound = "entity.zombie.destroy_egg"
+	EntityZombieHurt                           Sound = "entity.zombie.hurt"
+	EntityZombieInfect                         Sound = "entity.zombie.infect"
+	EntityZombieStep                           Sound = "entity.zombie.step"
+	EntityZombieHorseAmbient                   Sound = "entity.zombie_horse.ambient"
+	EntityZombieHorseDeath                     Sound = "entity.zombie_horse.death"
+	EntityZombieHorseHurt                      Sound = "entity.zombie_horse.hurt"
+	EntityZombieVillagerAmbient                Sound = "entity.zombie_villager.ambient"
+	EntityZombieVillagerConverted              Sound = "entity.zombie_villager.converted"
+	EntityZombieVillagerCure                   Sound = "entity.zombie_villager.cure"
+	EntityZombieVillagerDeath                  Sound = "entity.zombie_villager.death"
+	EntityZombieVillagerHurt                   Sound = "entity.zombie_villager.hurt"
+	EntityZombieVillagerStep                   Sound = "entity.zombie_villager.step"
+	EntityZombifiedPiglinAmbient               Sound = "entity.zombified_piglin.ambient"
+	EntityZombifiedPiglinAngry                 Sound = "entity.zombified_piglin.angry"
+	EntityZombifiedPiglinDeath                 Sound = "entity.zombified_piglin.death"
+	EntityZombifiedPiglinHurt                  Sound = "entity.zombified_piglin.hurt"
+	EventMobEffectBadOmen                      Sound = "event.mob_effect.bad_omen"
+	EventMobEffectRaidOmen                     Sound = "event.mob_effect.raid_omen"
+	EventMobEffectTrialOmen                    Sound = "event.mob_effect.trial_omen"
+	EventRaidHorn                              Sound = "event.raid.horn"
+	IntentionallyEmpty                         Sound = "intentionally_empty"
+	ItemArmorEquipChain                        Sound = "item.armor.equip_chain"
+	ItemArmorEquipDiamond                      Sound = "item.armor.equip_diamond"
+	ItemArmorEquipElytra                       Sound = "item.armor.equip_elytra"
+	ItemArmorEquipGeneric                      Sound = "item.armor.equip_generic"
+	ItemArmorEquipGold                         Sound = "item.armor.equip_gold"
+	ItemArmorEquipIron                         Sound = "item.armor.equip_iron"
+	ItemArmorEquipLeather                      Sound = "item.armor.equip_leather"
+	ItemArmorEquipNetherite                    Sound = "item.armor.equip_netherite"
+	ItemArmorEquipTurtle                       Sound = "item.armor.equip_turtle"
+	ItemArmorEquipWolf                         Sound = "item.armor.equip_wolf"
+	ItemArmorUnequipWolf                       Sound = "item.armor.unequip_wolf"
+	ItemAxeScrape                              Sound = "item.axe.scrape"
+	ItemAxeStrip                               Sound = "item.axe.strip"
+	ItemAxeWaxOff                              Sound = "item.axe.wax_off"
+	ItemBoneMealUse                            Sound = "item.bone_meal.use"
+	ItemBookPageTurn                           Sound = "item.book.page_turn"
+	ItemBookPut                                Sound = "item.book.put"
+	ItemBottleEmpty                            Sound = "item.bottle.empty"
+	ItemBottleFill                             Sound = "item.bottle.fill"
+	ItemBottleFillDragonbreath                 Sound = "item.bottle.fill_dragonbreath"
+	ItemBrushBrushingGeneric                   Sound = "item.brush.brushing.generic"
+	ItemBrushBrushingGravel                    Sound = "item.brush.brushing.gravel"
+	ItemBrushBrushingGravelComplete            Sound = "item.brush.brushing.gravel.complete"
+	ItemBrushBrushingSand                      Sound = "item.brush.brushing.sand"
+	ItemBrushBrushingSandComplete              Sound = "item.brush.brushing.sand.complete"
+	ItemBucketEmpty                            Sound = "item.bucket.empty"
+	ItemBucketEmptyAxolotl                     Sound = "item.bucket.empty_axolotl"
+	ItemBucketEmptyFish                        Sound = "item.bucket.empty_fish"
+	ItemBucketEmptyLava                        Sound = "item.bucket.empty_lava"
+	ItemBucketEmptyPowderSnow                  Sound = "item.bucket.empty_powder_snow"
+	ItemBucketEmptyTadpole                     Sound = "item.bucket.empty_tadpole"
+	ItemBucketFill                             Sound = "item.bucket.fill"
+	ItemBucketFillAxolotl                      Sound = "item.bucket.fill_axolotl"
+	ItemBucketFillFish                         Sound = "item.bucket.fill_fish"
+	ItemBucketFillLava                         Sound = "item.bucket.fill_lava"
+	ItemBucketFillPowderSnow                   Sound = "item.bucket.fill_powder_snow"
+	ItemBucketFillTadpole                      Sound = "item.bucket.fill_tadpole"
+	ItemBundleDropContents                     Sound = "item.bundle.drop_contents"
+	ItemBundleInsert                           Sound = "item.bundle.insert"
+	ItemBundleInsertFail                       Sound = "item.bundle.insert_fail"
+	ItemBundleRemoveOne                        Sound = "item.bundle.remove_one"
+	ItemChorusFruitTeleport                    Sound = "item.chorus_fruit.teleport"
+	ItemCropPlant                              Sound = "item.crop.plant"
+	ItemCrossbowHit                            Sound = "item.crossbow.hit"
+	ItemCrossbowLoadingEnd                     Sound = "item.crossbow.loading_end"
+	ItemCrossbowLoadingMiddle                  Sound = "item.crossbow.loading_middle"
+	ItemCrossbowLoadingStart                   Sound = "item.crossbow.loading_start"
+	ItemCrossbowQuickCharge1                   Sound = "item.crossbow.quick_charge_1"
+	ItemCrossbowQuickCharge2                   Sound = "item.crossbow.quick_charge_2"
+	ItemCrossbowQuickCharge3                   Sound = "item.crossbow.quick_charge_3"
+	ItemCrossbowShoot                          Sound = "item.crossbow.shoot"
+	ItemDyeUse                                 Sound = "item.dye.use"
+	ItemElytraFlying                           Sound = "item.elytra.flying"
+	ItemFirechargeUse                          Sound = "item.firecharge.use"
+	ItemFlintandsteelUse                       Sound = "item.flintandsteel.use"
+	ItemGlowInkSacUse                          Sound = "item.glow_ink_sac.use"
+	ItemGoatHornSound0                         Sound = "item.goat_horn.sound.0"
+	ItemGoatHornSound1                         Sound = "item.goat_horn.sound.1"
+	ItemGoatHornSound2                         Sound = "item.goat_horn.sound.2"
+	ItemGoatHornSound3                         Sound = "item.goat_horn.sound.3"
+	ItemGoatHornSound4                         Sound = "item.goat_horn.sound.4"
+	ItemGoatHornSound5                         Sound = "item.goat_horn.sound.5"
+	ItemGoatHornSound6                         Sound = "item.goat_horn.sound.6"
+	ItemGoatHornSound7                         Sound = "item.goat_horn.sound.7"
+	ItemHoeTill                                Sound = "item.hoe.till"
+	ItemHoneyBottleDrink                       Sound = "item.honey_bottle.drink"
+	ItemHoneycombWaxOn                         Sound = "item.honeycomb.wax_on"
+	ItemInkSacUse                              Sound = "item.ink_sac.use"
+	ItemLodestoneCompassLock                   Sound = "item.lodestone_compass.lock"
+	ItemMaceSmashAir                           Sound = "item.mace.smash_air"
+	ItemMaceSmashGround                        Sound = "item.mace.smash_ground"
+	ItemMaceSmashGroundHeavy                   Sound = "item.mace.smash_ground_heavy"
+	ItemNetherWartPlant                        Sound = "item.nether_wart.plant"
+	ItemOminousBottleDispose                   Sound = "item.ominous_bottle.dispose"
+	ItemShieldBlock                            Sound = "item.shield.block"
+	ItemShieldBreak                            Sound = "item.shield.break"
+	ItemShovelFlatten                          Sound = "item.shovel.flatten"
+	ItemSpyglassStopUsing                      Sound = "item.spyglass.stop_using"
+	ItemSpyglassUse                            Sound = "item.spyglass.use"
+	ItemTotemUse                               Sound = "item.totem.use"
+	ItemTridentHit                             Sound = "item.trident.hit"
+	ItemTridentHitGround                       Sound = "item.trident.hit_ground"
+	ItemTridentReturn                          Sound = "item.trident.return"
+	ItemTridentRiptide1                        Sound = "item.trident.riptide_1"
+	ItemTridentRiptide2                        Sound = "item.trident.riptide_2"
+	ItemTridentRiptide3                        Sound = "item.trident.riptide_3"
+	ItemTridentThrow                           Sound = "item.trident.throw"
+	ItemTridentThunder                         Sound = "item.trident.thunder"
+	ItemWolfArmorBreak                         Sound = "item.wolf_armor.break"
+	ItemWolfArmorCrack                         Sound = "item.wolf_armor.crack"
+	ItemWolfArmorDamage                        Sound = "item.wolf_armor.damage"
+	ItemWolfArmorRepair                        Sound = "item.wolf_armor.repair"
+	MusicCreative                              Sound = "music.creative"
+	MusicCredits                               Sound = "music.credits"
+	MusicDragon                                Sound = "music.dragon"
+	MusicEnd                                   Sound = "music.end"
+	MusicGame                                  Sound = "music.game"
+	MusicMenu                                  Sound = "music.menu"
+	MusicNetherBasaltDeltas                    Sound = "music.nether.basalt_deltas"
+	MusicNetherCrimsonForest                   Sound = "music.nether.crimson_forest"
+	MusicNetherNetherWastes                    Sound = "music.nether.nether_wastes"
+	MusicNetherSoulSandValley                  Sound = "music.nether.soul_sand_valley"
+	MusicNetherWarpedForest                    Sound = "music.nether.warped_forest"
+	MusicOverworldBadlands                     Sound = "music.overworld.badlands"
+	MusicOverworldBambooJungle                 Sound = "music.overworld.bamboo_jungle"
+	MusicOverworldCherryGrove                  Sound = "music.overworld.cherry_grove"
+	MusicOverworldDeepDark                     Sound = "music.overworld.deep_dark"
+	MusicOverworldDesert                       Sound = "music.overworld.desert"
+	MusicOverworldDripstoneCaves               Sound = "music.overworld.dripstone_caves"
+	MusicOverworldFlowerForest                 Sound = "music.overworld.flower_forest"
+	MusicOverworldForest                       Sound = "music.overworld.forest"
+	MusicOverworldFrozenPeaks                  Sound = "music.overworld.frozen_peaks"
+	MusicOverworldGrove                        Sound = "music.overworld.grove"
+	MusicOverworldJaggedPeaks                  Sound = "music.overworld.jagged_peaks"
+	MusicOverworldJungle                       Sound = "music.overworld.jungle"
+	MusicOverworldLushCaves                    Sound = "music.overworld.lush_caves"
+	MusicOverworldMeadow                       Sound = "music.overworld.meadow"
+	MusicOverworldOldGrowthTaiga               Sound = "music.overworld.old_growth_taiga"
+	MusicOverworldSnowySlopes                  Sound = "music.overworld.snowy_slopes"
+	MusicOverworldSparseJungle                 Sound = "music.overworld.sparse_jungle"
+	MusicOverworldStonyPeaks                   Sound = "music.overworld.stony_peaks"
+	MusicOverworldSwamp                        Sound = "music.overworld.swamp"
+	MusicUnderWater                            Sound = "music.under_water"
+	MusicDisc11Sound                           Sound = "music_disc.11"
+	MusicDisc13Sound                           Sound = "music_disc.13"
+	MusicDisc5Sound                            Sound = "music_disc.5"
+	MusicDiscBlocksSound                       Sound = "music_disc.blocks"
+	MusicDiscCatSound                          Sound = "music_disc.cat"
+	MusicDiscChirpSound                        Sound = "music_disc.chirp"
+	MusicDiscCreatorSound                      Sound = "music_disc.creator"
+	MusicDiscCreatorMusicBoxSound              Sound = "music_disc.creator_music_box"
+	MusicDiscFarSound                          Sound = "music_disc.far"
+	MusicDiscMallSound                         Sound = "music_disc.mall"
+	MusicDiscMellohiSound                      Sound = "music_disc.mellohi"
+	MusicDiscOthersideSound                    Sound = "music_disc.otherside"
+	MusicDiscPigstepSound                      Sound = "music_disc.pigstep"
+	MusicDiscPrecipiceSound                    Sound = "music_disc.precipice"
+	MusicDiscRelicSound                        Sound = "music_disc.relic"
+	MusicDiscStalSound                         Sound = "music_disc.stal"
+	MusicDiscStradSound                        Sound = "music_disc.strad"
+	MusicDiscWaitSound                         Sound = "music_disc.wait"
+	MusicDiscWardSound                         Sound = "music_disc.ward"
+	ParticleSoulEscape                         Sound = "particle.soul_escape"
+	UiButtonClick                              Sound = "ui.button.click"
+	UiCartographyTableTakeResult               Sound = "ui.cartography_table.take_result"
+	UiHudBubblePop                             Sound = "ui.hud.bubble_pop"
+	UiLoomSelectPattern                        Sound = "ui.loom.select_pattern"
+	UiLoomTakeResult                           Sound = "ui.loom.take_result"
+	UiStonecutterSelectRecipe                  Sound = "ui.stonecutter.select_recipe"
+	UiStonecutterTakeResult                    Sound = "ui.stonecutter.take_result"
+	UiToastChallengeComplete                   Sound = "ui.toast.challenge_complete"
+	UiToastIn                                  Sound = "ui.toast.in"
+	UiToastOut                                 Sound = "ui.toast.out"
+	WeatherRain                                Sound = "weather.rain"
+	WeatherRainAbove                           Sound = "weather.rain.above"
+)
@@ -0,0 +1,94 @@
+package commands_test
+
+import (
+	"archive/zip"
+	"errors"
+	"minecraftgo/commands"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeJar writes a server jar that only has version.json
+func fakeJar(t *testing.T, version string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "server.jar")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	entry, err := w.Create("version.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry.Write([]byte(`{"id": "` + version + `", "name": "` + version + `"}`))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestServerRegistry(t *testing.T) {
+	jar := fakeJar(t, "1.21.4")
+	dir := t.TempDir()
+	// a report generated earlier, so java isn't run
+	reports := filepath.Join(dir, "1.21.4", "reports")
+	os.MkdirAll(reports, 0755)
+	os.WriteFile(filepath.Join(reports, "registries.json"), []byte(`{
+		"minecraft:item": {"entries": {"minecraft:diamond": {}, "minecraft:golden_apple": {}}},
+		"minecraft:block": {"entries": {"minecraft:stone": {}}},
+		"minecraft:sound_event": {"entries": {"minecraft:entity.creeper.primed": {}}}
+	}`), 0644)
+
+	reg, err := commands.ServerRegistry(jar, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.ValidateItem("diamond[minecraft:rarity=epic]"); err != nil {
+		t.Error(err)
+	}
+	if err := reg.ValidateItem("minecraft:emerald_sword"); !errors.Is(err, commands.ErrUnknownEntry) {
+		t.Errorf("expected an unknown entry, got %v", err)
+	}
+	if err := reg.ValidateBlock("stone"); err != nil {
+		t.Error(err)
+	}
+	if err := reg.ValidateSound("entity.creeper.primed"); err != nil {
+		t.Error(err)
+	}
+
+	commands.UseRegistry(reg)
+	if d := commands.NewDialect("1.21.4"); d.Registry != reg {
+		t.Error("dialect doesn't use the server's registry")
+	}
+}
+
+func TestJarVersion(t *testing.T) {
+	if version, err := commands.JarVersion(fakeJar(t, "1.20.4")); err != nil || version != "1.20.4" {
+		t.Errorf("got %q, %v", version, err)
+	}
+	if _, err := commands.JarVersion(filepath.Join(t.TempDir(), "missing.jar")); err == nil {
+		t.Error("expected an error for a missing jar")
+	}
+}
+
+func TestDialectFallsBackToOlderFixture(t *testing.T) {
+	d := commands.NewDialect("1.21.1")
+	if d.Registry == nil || d.Registry.Version != "1.20.4" {
+		t.Fatalf("got registry %+v", d.Registry)
+	}
+	if err := d.Registry.ValidateItem(string(commands.DiamondSword)); err != nil {
+		t.Error(err)
+	}
+	if err := d.Registry.ValidateItem("minecraft:emerald_sword"); !errors.Is(err, commands.ErrUnknownEntry) {
+		t.Errorf("expected an unknown entry, got %v", err)
+	}
+	if err := d.Registry.ValidateBlock(string(commands.OakLog)); err != nil {
+		t.Error(err)
+	}
+	if err := d.Registry.ValidateSound(string(commands.EntityCreeperPrimed)); err != nil {
+		t.Error(err)
+	}
+}
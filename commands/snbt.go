@@ -0,0 +1,337 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SNBT is the stringified NBT format the server uses when printing entity data,
+// e.g. the response to "/data get entity <player> Inventory".
+
+type Compound map[string]any
+
+type List []any
+
+// Array is one of the typed arrays, [B; ...], [I; ...] or [L; ...]
+type Array struct {
+	Type   byte
+	Values []Literal
+}
+
+// Literal is an unquoted token such as 1b, 0.5d, 64 or true, kept as-is so it
+// can be written back without losing its type suffix
+type Literal string
+
+func (l Literal) Float() (float64, error) {
+	s := string(l)
+	switch s {
+	case "true":
+		return 1, nil
+	case "false":
+		return 0, nil
+	}
+	if len(s) > 1 && strings.ContainsAny(s[len(s)-1:], "bBsSlLfFdD") {
+		s = s[:len(s)-1]
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func (l Literal) Int() (int, error) {
+	f, err := l.Float()
+	return int(f), err
+}
+
+func (c Compound) String(key string) string {
+	s, _ := c[key].(string)
+	return s
+}
+
+func (c Compound) Int(key string) (int, bool) {
+	l, ok := c[key].(Literal)
+	if !ok {
+		return 0, false
+	}
+	i, err := l.Int()
+	return i, err == nil
+}
+
+func (c Compound) Float(key string) (float64, bool) {
+	l, ok := c[key].(Literal)
+	if !ok {
+		return 0, false
+	}
+	f, err := l.Float()
+	return f, err == nil
+}
+
+func (c Compound) Compound(key string) Compound {
+	v, _ := c[key].(Compound)
+	return v
+}
+
+func ParseSNBT(s string) (any, error) {
+	p := &snbtParser{src: s}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected trailing data")
+	}
+	return v, nil
+}
+
+func EncodeSNBT(v any) string {
+	var sb strings.Builder
+	encodeSNBT(&sb, v)
+	return sb.String()
+}
+
+func encodeSNBT(sb *strings.Builder, v any) {
+	switch val := v.(type) {
+	case Compound:
+		sb.WriteByte('{')
+		for idx, k := range sortedKeys(val) {
+			if idx > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(snbtKey(k))
+			sb.WriteString(": ")
+			encodeSNBT(sb, val[k])
+		}
+		sb.WriteByte('}')
+	case List:
+		sb.WriteByte('[')
+		for idx, item := range val {
+			if idx > 0 {
+				sb.WriteString(", ")
+			}
+			encodeSNBT(sb, item)
+		}
+		sb.WriteByte(']')
+	case Array:
+		sb.WriteByte('[')
+		sb.WriteByte(val.Type)
+		sb.WriteByte(';')
+		for idx, item := range val.Values {
+			if idx > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteByte(' ')
+			sb.WriteString(string(item))
+		}
+		sb.WriteByte(']')
+	case Literal:
+		sb.WriteString(string(val))
+	case string:
+		sb.WriteString(strconv.Quote(val))
+	default:
+		sb.WriteString(fmt.Sprint(val))
+	}
+}
+
+func sortedKeys(c Compound) []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func snbtKey(k string) string {
+	for _, r := range k {
+		if !isUnquotedRune(r) {
+			return strconv.Quote(k)
+		}
+	}
+	if k == "" {
+		return `""`
+	}
+	return k
+}
+
+func isUnquotedRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		r == '_' || r == '-' || r == '.' || r == '+'
+}
+
+type snbtParser struct {
+	src string
+	pos int
+}
+
+func (p *snbtParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("snbt: %s at offset %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *snbtParser) skipSpace() {
+	for p.pos < len(p.src) && strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *snbtParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *snbtParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *snbtParser) value() (any, error) {
+	switch p.peek() {
+	case 0:
+		return nil, p.errorf("unexpected end of input")
+	case '{':
+		return p.compound()
+	case '[':
+		return p.list()
+	case '"', '\'':
+		return p.quoted()
+	default:
+		tok := p.unquoted()
+		if tok == "" {
+			return nil, p.errorf("unexpected character %q", p.src[p.pos])
+		}
+		if _, err := Literal(tok).Float(); err == nil {
+			return Literal(tok), nil
+		}
+		return tok, nil
+	}
+}
+
+func (p *snbtParser) compound() (Compound, error) {
+	p.pos++ // {
+	c := Compound{}
+	if p.peek() == '}' {
+		p.pos++
+		return c, nil
+	}
+	for {
+		var key string
+		if ch := p.peek(); ch == '"' || ch == '\'' {
+			k, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		} else {
+			key = p.unquoted()
+			if key == "" {
+				return nil, p.errorf("expected key")
+			}
+		}
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		c[key] = v
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return c, nil
+		default:
+			return nil, p.errorf("expected ',' or '}'")
+		}
+	}
+}
+
+func (p *snbtParser) list() (any, error) {
+	p.pos++ // [
+	if p.pos+1 < len(p.src) && p.src[p.pos+1] == ';' && strings.ContainsRune("BIL", rune(p.src[p.pos])) {
+		arr := Array{Type: p.src[p.pos]}
+		p.pos += 2
+		if p.peek() == ']' {
+			p.pos++
+			return arr, nil
+		}
+		for {
+			tok := p.unquoted()
+			if tok == "" {
+				return nil, p.errorf("expected array value")
+			}
+			arr.Values = append(arr.Values, Literal(tok))
+			switch p.peek() {
+			case ',':
+				p.pos++
+			case ']':
+				p.pos++
+				return arr, nil
+			default:
+				return nil, p.errorf("expected ',' or ']'")
+			}
+		}
+	}
+
+	l := List{}
+	if p.peek() == ']' {
+		p.pos++
+		return l, nil
+	}
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, v)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return l, nil
+		default:
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (p *snbtParser) quoted() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		p.pos++
+		switch ch {
+		case '\\':
+			if p.pos >= len(p.src) {
+				return "", p.errorf("unterminated escape")
+			}
+			sb.WriteByte(p.src[p.pos])
+			p.pos++
+		case quote:
+			return sb.String(), nil
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *snbtParser) unquoted() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && isUnquotedRune(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
@@ -0,0 +1,288 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"minecraftgo/discord"
+	"minecraftgo/input"
+	"minecraftgo/twitch"
+	"os"
+	"strings"
+)
+
+type Config struct {
+	// channel whose chat and events drive the game
+	BroadcasterLogin string `json:"broadcaster_login"`
+	// account that reads chat, when empty the broadcaster's own account does
+	BotLogin string `json:"bot_login"`
+	// minecraft player the chaos happens to
+	PlayerName string `json:"player_name"`
+	// how long a chat command has to wait before it can be used again
+	CommandCooldownSeconds int `json:"command_cooldown_seconds"`
+	// what chat commands do, see actions.ChatCommand. "commands.json" when
+	// empty, changes are picked up while running
+	CommandsFile string `json:"commands_file"`
+	// how often chat gets a poll on the next chaos event, 0 only starts them
+	// when a moderator says "poll"
+	PollIntervalSeconds int `json:"poll_interval_seconds"`
+	// how many options each poll has and how long voting stays open
+	PollChoices         int `json:"poll_choices"`
+	PollDurationSeconds int `json:"poll_duration_seconds"`
+	// what polls pick their choices from by title, the rewards when empty
+	PollOptions map[string]PollOption `json:"poll_options"`
+	// channel point rewards by title, see Reward
+	Rewards map[string]Reward `json:"rewards"`
+	// what bits, subs, gifted subs and tips do, see Support
+	Support Support `json:"support"`
+	// predictions by the name a moderator starts them with, see Prediction
+	Predictions map[string]Prediction `json:"predictions"`
+	// what gets said in chat after a command, see Replies
+	Replies Replies `json:"replies"`
+	// overrides for the twitch urls, e.g. to run against twitchmock
+	Twitch twitch.Endpoints `json:"twitch"`
+	// receive events over webhooks instead of websockets, see Webhook
+	Webhook Webhook `json:"webhook"`
+	// chat commands from a discord channel as well, see Discord
+	Discord Discord `json:"discord"`
+	// chat, Super Chats and memberships from a YouTube simulcast, see YouTube
+	YouTube YouTube `json:"youtube"`
+	// tips from alert services, each posts to /alerts/<name>
+	Alerts []AlertSource `json:"alerts"`
+	// US dollars per unit of a currency, on top of input.DefaultRates
+	CurrencyRates input.Rates `json:"currency_rates"`
+}
+
+// Webhook is where twitch sends events when set. CallbackUrl has to be https
+// on port 443 and reach /eventsub on this server, e.g. through a tunnel.
+type Webhook struct {
+	CallbackUrl string `json:"callback_url"`
+	// 10 to 100 characters, used to sign every message
+	Secret string `json:"secret"`
+}
+
+func (w Webhook) Enabled() bool {
+	return w.CallbackUrl != ""
+}
+
+// Discord lets members of a server run the same commands as twitch chat, by
+// writing Prefix and the command in ChannelId or with the /mc slash command
+type Discord struct {
+	// the bot's token, leave empty to keep discord off
+	Token string `json:"token"`
+	// needed to register /mc, without it only prefixed messages work
+	ApplicationId string `json:"application_id"`
+	GuildId       string `json:"guild_id"`
+	ChannelId     string `json:"channel_id"`
+	// "!" when empty
+	Prefix string `json:"prefix"`
+	// role id to the badge it gives, "broadcaster" and "moderator" may start
+	// polls and predictions
+	Roles map[string]string `json:"roles"`
+	// when set, only members with this role id can use commands
+	RequireRole string `json:"require_role"`
+	// overrides for the discord urls, e.g. to run against discordmock
+	Endpoints discord.Endpoints `json:"endpoints"`
+}
+
+func (d Discord) Enabled() bool {
+	return d.Token != ""
+}
+
+// YouTube reads the live chat of VideoId with ApiKey. It can only read, so
+// YouTube viewers get no replies. Super Chats count as tips, converted with
+// currency_rates.
+type YouTube struct {
+	ApiKey  string `json:"api_key"`
+	VideoId string `json:"video_id"`
+	// instead of VideoId, when the chat id is already known
+	LiveChatId string `json:"live_chat_id"`
+	// membership level name to the twitch sub tier it counts as, "1000", "2000"
+	// or "3000", levels not listed count as "1000"
+	MembershipTiers map[string]string `json:"membership_tiers"`
+	// override for the api url, e.g. to run against youtubemock
+	Api string `json:"api"`
+}
+
+func (y YouTube) Enabled() bool {
+	return y.ApiKey != ""
+}
+
+// AlertSource is an alert service that posts tips to /alerts/<Name>, signed
+// with an HMAC-SHA256 of the body made with Secret
+type AlertSource struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+	// "X-Signature" when empty
+	SignatureHeader string `json:"signature_header"`
+	// "USD" when empty
+	DefaultCurrency string            `json:"default_currency"`
+	Fields          input.AlertFields `json:"fields"`
+}
+
+// Replies are chat templates for how a command went. {user}, {command},
+// {reason} and {seconds} are filled in, a template set to "-" stays quiet.
+type Replies struct {
+	Success  string `json:"success"`
+	Cooldown string `json:"cooldown"`
+	Invalid  string `json:"invalid"`
+	Offline  string `json:"offline"`
+}
+
+var defaultReplies = Replies{
+	Success:  "@{user} {command} done!",
+	Cooldown: "@{user} {command} is on cooldown, try again in {seconds}s",
+	Invalid:  "@{user} {command} didn't work: {reason}",
+	Offline:  "@{user} the server is offline right now",
+}
+
+// Format fills in a reply template, an empty result means say nothing
+func Format(template string, vars map[string]string) string {
+	if template == "-" {
+		return ""
+	}
+	pairs := make([]string, 0, len(vars)*2)
+	for key, value := range vars {
+		pairs = append(pairs, "{"+key+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	cfg.BroadcasterLogin = strings.ToLower(strings.TrimSpace(cfg.BroadcasterLogin))
+	cfg.BotLogin = strings.ToLower(strings.TrimSpace(cfg.BotLogin))
+	cfg.PlayerName = strings.TrimSpace(cfg.PlayerName)
+	cfg.Replies.fillDefaults()
+	if cfg.CommandsFile == "" {
+		cfg.CommandsFile = "commands.json"
+	}
+	if cfg.Discord.Prefix == "" {
+		cfg.Discord.Prefix = "!"
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.BroadcasterLogin == "" {
+		errs = append(errs, errors.New("broadcaster_login is required"))
+	}
+	if c.PlayerName == "" {
+		errs = append(errs, errors.New("player_name is required"))
+	}
+	if c.PollIntervalSeconds < 0 {
+		errs = append(errs, errors.New("poll_interval_seconds can't be negative"))
+	}
+	if c.PollChoices != 0 && (c.PollChoices < 2 || c.PollChoices > 5) {
+		errs = append(errs, errors.New("poll_choices must be between 2 and 5"))
+	}
+	if c.PollDurationSeconds != 0 && (c.PollDurationSeconds < 15 || c.PollDurationSeconds > 1800) {
+		errs = append(errs, errors.New("poll_duration_seconds must be between 15 and 1800"))
+	}
+	if c.Webhook.Enabled() {
+		if !strings.HasPrefix(c.Webhook.CallbackUrl, "https://") {
+			errs = append(errs, errors.New("webhook callback_url must be https"))
+		}
+		if len(c.Webhook.Secret) < 10 || len(c.Webhook.Secret) > 100 {
+			errs = append(errs, errors.New("webhook secret must be 10 to 100 characters"))
+		}
+	}
+	if c.Discord.Enabled() {
+		if c.Discord.ChannelId == "" {
+			errs = append(errs, errors.New("discord channel_id is required"))
+		}
+		if c.Discord.ApplicationId == "" && c.Discord.GuildId != "" {
+			errs = append(errs, errors.New("discord guild_id needs application_id to register /mc"))
+		}
+		if strings.TrimSpace(c.Discord.Prefix) != c.Discord.Prefix {
+			errs = append(errs, errors.New("discord prefix can't start or end with spaces"))
+		}
+	}
+	if c.YouTube.Enabled() {
+		if c.YouTube.VideoId == "" && c.YouTube.LiveChatId == "" {
+			errs = append(errs, errors.New("youtube needs video_id or live_chat_id"))
+		}
+		for level, tier := range c.YouTube.MembershipTiers {
+			if tier != "1000" && tier != "2000" && tier != "3000" {
+				errs = append(errs, fmt.Errorf("youtube membership tier for %q must be 1000, 2000 or 3000", level))
+			}
+		}
+	}
+	names := map[string]bool{}
+	for _, alert := range c.Alerts {
+		if alert.Name == "" || strings.Trim(alert.Name, "abcdefghijklmnopqrstuvwxyz0123456789_-") != "" {
+			errs = append(errs, fmt.Errorf("alert name %q must be lowercase letters, digits, _ or -", alert.Name))
+		}
+		if names[alert.Name] {
+			errs = append(errs, fmt.Errorf("alert name %q is used twice", alert.Name))
+		}
+		names[alert.Name] = true
+		if len(alert.Secret) < 10 {
+			errs = append(errs, fmt.Errorf("alert %s secret must be at least 10 characters", alert.Name))
+		}
+		if alert.Fields.Amount == "" {
+			errs = append(errs, fmt.Errorf("alert %s needs fields.amount", alert.Name))
+		}
+		if alert.Fields.Id == "" && alert.Fields.Time == "" {
+			errs = append(errs, fmt.Errorf("alert %s needs fields.id or fields.time so alerts can't be replayed", alert.Name))
+		}
+		if alert.DefaultCurrency != "" && c.Rates()[strings.ToUpper(alert.DefaultCurrency)] == 0 {
+			errs = append(errs, fmt.Errorf("alert %s default_currency %s has no rate", alert.Name, alert.DefaultCurrency))
+		}
+	}
+	for currency, rate := range c.CurrencyRates {
+		if rate <= 0 {
+			errs = append(errs, fmt.Errorf("currency rate for %s must be positive", currency))
+		}
+	}
+	if c.CommandCooldownSeconds < 0 {
+		errs = append(errs, errors.New("command_cooldown_seconds can't be negative"))
+	}
+	errs = append(errs, c.validateGame()...)
+	return errors.Join(errs...)
+}
+
+func (r *Replies) fillDefaults() {
+	if r.Success == "" {
+		r.Success = defaultReplies.Success
+	}
+	if r.Cooldown == "" {
+		r.Cooldown = defaultReplies.Cooldown
+	}
+	if r.Invalid == "" {
+		r.Invalid = defaultReplies.Invalid
+	}
+	if r.Offline == "" {
+		r.Offline = defaultReplies.Offline
+	}
+}
+
+// Rates are the default currency rates with the ones from config on top
+func (c *Config) Rates() input.Rates {
+	rates := input.Rates{}
+	for currency, rate := range input.DefaultRates {
+		rates[currency] = rate
+	}
+	for currency, rate := range c.CurrencyRates {
+		rates[strings.ToUpper(currency)] = rate
+	}
+	return rates
+}
+
+// HasBot reports whether chat is read by a separate bot account
+func (c *Config) HasBot() bool {
+	return c.BotLogin != "" && c.BotLogin != c.BroadcasterLogin
+}
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"minecraftgo/actions"
+	"minecraftgo/game"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Reward is a channel point reward, it's created on the channel at startup
+// so redemptions can be fulfilled or refunded
+type Reward struct {
+	Cost   int          `json:"cost"`
+	Action actions.Spec `json:"action"`
+	// how long a timed action lasts before it's reverted, 0 keeps it
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+}
+
+// PollOption is something chat can vote for in a poll
+type PollOption struct {
+	Action          actions.Spec `json:"action"`
+	DurationSeconds int          `json:"duration_seconds,omitempty"`
+}
+
+// Tier is one rung of a support ladder, the highest one an amount reaches runs
+type Tier struct {
+	// bits, months, gifted subs or US cents, depending on the ladder
+	Min int `json:"min"`
+	// lowest sub tier that reaches this tier, "1000", "2000" or "3000", empty for any
+	SubTier         string       `json:"sub_tier,omitempty"`
+	Action          actions.Spec `json:"action"`
+	DurationSeconds int          `json:"duration_seconds,omitempty"`
+	// when set, the action runs once per unit of the amount, at most max times
+	Unit int `json:"unit,omitempty"`
+	Max  int `json:"max,omitempty"`
+}
+
+// Support are the ladders for bits, subs, gifted subs and tips
+type Support struct {
+	Cheer     []Tier `json:"cheer"`
+	Subscribe []Tier `json:"subscribe"`
+	Resub     []Tier `json:"resub"`
+	GiftSub   []Tier `json:"gift_sub"`
+	Tip       []Tier `json:"tip"`
+}
+
+// Prediction is a yes/no prediction a moderator starts with "predict <name>"
+type Prediction struct {
+	Title   string `json:"title"`
+	Happens string `json:"happens"`
+	DoesNot string `json:"does_not"`
+	// how long viewers can bet, 30 to 1800
+	WindowSeconds int `json:"window_seconds"`
+	// how long the condition has to happen in after betting closes
+	DurationSeconds int `json:"duration_seconds"`
+	// "death", "advancement" or "dimension"
+	Condition string `json:"condition"`
+	// advancement title or dimension id to wait for, empty for any
+	Value string `json:"value,omitempty"`
+}
+
+func seconds(s int) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+func (c *Config) RewardMap() actions.RewardMap {
+	rewards := actions.RewardMap{}
+	for title, r := range c.Rewards {
+		rewards[title] = actions.Reward{Spec: r.Action, Duration: seconds(r.DurationSeconds), Cost: r.Cost}
+	}
+	return rewards
+}
+
+// PollOptionMap is what polls pick from, the rewards unless poll options are set
+func (c *Config) PollOptionMap() actions.RewardMap {
+	if len(c.PollOptions) == 0 {
+		return c.RewardMap()
+	}
+	options := actions.RewardMap{}
+	for title, o := range c.PollOptions {
+		options[title] = actions.Reward{Spec: o.Action, Duration: seconds(o.DurationSeconds)}
+	}
+	return options
+}
+
+func tierTable(tiers []Tier) actions.TierTable {
+	table := make(actions.TierTable, 0, len(tiers))
+	for _, t := range tiers {
+		table = append(table, actions.Tier{Min: t.Min, SubTier: t.SubTier, Spec: t.Action, Duration: seconds(t.DurationSeconds), Unit: t.Unit, Max: t.Max})
+	}
+	return table
+}
+
+func (c *Config) SupportTiers() actions.SupportTiers {
+	return actions.SupportTiers{
+		Cheer:     tierTable(c.Support.Cheer),
+		Subscribe: tierTable(c.Support.Subscribe),
+		Resub:     tierTable(c.Support.Resub),
+		GiftSub:   tierTable(c.Support.GiftSub),
+		Tip:       tierTable(c.Support.Tip),
+	}
+}
+
+func (p Prediction) spec() game.PredictionSpec {
+	return game.PredictionSpec{Title: p.Title, Happens: p.Happens, DoesNot: p.DoesNot,
+		Window: seconds(p.WindowSeconds), Duration: seconds(p.DurationSeconds), Condition: p.Condition, Value: p.Value}
+}
+
+func (c *Config) PredictionSpecs() map[string]game.PredictionSpec {
+	specs := map[string]game.PredictionSpec{}
+	for name, p := range c.Predictions {
+		specs[name] = p.spec()
+	}
+	return specs
+}
+
+// validateGame checks rewards, poll options, support tiers and predictions,
+// actions are built once so a typo shows up now and not when a viewer pays for it
+func (c *Config) validateGame() []error {
+	var errs []error
+	checkAction := func(what string, spec actions.Spec, durationSeconds int) {
+		if _, err := actions.Build(spec); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", what, err))
+		}
+		if durationSeconds < 0 {
+			errs = append(errs, fmt.Errorf("%s: duration_seconds can't be negative", what))
+		}
+	}
+
+	titles := make([]string, 0, len(c.Rewards))
+	for title := range c.Rewards {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	seen := map[string]bool{}
+	for _, title := range titles {
+		r := c.Rewards[title]
+		if strings.TrimSpace(title) == "" || len(title) > 45 {
+			errs = append(errs, fmt.Errorf("reward title %q must be 1 to 45 characters", title))
+		}
+		if seen[strings.ToLower(title)] {
+			errs = append(errs, fmt.Errorf("reward %q is there twice with different case", title))
+		}
+		seen[strings.ToLower(title)] = true
+		if r.Cost < 1 {
+			errs = append(errs, fmt.Errorf("reward %q needs a cost of at least 1", title))
+		}
+		checkAction("reward "+title, r.Action, r.DurationSeconds)
+	}
+
+	options := make([]string, 0, len(c.PollOptions))
+	for title := range c.PollOptions {
+		options = append(options, title)
+	}
+	sort.Strings(options)
+	for _, title := range options {
+		// twitch's limit on a choice title
+		if strings.TrimSpace(title) == "" || len(title) > 25 {
+			errs = append(errs, fmt.Errorf("poll option title %q must be 1 to 25 characters", title))
+		}
+		o := c.PollOptions[title]
+		checkAction("poll option "+title, o.Action, o.DurationSeconds)
+	}
+	if len(c.PollOptions) == 1 {
+		errs = append(errs, errors.New("poll_options needs at least 2 options"))
+	}
+
+	ladders := []struct {
+		name  string
+		tiers []Tier
+	}{{"cheer", c.Support.Cheer}, {"subscribe", c.Support.Subscribe}, {"resub", c.Support.Resub}, {"gift_sub", c.Support.GiftSub}, {"tip", c.Support.Tip}}
+	for _, ladder := range ladders {
+		for idx, t := range ladder.tiers {
+			what := fmt.Sprintf("support %s tier %d", ladder.name, idx+1)
+			if t.Min < 1 {
+				errs = append(errs, fmt.Errorf("%s: min must be at least 1", what))
+			}
+			if t.SubTier != "" && t.SubTier != "1000" && t.SubTier != "2000" && t.SubTier != "3000" {
+				errs = append(errs, fmt.Errorf("%s: sub_tier must be 1000, 2000 or 3000", what))
+			}
+			if t.Unit < 0 || t.Max < 0 {
+				errs = append(errs, fmt.Errorf("%s: unit and max can't be negative", what))
+			}
+			checkAction(what, t.Action, t.DurationSeconds)
+		}
+	}
+
+	names := make([]string, 0, len(c.Predictions))
+	for name := range c.Predictions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := c.Predictions[name]
+		if name == "" || strings.ContainsFunc(name, func(r rune) bool { return r == ' ' }) {
+			errs = append(errs, fmt.Errorf("prediction name %q can't be empty or have spaces", name))
+		}
+		if err := p.spec().Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("prediction %s: %w", name, err))
+		}
+		if p.WindowSeconds < 30 || p.WindowSeconds > 1800 {
+			errs = append(errs, fmt.Errorf("prediction %s: window_seconds must be between 30 and 1800", name))
+		}
+	}
+	return errs
+}
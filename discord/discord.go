@@ -0,0 +1,239 @@
+// Package discord reads chat commands from a Discord channel, through the
+// gateway for messages and slash commands and the REST api for answers.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	discordGatewayUrl = "wss://gateway.discord.gg/?v=10&encoding=json"
+	discordApiUrl     = "https://discord.com/api/v10"
+)
+
+// Endpoints are where the clients in this package connect, empty fields keep
+// the real discord endpoints
+type Endpoints struct {
+	Gateway string `json:"gateway"`
+	Api     string `json:"api"`
+}
+
+func (e Endpoints) GatewayUrl() string {
+	if e.Gateway == "" {
+		return discordGatewayUrl
+	}
+	return e.Gateway
+}
+
+func (e Endpoints) ApiUrl() string {
+	if e.Api == "" {
+		return discordApiUrl
+	}
+	return strings.TrimSuffix(e.Api, "/")
+}
+
+type User struct {
+	Id         string `json:"id"`
+	Username   string `json:"username"`
+	GlobalName string `json:"global_name"`
+	Bot        bool   `json:"bot"`
+}
+
+type Member struct {
+	// only set on interactions, messages carry the author separately
+	User  *User    `json:"user,omitempty"`
+	Nick  string   `json:"nick"`
+	Roles []string `json:"roles"`
+}
+
+type Message struct {
+	Id        string  `json:"id"`
+	ChannelId string  `json:"channel_id"`
+	GuildId   string  `json:"guild_id"`
+	Author    User    `json:"author"`
+	Member    *Member `json:"member"`
+	Content   string  `json:"content"`
+}
+
+const (
+	InteractionApplicationCommand = 2
+
+	// what an interaction can be answered with
+	ResponseChannelMessage = 4
+
+	// only the user who ran the command sees the answer
+	FlagEphemeral = 1 << 6
+)
+
+type CommandOption struct {
+	Name  string `json:"name"`
+	Type  int    `json:"type"`
+	Value any    `json:"value,omitempty"`
+	// only when registering
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+type Interaction struct {
+	Id            string  `json:"id"`
+	ApplicationId string  `json:"application_id"`
+	Type          int     `json:"type"`
+	Token         string  `json:"token"`
+	ChannelId     string  `json:"channel_id"`
+	GuildId       string  `json:"guild_id"`
+	Member        *Member `json:"member"`
+	Data          struct {
+		Name    string          `json:"name"`
+		Options []CommandOption `json:"options"`
+	} `json:"data"`
+}
+
+// Option returns the string value of the named option
+func (i Interaction) Option(name string) string {
+	for _, o := range i.Data.Options {
+		if o.Name == name {
+			return fmt.Sprint(o.Value)
+		}
+	}
+	return ""
+}
+
+type ApplicationCommand struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Type        int             `json:"type,omitempty"`
+	Options     []CommandOption `json:"options,omitempty"`
+}
+
+type MessageReference struct {
+	MessageId string `json:"message_id"`
+}
+
+type CreateMessageRequest struct {
+	Content          string            `json:"content"`
+	MessageReference *MessageReference `json:"message_reference,omitempty"`
+	Flags            int               `json:"flags,omitempty"`
+	// nobody gets pinged by what viewers make the bot say
+	AllowedMentions struct {
+		Parse []string `json:"parse"`
+	} `json:"allowed_mentions"`
+}
+
+type ApiError struct {
+	StatusCode int
+	Message    string `json:"message"`
+	Code       int    `json:"code"`
+	Method     string
+	Path       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("discord %s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// Rest calls the discord http api as a bot
+type Rest struct {
+	BaseUrl    string
+	Token      string
+	HTTP       *http.Client
+	MaxRetries int
+}
+
+func NewRest(token string) *Rest {
+	return &Rest{BaseUrl: discordApiUrl, Token: token, HTTP: http.DefaultClient, MaxRetries: 3}
+}
+
+func (r *Rest) do(ctx context.Context, method string, path string, body any, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, r.BaseUrl+path, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bot "+r.Token)
+		req.Header.Set("User-Agent", "DiscordBot (minecraftgo, 1)")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		res, err := r.HTTP.Do(req)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode == http.StatusTooManyRequests && attempt < r.MaxRetries {
+			var limit struct {
+				RetryAfter float64 `json:"retry_after"`
+			}
+			json.Unmarshal(data, &limit)
+			select {
+			case <-time.After(time.Duration(limit.RetryAfter*1000) * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		if res.StatusCode >= 300 {
+			apiErr := &ApiError{StatusCode: res.StatusCode, Method: method, Path: path}
+			json.Unmarshal(data, apiErr)
+			return apiErr
+		}
+		if out != nil && len(data) > 0 {
+			return json.Unmarshal(data, out)
+		}
+		return nil
+	}
+}
+
+func (r *Rest) CreateMessage(ctx context.Context, channelId string, req CreateMessageRequest) (*Message, error) {
+	req.AllowedMentions.Parse = []string{}
+	var msg Message
+	if err := r.do(ctx, http.MethodPost, "/channels/"+url.PathEscape(channelId)+"/messages", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// Respond answers an interaction, which has to happen within 3 seconds
+func (r *Rest) Respond(ctx context.Context, interaction *Interaction, req CreateMessageRequest) error {
+	req.AllowedMentions.Parse = []string{}
+	body := map[string]any{"type": ResponseChannelMessage, "data": req}
+	return r.do(ctx, http.MethodPost, "/interactions/"+url.PathEscape(interaction.Id)+"/"+url.PathEscape(interaction.Token)+"/callback", body, nil)
+}
+
+// FollowUp adds a message to an interaction that was already answered, for
+// the next 15 minutes
+func (r *Rest) FollowUp(ctx context.Context, interaction *Interaction, req CreateMessageRequest) error {
+	req.AllowedMentions.Parse = []string{}
+	return r.do(ctx, http.MethodPost, "/webhooks/"+url.PathEscape(interaction.ApplicationId)+"/"+url.PathEscape(interaction.Token), req, nil)
+}
+
+// RegisterCommands replaces the application's slash commands, in one guild
+// when guildId is set since those show up right away
+func (r *Rest) RegisterCommands(ctx context.Context, applicationId string, guildId string, commands []ApplicationCommand) error {
+	path := "/applications/" + url.PathEscape(applicationId) + "/commands"
+	if guildId != "" {
+		path = "/applications/" + url.PathEscape(applicationId) + "/guilds/" + url.PathEscape(guildId) + "/commands"
+	}
+	return r.do(ctx, http.MethodPut, path, commands, nil)
+}
@@ -0,0 +1,101 @@
+package discordmock
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Step is one thing the injector does. Scripts are one step per line as json,
+// blank lines and lines starting with # are skipped:
+//
+//	{"wait": "5s"}
+//	{"message": {"user": "viewer1", "text": "!skeleton"}}
+//	{"command": {"user": "viewer2", "roles": ["200"], "text": "poll"}}
+//	{"reconnect": true}
+//	{"disconnect": true}
+type Step struct {
+	Wait       string  `json:"wait,omitempty"`
+	Message    *Author `json:"message,omitempty"`
+	Command    *Author `json:"command,omitempty"`
+	Reconnect  bool    `json:"reconnect,omitempty"`
+	Disconnect bool    `json:"disconnect,omitempty"`
+}
+
+// Author is who says what where, the channel defaults to Server.ChannelId
+type Author struct {
+	Channel string   `json:"channel,omitempty"`
+	User    string   `json:"user"`
+	Roles   []string `json:"roles,omitempty"`
+	Text    string   `json:"text"`
+}
+
+func (s *Server) Do(ctx context.Context, step Step) error {
+	switch {
+	case step.Wait != "":
+		d, err := time.ParseDuration(step.Wait)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	case step.Message != nil:
+		if s.Message(step.Message.Channel, step.Message.User, step.Message.Roles, step.Message.Text) == 0 {
+			return fmt.Errorf("nobody is connected to the gateway")
+		}
+	case step.Command != nil:
+		if s.Command(step.Command.Channel, step.Command.User, step.Command.Roles, step.Command.Text) == 0 {
+			return fmt.Errorf("nobody is connected to the gateway")
+		}
+	case step.Reconnect:
+		s.Reconnect()
+	case step.Disconnect:
+		s.Disconnect()
+	default:
+		return fmt.Errorf("step does nothing")
+	}
+	return nil
+}
+
+// RunScript runs the steps in r one after another, steps that fail are
+// reported and skipped
+func (s *Server) RunScript(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var step Step
+		if err := json.Unmarshal([]byte(text), &step); err != nil {
+			return fmt.Errorf("script line %d: %w", line, err)
+		}
+		if err := s.Do(ctx, step); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fmt.Println("discordmock script line", line, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// handleInject runs steps posted to /mock/inject, one per line like a script
+func (s *Server) handleInject(res http.ResponseWriter, req *http.Request) {
+	if err := s.RunScript(req.Context(), req.Body); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
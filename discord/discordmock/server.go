@@ -0,0 +1,524 @@
+// Package discordmock is a stand-in for the parts of discord the bot talks to:
+// the gateway websocket and the REST endpoints for answering. Messages and
+// slash commands are injected from Go, over HTTP or from a script.
+package discordmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"minecraftgo/discord"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/google/uuid"
+)
+
+const (
+	opDispatch       = 0
+	opHeartbeat      = 1
+	opIdentify       = 2
+	opResume         = 6
+	opReconnect      = 7
+	opInvalidSession = 9
+	opHello          = 10
+	opHeartbeatAck   = 11
+
+	// how many dispatches a session keeps for resuming
+	historySize = 1000
+)
+
+type payload struct {
+	Op int             `json:"op"`
+	D  json.RawMessage `json:"d"`
+	S  int64           `json:"s,omitempty"`
+	T  string          `json:"t,omitempty"`
+}
+
+type connection struct {
+	send   chan []byte
+	closed chan websocket.StatusCode
+}
+
+// session outlives its connection so it can be resumed
+type session struct {
+	id      string
+	seq     int64
+	history []payload
+	conn    *connection
+}
+
+// SentMessage is something the bot said, in a channel or to an interaction
+type SentMessage struct {
+	ChannelId     string
+	InteractionId string
+	ReplyTo       string
+	Content       string
+	Ephemeral     bool
+}
+
+type Server struct {
+	// the bot token the gateway and REST api accept, empty accepts any
+	Token             string
+	HeartbeatInterval time.Duration
+	// where injected messages and commands come from by default
+	GuildId   string
+	ChannelId string
+
+	mu           sync.Mutex
+	sessions     map[string]*session
+	interactions map[string]string
+	messages     []SentMessage
+	commands     []discord.ApplicationCommand
+	identifies   int
+	resumes      int
+	// heartbeats go unanswered, like on a zombie connection
+	skipAcks bool
+
+	mux *http.ServeMux
+}
+
+func NewServer() *Server {
+	s := &Server{
+		HeartbeatInterval: 10 * time.Second,
+		GuildId:           "100",
+		ChannelId:         "1000",
+		sessions:          map[string]*session{},
+		interactions:      map[string]string{},
+		mux:               http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /gateway", s.handleGateway)
+	s.mux.HandleFunc("POST /api/channels/{channel}/messages", s.rest(s.handleCreateMessage))
+	s.mux.HandleFunc("POST /api/interactions/{id}/{token}/callback", s.handleCallback)
+	s.mux.HandleFunc("POST /api/webhooks/{app}/{token}", s.handleFollowUp)
+	s.mux.HandleFunc("PUT /api/applications/{app}/commands", s.rest(s.handleCommands))
+	s.mux.HandleFunc("PUT /api/applications/{app}/guilds/{guild}/commands", s.rest(s.handleCommands))
+	s.mux.HandleFunc("POST /mock/inject", s.handleInject)
+	return s
+}
+
+func (s *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	fmt.Println("discordmock", req.Method, req.URL.Path)
+	s.mux.ServeHTTP(res, req)
+}
+
+// Endpoints points the discord clients at this server running on baseUrl,
+// e.g. http://localhost:8081
+func Endpoints(baseUrl string) discord.Endpoints {
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+	return discord.Endpoints{
+		Gateway: "ws" + strings.TrimPrefix(baseUrl, "http") + "/gateway",
+		Api:     baseUrl + "/api",
+	}
+}
+
+// Messages returns what the bot said so far
+func (s *Server) Messages() []SentMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SentMessage{}, s.messages...)
+}
+
+// Commands returns the slash commands the bot registered last
+func (s *Server) Commands() []discord.ApplicationCommand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]discord.ApplicationCommand{}, s.commands...)
+}
+
+func userId(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(name)))
+	return strconv.FormatUint(uint64(h.Sum32()%100000000), 10)
+}
+
+func encode(p payload) []byte {
+	data, _ := json.Marshal(p)
+	return data
+}
+
+// gateway
+
+func (s *Server) handleGateway(res http.ResponseWriter, req *http.Request) {
+	conn, err := websocket.Accept(res, req, nil)
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	hello, _ := json.Marshal(map[string]int{"heartbeat_interval": int(s.HeartbeatInterval.Milliseconds())})
+	if err := conn.Write(ctx, websocket.MessageText, encode(payload{Op: opHello, D: hello})); err != nil {
+		return
+	}
+
+	var first payload
+	if _, data, err := conn.Read(ctx); err != nil || json.Unmarshal(data, &first) != nil {
+		return
+	}
+	var start struct {
+		Token     string `json:"token"`
+		SessionId string `json:"session_id"`
+		Seq       int64  `json:"seq"`
+	}
+	json.Unmarshal(first.D, &start)
+	if s.Token != "" && start.Token != s.Token {
+		conn.Close(4004, "Authentication failed.")
+		return
+	}
+
+	// room for a resume to replay the whole history
+	c := &connection{send: make(chan []byte, historySize+64), closed: make(chan websocket.StatusCode, 1)}
+	var sess *session
+	switch first.Op {
+	case opIdentify:
+		sess = s.identify(c, "ws://"+req.Host+"/gateway")
+	case opResume:
+		if sess = s.resume(c, start.SessionId, start.Seq); sess == nil {
+			conn.Write(ctx, websocket.MessageText, encode(payload{Op: opInvalidSession, D: json.RawMessage("false")}))
+			conn.Close(4009, "Session timed out.")
+			return
+		}
+	default:
+		conn.Close(4003, "Not authenticated.")
+		return
+	}
+	defer s.detach(sess, c)
+
+	go func() {
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				cancel()
+				return
+			}
+			var p payload
+			if json.Unmarshal(data, &p) == nil && p.Op == opHeartbeat && !s.skippingAcks() {
+				select {
+				case c.send <- encode(payload{Op: opHeartbeatAck}):
+				default:
+				}
+			}
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-c.send:
+			if err := conn.Write(ctx, websocket.MessageText, msg); err != nil {
+				return
+			}
+		case code := <-c.closed:
+			conn.Close(code, "")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Server) identify(c *connection, resumeUrl string) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identifies++
+	sess := &session{id: uuid.NewString(), conn: c}
+	s.sessions[sess.id] = sess
+	ready, _ := json.Marshal(map[string]any{
+		"v":                  10,
+		"session_id":         sess.id,
+		"resume_gateway_url": resumeUrl,
+		"user":               discord.User{Id: userId("minecraftgo"), Username: "minecraftgo", Bot: true},
+	})
+	s.dispatchLocked(sess, "READY", ready)
+	return sess
+}
+
+// resume sends what the session missed since seq, nil when there is no such session
+func (s *Server) resume(c *connection, sessionId string, seq int64) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[sessionId]
+	if !ok {
+		return nil
+	}
+	s.resumes++
+	if sess.conn != nil {
+		select {
+		case sess.conn.closed <- 4000:
+		default:
+		}
+	}
+	sess.conn = c
+	for _, p := range sess.history {
+		if p.S > seq {
+			c.send <- encode(p)
+		}
+	}
+	s.dispatchLocked(sess, "RESUMED", json.RawMessage("{}"))
+	return sess
+}
+
+func (s *Server) detach(sess *session, c *connection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sess.conn == c {
+		sess.conn = nil
+	}
+}
+
+func (s *Server) dispatchLocked(sess *session, eventType string, data json.RawMessage) {
+	sess.seq++
+	p := payload{Op: opDispatch, D: data, S: sess.seq, T: eventType}
+	sess.history = append(sess.history, p)
+	if len(sess.history) > historySize {
+		sess.history = sess.history[1:]
+	}
+	if sess.conn != nil {
+		select {
+		case sess.conn.send <- encode(p):
+		default:
+			fmt.Println("discordmock dropping", eventType, "for a slow session")
+		}
+	}
+}
+
+// Identifies and Resumes count how sessions were started and picked up again
+func (s *Server) Identifies() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.identifies
+}
+
+func (s *Server) Resumes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.resumes
+}
+
+// AckHeartbeats turns answering heartbeats on and off, off makes every
+// connection look like a zombie to the bot
+func (s *Server) AckHeartbeats(on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.skipAcks = !on
+}
+
+func (s *Server) skippingAcks() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.skipAcks
+}
+
+// Connected returns how many sessions have a live connection
+func (s *Server) Connected() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connected := 0
+	for _, sess := range s.sessions {
+		if sess.conn != nil {
+			connected++
+		}
+	}
+	return connected
+}
+
+// Dispatch sends an event to every session, returns how many are connected
+func (s *Server) Dispatch(eventType string, event any) int {
+	data, _ := json.Marshal(event)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connected := 0
+	for _, sess := range s.sessions {
+		if sess.conn != nil {
+			connected++
+		}
+		s.dispatchLocked(sess, eventType, data)
+	}
+	return connected
+}
+
+func member(roles []string) discord.Member {
+	if roles == nil {
+		roles = []string{}
+	}
+	return discord.Member{Roles: roles}
+}
+
+// Message has user write text in channelId, the default channel when empty
+func (s *Server) Message(channelId string, user string, roles []string, text string) int {
+	if channelId == "" {
+		channelId = s.ChannelId
+	}
+	member := member(roles)
+	return s.Dispatch(discord.MessageCreateType, discord.Message{
+		Id:        uuid.NewString(),
+		ChannelId: channelId,
+		GuildId:   s.GuildId,
+		Author:    discord.User{Id: userId(user), Username: user},
+		Member:    &member,
+		Content:   text,
+	})
+}
+
+// Command has user run the /mc slash command with text
+func (s *Server) Command(channelId string, user string, roles []string, text string) int {
+	if channelId == "" {
+		channelId = s.ChannelId
+	}
+	member := member(roles)
+	member.User = &discord.User{Id: userId(user), Username: user}
+	interaction := discord.Interaction{
+		Id:            uuid.NewString(),
+		ApplicationId: "mockapp",
+		Type:          discord.InteractionApplicationCommand,
+		Token:         uuid.NewString(),
+		ChannelId:     channelId,
+		GuildId:       s.GuildId,
+		Member:        &member,
+	}
+	interaction.Data.Name = discord.CommandName
+	interaction.Data.Options = []discord.CommandOption{{Name: "command", Type: 3, Value: text}}
+
+	s.mu.Lock()
+	s.interactions[interaction.Token] = interaction.Id
+	s.mu.Unlock()
+	return s.Dispatch(discord.InteractionCreateType, interaction)
+}
+
+// Reconnect asks every connection to reconnect and resume
+func (s *Server) Reconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sess := range s.sessions {
+		if sess.conn != nil {
+			select {
+			case sess.conn.send <- encode(payload{Op: opReconnect, D: json.RawMessage("null")}):
+			default:
+			}
+		}
+	}
+}
+
+// Disconnect drops every connection without warning, sessions stay resumable
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sess := range s.sessions {
+		if sess.conn != nil {
+			select {
+			case sess.conn.closed <- 4000:
+			default:
+			}
+		}
+	}
+}
+
+// rest
+
+func writeJSON(res http.ResponseWriter, status int, body any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(body)
+}
+
+func writeError(res http.ResponseWriter, status int, message string) {
+	writeJSON(res, status, map[string]any{"message": message, "code": 0})
+}
+
+// rest checks the bot token for endpoints that need one
+func (s *Server) rest(next http.HandlerFunc) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bot ")
+		if !ok || (s.Token != "" && token != s.Token) {
+			writeError(res, http.StatusUnauthorized, "401: Unauthorized")
+			return
+		}
+		next(res, req)
+	}
+}
+
+func (s *Server) handleCreateMessage(res http.ResponseWriter, req *http.Request) {
+	var body discord.CreateMessageRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Content == "" {
+		writeError(res, http.StatusBadRequest, "Cannot send an empty message")
+		return
+	}
+	if len(body.Content) > 2000 {
+		writeError(res, http.StatusBadRequest, "Invalid Form Body")
+		return
+	}
+	msg := SentMessage{ChannelId: req.PathValue("channel"), Content: body.Content}
+	if body.MessageReference != nil {
+		msg.ReplyTo = body.MessageReference.MessageId
+	}
+	s.mu.Lock()
+	s.messages = append(s.messages, msg)
+	s.mu.Unlock()
+	fmt.Println("discordmock bot says:", body.Content)
+	writeJSON(res, http.StatusOK, discord.Message{Id: uuid.NewString(), ChannelId: msg.ChannelId, Content: body.Content})
+}
+
+// handleCallback answers interactions, each can be answered once
+func (s *Server) handleCallback(res http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	id, ok := s.interactions[req.PathValue("token")]
+	s.mu.Unlock()
+	if !ok || id != req.PathValue("id") {
+		writeError(res, http.StatusNotFound, "Unknown interaction")
+		return
+	}
+
+	var body struct {
+		Type int                          `json:"type"`
+		Data discord.CreateMessageRequest `json:"data"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Type != discord.ResponseChannelMessage {
+		writeError(res, http.StatusBadRequest, "Invalid Form Body")
+		return
+	}
+	s.mu.Lock()
+	s.messages = append(s.messages, SentMessage{InteractionId: id, Content: body.Data.Content, Ephemeral: body.Data.Flags&discord.FlagEphemeral != 0})
+	s.mu.Unlock()
+	fmt.Println("discordmock bot answers:", body.Data.Content)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleFollowUp(res http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	id, ok := s.interactions[req.PathValue("token")]
+	s.mu.Unlock()
+	if !ok {
+		writeError(res, http.StatusNotFound, "Unknown Webhook")
+		return
+	}
+
+	var body discord.CreateMessageRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Content == "" {
+		writeError(res, http.StatusBadRequest, "Cannot send an empty message")
+		return
+	}
+	s.mu.Lock()
+	s.messages = append(s.messages, SentMessage{InteractionId: id, Content: body.Content})
+	s.mu.Unlock()
+	fmt.Println("discordmock bot follows up:", body.Content)
+	writeJSON(res, http.StatusOK, discord.Message{Id: uuid.NewString(), Content: body.Content})
+}
+
+func (s *Server) handleCommands(res http.ResponseWriter, req *http.Request) {
+	var commands []discord.ApplicationCommand
+	if err := json.NewDecoder(req.Body).Decode(&commands); err != nil {
+		writeError(res, http.StatusBadRequest, "Invalid Form Body")
+		return
+	}
+	s.mu.Lock()
+	s.commands = commands
+	s.mu.Unlock()
+	writeJSON(res, http.StatusOK, commands)
+}
@@ -0,0 +1,281 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+const (
+	opDispatch       = 0
+	opHeartbeat      = 1
+	opIdentify       = 2
+	opResume         = 6
+	opReconnect      = 7
+	opInvalidSession = 9
+	opHello          = 10
+	opHeartbeatAck   = 11
+)
+
+const (
+	IntentGuilds         = 1 << 0
+	IntentGuildMessages  = 1 << 9
+	IntentMessageContent = 1 << 15
+)
+
+const (
+	MessageCreateType     = "MESSAGE_CREATE"
+	InteractionCreateType = "INTERACTION_CREATE"
+	readyType             = "READY"
+	resumedType           = "RESUMED"
+)
+
+// close codes after which connecting again won't help, like a bad token
+var fatalCloseCodes = map[websocket.StatusCode]string{
+	4004: "authentication failed",
+	4010: "invalid shard",
+	4011: "sharding required",
+	4012: "invalid api version",
+	4013: "invalid intents",
+	4014: "disallowed intents, is the message content intent enabled for the bot?",
+}
+
+var errReconnect = errors.New("discord: gateway asked to reconnect")
+
+type gatewayPayload struct {
+	Op int             `json:"op"`
+	D  json.RawMessage `json:"d"`
+	S  int64           `json:"s,omitempty"`
+	T  string          `json:"t,omitempty"`
+}
+
+// Dispatch is an event the gateway sent, Data decodes into a Message for
+// MESSAGE_CREATE and an Interaction for INTERACTION_CREATE
+type Dispatch struct {
+	Type string
+	Seq  int64
+	Data json.RawMessage
+}
+
+// Gateway keeps a gateway connection open. It heartbeats, resumes the session
+// after a drop so no events are missed and identifies anew when it can't.
+type Gateway struct {
+	Url     string
+	Token   string
+	Intents int
+
+	events    chan Dispatch
+	sessionId string
+	resumeUrl string
+	seq       atomic.Int64
+}
+
+func NewGateway(token string, intents int) *Gateway {
+	return &Gateway{
+		Url:     discordGatewayUrl,
+		Token:   token,
+		Intents: intents,
+		events:  make(chan Dispatch, 16),
+	}
+}
+
+func (g *Gateway) Events() <-chan Dispatch {
+	return g.events
+}
+
+// Run blocks until ctx is done or discord turns us away for good, the events
+// channel is closed when it returns
+func (g *Gateway) Run(ctx context.Context) error {
+	defer close(g.events)
+
+	backoff := time.Second
+	for {
+		started := time.Now()
+		err := g.connect(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if reason, ok := fatalCloseCodes[websocket.CloseStatus(err)]; ok {
+			return fmt.Errorf("discord gateway: %s", reason)
+		}
+		if errors.Is(err, errReconnect) {
+			continue
+		}
+
+		if time.Since(started) > time.Minute {
+			backoff = time.Second
+		}
+		fmt.Println("Discord gateway connection lost, retrying in", backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff = min(backoff*2, time.Minute)
+	}
+}
+
+func gatewayQuery(u string) string {
+	if strings.Contains(u, "?") {
+		return u
+	}
+	return u + "?v=10&encoding=json"
+}
+
+func (g *Gateway) send(ctx context.Context, conn *websocket.Conn, op int, d any) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	msg, _ := json.Marshal(gatewayPayload{Op: op, D: data})
+	return conn.Write(ctx, websocket.MessageText, msg)
+}
+
+func (g *Gateway) read(ctx context.Context, conn *websocket.Conn) (gatewayPayload, error) {
+	var p gatewayPayload
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		return p, err
+	}
+	err = json.Unmarshal(data, &p)
+	return p, err
+}
+
+func (g *Gateway) heartbeat(ctx context.Context, conn *websocket.Conn) error {
+	seq := g.seq.Load()
+	if seq == 0 {
+		return g.send(ctx, conn, opHeartbeat, nil)
+	}
+	return g.send(ctx, conn, opHeartbeat, seq)
+}
+
+// connect runs one connection until it drops
+func (g *Gateway) connect(ctx context.Context) error {
+	u := g.Url
+	resuming := g.sessionId != "" && g.resumeUrl != ""
+	if resuming {
+		u = g.resumeUrl
+	}
+	conn, _, err := websocket.Dial(ctx, gatewayQuery(u), nil)
+	if err != nil {
+		return err
+	}
+	conn.SetReadLimit(1 << 20)
+	defer conn.CloseNow()
+
+	hello, err := g.read(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if hello.Op != opHello {
+		return fmt.Errorf("discord gateway: expected hello, got op %d", hello.Op)
+	}
+	var h struct {
+		HeartbeatInterval int `json:"heartbeat_interval"`
+	}
+	json.Unmarshal(hello.D, &h)
+	interval := time.Duration(h.HeartbeatInterval) * time.Millisecond
+	if interval <= 0 {
+		return errors.New("discord gateway: hello without heartbeat_interval")
+	}
+
+	if resuming {
+		err = g.send(ctx, conn, opResume, map[string]any{"token": g.Token, "session_id": g.sessionId, "seq": g.seq.Load()})
+	} else {
+		err = g.send(ctx, conn, opIdentify, map[string]any{
+			"token":      g.Token,
+			"intents":    g.Intents,
+			"properties": map[string]string{"os": "linux", "browser": "minecraftgo", "device": "minecraftgo"},
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var acked atomic.Bool
+	acked.Store(true)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-connCtx.Done():
+				return
+			}
+			if !acked.Swap(false) {
+				// a zombie connection, anything but 1000 keeps the session resumable
+				conn.Close(4000, "heartbeat not acknowledged")
+				return
+			}
+			if g.heartbeat(connCtx, conn) != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		p, err := g.read(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		switch p.Op {
+		case opHeartbeat:
+			if err := g.heartbeat(ctx, conn); err != nil {
+				return err
+			}
+		case opHeartbeatAck:
+			acked.Store(true)
+		case opReconnect:
+			conn.Close(4000, "reconnecting")
+			return errReconnect
+		case opInvalidSession:
+			var resumable bool
+			json.Unmarshal(p.D, &resumable)
+			if !resumable {
+				g.sessionId, g.resumeUrl = "", ""
+				g.seq.Store(0)
+			}
+			fmt.Println("Discord session invalidated, resumable:", resumable)
+			// discord asks for a short random wait before identifying again
+			select {
+			case <-time.After(time.Second + time.Duration(time.Now().UnixNano()%4)*time.Second):
+			case <-ctx.Done():
+			}
+			return errReconnect
+		case opDispatch:
+			if p.S > 0 {
+				g.seq.Store(p.S)
+			}
+			if p.T == readyType {
+				var ready struct {
+					SessionId        string `json:"session_id"`
+					ResumeGatewayUrl string `json:"resume_gateway_url"`
+					User             User   `json:"user"`
+				}
+				json.Unmarshal(p.D, &ready)
+				g.sessionId, g.resumeUrl = ready.SessionId, ready.ResumeGatewayUrl
+				fmt.Println("Discord gateway ready as", ready.User.Username)
+				continue
+			}
+			if p.T == resumedType {
+				fmt.Println("Discord gateway resumed")
+				continue
+			}
+			select {
+			case g.events <- Dispatch{Type: p.T, Seq: p.S, Data: p.D}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package discord_test
+
+import (
+	"context"
+	"encoding/json"
+	"minecraftgo/discord"
+	"minecraftgo/discord/discordmock"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// startGateway connects a gateway to a fresh mock that wants heartbeats every 50ms
+func startGateway(t *testing.T) (*discordmock.Server, *discord.Gateway) {
+	t.Helper()
+	mock := discordmock.NewServer()
+	mock.HeartbeatInterval = 50 * time.Millisecond
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+
+	gateway := discord.NewGateway("token", discord.IntentGuildMessages)
+	gateway.Url = discordmock.Endpoints(srv.URL).Gateway
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go gateway.Run(ctx)
+	waitFor(t, "connection", func() bool { return mock.Connected() == 1 })
+	return mock, gateway
+}
+
+func waitFor(t *testing.T, what string, ok func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !ok() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// nextMessage skips to the next MESSAGE_CREATE
+func nextMessage(t *testing.T, gateway *discord.Gateway) discord.Message {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case dispatch := <-gateway.Events():
+			if dispatch.Type != discord.MessageCreateType {
+				continue
+			}
+			var msg discord.Message
+			if err := json.Unmarshal(dispatch.Data, &msg); err != nil {
+				t.Fatal(err)
+			}
+			return msg
+		case <-timeout:
+			t.Fatal("no message")
+			return discord.Message{}
+		}
+	}
+}
+
+func TestGatewayIdentify(t *testing.T) {
+	mock, gateway := startGateway(t)
+	mock.Message("", "viewer1", nil, "!skeleton")
+
+	if msg := nextMessage(t, gateway); msg.Content != "!skeleton" || msg.Author.Username != "viewer1" {
+		t.Errorf("got %q from %s", msg.Content, msg.Author.Username)
+	}
+	if mock.Identifies() != 1 || mock.Resumes() != 0 {
+		t.Errorf("%d identifies and %d resumes, want 1 and 0", mock.Identifies(), mock.Resumes())
+	}
+}
+
+func TestGatewayResumesAfterDrop(t *testing.T) {
+	mock, gateway := startGateway(t)
+	mock.Message("", "viewer1", nil, "!one")
+	nextMessage(t, gateway)
+
+	mock.Disconnect()
+	waitFor(t, "the drop", func() bool { return mock.Connected() == 0 })
+	// sent while nobody listens, the resume has to replay it
+	mock.Message("", "viewer1", nil, "!two")
+
+	if msg := nextMessage(t, gateway); msg.Content != "!two" {
+		t.Errorf("got %q, want the missed message", msg.Content)
+	}
+	if mock.Identifies() != 1 || mock.Resumes() != 1 {
+		t.Errorf("%d identifies and %d resumes, want 1 and 1", mock.Identifies(), mock.Resumes())
+	}
+}
+
+func TestGatewayDropsZombieConnection(t *testing.T) {
+	mock, gateway := startGateway(t)
+	mock.AckHeartbeats(false)
+	waitFor(t, "a resume", func() bool { return mock.Resumes() > 0 })
+	mock.AckHeartbeats(true)
+
+	waitFor(t, "the connection", func() bool { return mock.Connected() == 1 })
+	mock.Message("", "viewer1", nil, "!alive")
+	if msg := nextMessage(t, gateway); msg.Content != "!alive" {
+		t.Errorf("got %q", msg.Content)
+	}
+	if mock.Identifies() != 1 {
+		t.Errorf("%d identifies, the session should have been resumed", mock.Identifies())
+	}
+}
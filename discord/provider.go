@@ -0,0 +1,184 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"minecraftgo/input"
+	"strings"
+)
+
+// CommandName is the slash command viewers use, e.g. /mc skeleton
+const CommandName = "mc"
+
+// Provider turns messages starting with Prefix in ChannelId and /mc slash
+// commands into chat events, the same ones twitch chat makes
+type Provider struct {
+	Gateway       *Gateway
+	Rest          *Rest
+	ApplicationId string
+	GuildId       string
+	ChannelId     string
+	Prefix        string
+	// role id to the badge members with it get, e.g. moderator
+	Roles map[string]string
+	// when set, members without this role can't do anything
+	RequireRole string
+}
+
+func NewProvider(gateway *Gateway, rest *Rest) *Provider {
+	return &Provider{Gateway: gateway, Rest: rest, Prefix: "!", Roles: map[string]string{}}
+}
+
+func (p *Provider) Name() string {
+	return "discord"
+}
+
+func (p *Provider) Run(ctx context.Context, events chan<- input.Event) error {
+	if p.ApplicationId != "" {
+		err := p.Rest.RegisterCommands(ctx, p.ApplicationId, p.GuildId, []ApplicationCommand{{
+			Name:        CommandName,
+			Description: "Do something to the streamer's minecraft world",
+			Type:        1,
+			Options:     []CommandOption{{Name: "command", Description: "what to do, like skeleton or rain", Type: 3, Required: true}},
+		}})
+		if err != nil {
+			fmt.Println("!! Could not register discord slash commands", err)
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Gateway.Run(ctx)
+	}()
+
+	for dispatch := range p.Gateway.Events() {
+		ev, ok := p.toInput(ctx, dispatch)
+		if !ok {
+			continue
+		}
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+		}
+	}
+	return <-done
+}
+
+func (p *Provider) toInput(ctx context.Context, dispatch Dispatch) (input.Event, bool) {
+	switch dispatch.Type {
+	case MessageCreateType:
+		var msg Message
+		if err := json.Unmarshal(dispatch.Data, &msg); err != nil {
+			fmt.Println("!! Could not decode discord message", err)
+			return input.Event{}, false
+		}
+		if msg.Author.Bot || msg.ChannelId != p.ChannelId || msg.Member == nil {
+			return input.Event{}, false
+		}
+		text, ok := strings.CutPrefix(msg.Content, p.Prefix)
+		if !ok || !p.allowed(msg.Member) {
+			return input.Event{}, false
+		}
+		return input.Event{
+			Kind:      input.Chat,
+			Viewer:    p.viewer(msg.Author, msg.Member),
+			What:      oneLine(text),
+			MessageId: msg.Id,
+			Raw:       &msg,
+		}, true
+	case InteractionCreateType:
+		var interaction Interaction
+		if err := json.Unmarshal(dispatch.Data, &interaction); err != nil {
+			fmt.Println("!! Could not decode discord interaction", err)
+			return input.Event{}, false
+		}
+		if interaction.Type != InteractionApplicationCommand || interaction.Data.Name != CommandName || interaction.Member == nil || interaction.Member.User == nil {
+			return input.Event{}, false
+		}
+
+		// every interaction needs an answer within 3 seconds, what the
+		// command did comes after as a follow up
+		answer, ok := "", false
+		switch {
+		case interaction.ChannelId != p.ChannelId:
+			answer = "Commands only work in <#" + p.ChannelId + ">"
+		case !p.allowed(interaction.Member):
+			answer = "You need the <@&" + p.RequireRole + "> role for that"
+		default:
+			answer, ok = interaction.Member.User.Username+" used /"+CommandName+" "+interaction.Option("command"), true
+		}
+		req := CreateMessageRequest{Content: answer}
+		if !ok {
+			req.Flags = FlagEphemeral
+		}
+		if err := p.Rest.Respond(ctx, &interaction, req); err != nil {
+			fmt.Println("!! Could not answer discord interaction", err)
+		}
+		if !ok {
+			return input.Event{}, false
+		}
+		return input.Event{
+			Kind:   input.Chat,
+			Viewer: p.viewer(*interaction.Member.User, interaction.Member),
+			What:   oneLine(interaction.Option("command")),
+			Raw:    &interaction,
+		}, true
+	}
+	return input.Event{}, false
+}
+
+// oneLine squashes all whitespace, line breaks included, into single spaces.
+// A message goes to the server console as is and every line there is a
+// command of its own.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (p *Provider) allowed(member *Member) bool {
+	if p.RequireRole == "" {
+		return true
+	}
+	for _, role := range member.Roles {
+		if role == p.RequireRole {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Provider) viewer(user User, member *Member) input.Viewer {
+	v := input.Viewer{Id: user.Id, Login: user.Username, Name: user.Username}
+	if user.GlobalName != "" {
+		v.Name = user.GlobalName
+	}
+	if member.Nick != "" {
+		v.Name = member.Nick
+	}
+	for _, role := range member.Roles {
+		if badge, ok := p.Roles[role]; ok {
+			v.Badges = append(v.Badges, badge)
+		}
+	}
+	return v
+}
+
+// Reply answers under the message, or as a follow up to the slash command. It
+// doesn't wait for discord so a rate limit can't hold up the game.
+func (p *Provider) Reply(ev input.Event, text string) {
+	go func() {
+		ctx := context.Background()
+		var err error
+		switch raw := ev.Raw.(type) {
+		case *Interaction:
+			err = p.Rest.FollowUp(ctx, raw, CreateMessageRequest{Content: text})
+		case *Message:
+			_, err = p.Rest.CreateMessage(ctx, raw.ChannelId, CreateMessageRequest{Content: text, MessageReference: &MessageReference{MessageId: raw.Id}})
+		default:
+			_, err = p.Rest.CreateMessage(ctx, p.ChannelId, CreateMessageRequest{Content: text})
+		}
+		if err != nil {
+			fmt.Println("!! Could not reply on discord", err)
+		}
+	}()
+}
@@ -0,0 +1,85 @@
+package discord_test
+
+import (
+	"context"
+	"minecraftgo/discord"
+	"minecraftgo/discord/discordmock"
+	"minecraftgo/input"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	streamerRole = "501"
+	modRole      = "502"
+)
+
+func startProvider(t *testing.T) (*discordmock.Server, <-chan input.Event) {
+	t.Helper()
+	mock := discordmock.NewServer()
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+	endpoints := discordmock.Endpoints(srv.URL)
+
+	gateway := discord.NewGateway("token", discord.IntentGuildMessages)
+	gateway.Url = endpoints.Gateway
+	rest := discord.NewRest("token")
+	rest.BaseUrl = endpoints.Api
+	provider := discord.NewProvider(gateway, rest)
+	provider.ChannelId = mock.ChannelId
+	provider.RequireRole = streamerRole
+	provider.Roles = map[string]string{modRole: input.BadgeModerator}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	events := make(chan input.Event, 16)
+	go provider.Run(ctx, events)
+	waitFor(t, "connection", func() bool { return mock.Connected() == 1 })
+	return mock, events
+}
+
+func expectEvent(t *testing.T, events <-chan input.Event) input.Event {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+		return input.Event{}
+	}
+}
+
+func TestProviderRoleGating(t *testing.T) {
+	mock, events := startProvider(t)
+
+	mock.Message("", "lurker", nil, "!kill")
+	mock.Message("", "mod", []string{streamerRole, modRole}, "!poll")
+	ev := expectEvent(t, events)
+	if ev.Viewer.Name != "mod" || ev.What != "poll" {
+		t.Fatalf("got %q from %s, the member without the role got through", ev.What, ev.Viewer.Name)
+	}
+	if !ev.Viewer.Privileged() {
+		t.Error("the moderator role didn't give the moderator badge")
+	}
+
+	mock.Command("", "lurker", nil, "kill")
+	waitFor(t, "the answer", func() bool { return len(mock.Messages()) == 1 })
+	if answer := mock.Messages()[0]; !answer.Ephemeral {
+		t.Errorf("answer to a member without the role should be ephemeral, got %+v", answer)
+	}
+	select {
+	case ev := <-events:
+		t.Errorf("got %q from %s without the role", ev.What, ev.Viewer.Name)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestProviderFlattensLines(t *testing.T) {
+	mock, events := startProvider(t)
+
+	mock.Message("", "viewer", []string{streamerRole}, "!x\nop viewer\r\nstop")
+	if ev := expectEvent(t, events); ev.What != "x op viewer stop" {
+		t.Errorf("got %q, line breaks must not survive", ev.What)
+	}
+}
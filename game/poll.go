@@ -0,0 +1,218 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"minecraftgo/actions"
+	"minecraftgo/commands"
+	"minecraftgo/twitch"
+	"minecraftgo/wrapper"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const pollBossbar = "minecraftgo:poll"
+
+// twitch limits on polls
+const (
+	minPollChoices     = 2
+	maxPollChoices     = 5
+	maxPollChoiceTitle = 25
+	minPollDuration    = 15 * time.Second
+	maxPollDuration    = 30 * time.Minute
+)
+
+// how long after a poll should have ended we stop waiting for its end event
+const pollEndGrace = 30 * time.Second
+
+var ErrPollRunning = errors.New("a poll is already running")
+
+// Polls lets chat vote on what happens next. Start puts a few random options
+// up as a twitch poll, Handle follows the poll events and runs the winner.
+type Polls struct {
+	Helix         *twitch.Helix
+	BroadcasterId string
+	Scheduler     *actions.Scheduler
+	Wrapper       *wrapper.Wrapper
+	Player        string
+	// what chat can vote for, keyed by the choice title, the channel point
+	// rewards when the config has no poll options
+	Options  actions.RewardMap
+	Choices  int
+	Duration time.Duration
+	Title    string
+
+	mu     sync.Mutex
+	active *twitch.Poll
+	// set while the poll is being created, without holding mu
+	starting bool
+	// clears active in case the end event never comes
+	expiry *time.Timer
+}
+
+func NewPolls(helix *twitch.Helix, broadcasterId string, scheduler *actions.Scheduler, wpr *wrapper.Wrapper, player_name string, options actions.RewardMap) *Polls {
+	return &Polls{
+		Helix:         helix,
+		BroadcasterId: broadcasterId,
+		Scheduler:     scheduler,
+		Wrapper:       wpr,
+		Player:        player_name,
+		Options:       options,
+		Choices:       3,
+		Duration:      time.Minute,
+		Title:         "What happens next?",
+	}
+}
+
+// pick chooses n random option titles that fit in a poll choice
+func (p *Polls) pick(n int) []string {
+	var titles []string
+	for title := range p.Options {
+		if len(title) <= maxPollChoiceTitle {
+			titles = append(titles, title)
+		}
+	}
+	sort.Strings(titles)
+	rand.Shuffle(len(titles), func(i, j int) { titles[i], titles[j] = titles[j], titles[i] })
+	if len(titles) > n {
+		titles = titles[:n]
+	}
+	return titles
+}
+
+func (p *Polls) Start(ctx context.Context) error {
+	p.mu.Lock()
+	if p.active != nil || p.starting {
+		p.mu.Unlock()
+		return ErrPollRunning
+	}
+	choices := min(max(p.Choices, minPollChoices), maxPollChoices)
+	titles := p.pick(choices)
+	if len(titles) < minPollChoices {
+		p.mu.Unlock()
+		return fmt.Errorf("need at least %d poll options, have %d", minPollChoices, len(titles))
+	}
+	// poll events are handled while twitch answers
+	p.starting = true
+	p.mu.Unlock()
+	duration := min(max(p.Duration, minPollDuration), maxPollDuration)
+
+	req := twitch.CreatePollRequest{
+		BroadcasterId: p.BroadcasterId,
+		Title:         p.Title,
+		Duration:      int(duration.Seconds()),
+	}
+	for _, title := range titles {
+		req.AddChoice(title)
+	}
+	poll, err := p.Helix.CreatePoll(ctx, req)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.starting = false
+	if err != nil {
+		return err
+	}
+	p.active = poll
+	p.expiry = time.AfterFunc(duration+pollEndGrace, func() { p.expire(poll.Id) })
+	fmt.Println("Poll started", poll.Id, strings.Join(titles, ", "))
+
+	commands.ShowBossbar(p.Wrapper, p.Player, pollBossbar, p.Title, commands.BossbarPurple)
+	commands.SetBossbarProgress(p.Wrapper, pollBossbar, progressTitle(p.Title, poll.Choices), 0, 1)
+	return nil
+}
+
+// Handle reacts to poll events for the poll we started, reports whether the
+// event was one
+func (p *Polls) Handle(decoded any) bool {
+	ev, ok := decoded.(*twitch.PollEvent)
+	if !ok {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active == nil || p.active.Id != ev.Id {
+		return true
+	}
+
+	leader, total := leading(ev.Choices)
+	if ev.Status == "" {
+		// begin or progress
+		commands.SetBossbarProgress(p.Wrapper, pollBossbar, progressTitle(ev.Title, ev.Choices), leader.Votes, total)
+		commands.ActionBar(p.Wrapper, p.Player, fmt.Sprintf("Chat is voting: %s leads with %d", leader.Title, leader.Votes))
+		return true
+	}
+
+	p.clear()
+	if ev.Status != "completed" && ev.Status != "terminated" {
+		fmt.Println("Poll", ev.Id, ev.Status, "no winner")
+		return true
+	}
+	if total == 0 {
+		commands.ActionBar(p.Wrapper, p.Player, "Nobody voted, you got lucky")
+		return true
+	}
+
+	reward, ok := p.Options.Lookup("", leader.Title)
+	if !ok {
+		fmt.Println("!! Poll winner", leader.Title, "has no action")
+		return true
+	}
+	commands.ActionBar(p.Wrapper, p.Player, "Chat chose: "+leader.Title)
+	if err := p.Scheduler.Run(reward.Spec, reward.Duration); err != nil {
+		fmt.Println("!! Poll winner", leader.Title, "failed", err)
+	}
+	return true
+}
+
+// clear forgets the active poll, p.mu has to be held
+func (p *Polls) clear() {
+	p.active = nil
+	if p.expiry != nil {
+		p.expiry.Stop()
+		p.expiry = nil
+	}
+	commands.RemoveBossbar(p.Wrapper, pollBossbar)
+}
+
+// expire gives up on poll id when its end event got lost, so new polls can start
+func (p *Polls) expire(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active == nil || p.active.Id != id {
+		return
+	}
+	fmt.Println("!! Poll", id, "never reported its end, no winner")
+	p.clear()
+}
+
+// leading returns the choice with the most votes, ties go to a random one
+func leading(choices []twitch.PollChoice) (twitch.PollChoice, int) {
+	var best []twitch.PollChoice
+	total := 0
+	for _, c := range choices {
+		total += c.Votes
+		if len(best) == 0 || c.Votes > best[0].Votes {
+			best = []twitch.PollChoice{c}
+		} else if c.Votes == best[0].Votes {
+			best = append(best, c)
+		}
+	}
+	if len(best) == 0 {
+		return twitch.PollChoice{}, 0
+	}
+	return best[rand.Intn(len(best))], total
+}
+
+func progressTitle(title string, choices []twitch.PollChoice) string {
+	parts := make([]string, len(choices))
+	for idx, c := range choices {
+		parts[idx] = fmt.Sprintf("%s %d", c.Title, c.Votes)
+	}
+	return title + "  " + strings.Join(parts, " | ")
+}
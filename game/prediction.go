@@ -0,0 +1,210 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"minecraftgo/commands"
+	"minecraftgo/twitch"
+	"minecraftgo/wrapper"
+	"strings"
+	"sync"
+	"time"
+)
+
+// what a prediction can watch for
+const (
+	ConditionDeath       = "death"
+	ConditionAdvancement = "advancement"
+	ConditionDimension   = "dimension"
+)
+
+// twitch limits on the prediction window
+const (
+	minPredictionWindow = 30 * time.Second
+	maxPredictionWindow = 30 * time.Minute
+)
+
+// dimension changes don't show up in the log so the player is asked every so often
+const dimensionPollInterval = 3 * time.Second
+
+var ErrPredictionRunning = errors.New("a prediction is already running")
+
+// PredictionSpec describes a yes/no prediction on something happening to the
+// player. Happens wins when the condition is met within Duration of the
+// prediction locking, DoesNot wins otherwise.
+type PredictionSpec struct {
+	Title    string
+	Happens  string
+	DoesNot  string
+	Window   time.Duration
+	Duration time.Duration
+	// ConditionDeath, ConditionAdvancement or ConditionDimension
+	Condition string
+	// advancement title or dimension id to wait for, empty for any
+	Value string
+}
+
+func (s PredictionSpec) Validate() error {
+	switch s.Condition {
+	case ConditionDeath, ConditionAdvancement, ConditionDimension:
+	default:
+		return fmt.Errorf("unknown prediction condition %q", s.Condition)
+	}
+	if s.Title == "" || s.Happens == "" || s.DoesNot == "" {
+		return errors.New("prediction needs a title and both outcomes")
+	}
+	if s.Duration <= 0 {
+		return errors.New("prediction needs a duration")
+	}
+	return nil
+}
+
+// Predictions runs one prediction at a time and settles it from what the
+// server log says happened
+type Predictions struct {
+	Helix         *twitch.Helix
+	BroadcasterId string
+	Wrapper       *wrapper.Wrapper
+	Player        string
+
+	mu     sync.Mutex
+	active *twitch.Prediction
+}
+
+func NewPredictions(helix *twitch.Helix, broadcasterId string, wpr *wrapper.Wrapper, player_name string) *Predictions {
+	return &Predictions{Helix: helix, BroadcasterId: broadcasterId, Wrapper: wpr, Player: player_name}
+}
+
+func (p *Predictions) Start(ctx context.Context, spec PredictionSpec) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active != nil {
+		return ErrPredictionRunning
+	}
+
+	window := min(max(spec.Window, minPredictionWindow), maxPredictionWindow)
+	req := twitch.CreatePredictionRequest{
+		BroadcasterId:    p.BroadcasterId,
+		Title:            spec.Title,
+		PredictionWindow: int(window.Seconds()),
+	}
+	req.AddOutcome(spec.Happens)
+	req.AddOutcome(spec.DoesNot)
+
+	// subscribe before creating so nothing that happens in between is missed
+	lines, unsubscribe := p.Wrapper.SubscribeLog()
+	prediction, err := p.Helix.CreatePrediction(ctx, req)
+	if err != nil {
+		unsubscribe()
+		return err
+	}
+	if len(prediction.Outcomes) != 2 {
+		unsubscribe()
+		return fmt.Errorf("prediction %s came back with %d outcomes", prediction.Id, len(prediction.Outcomes))
+	}
+	p.active = prediction
+	fmt.Println("Prediction started", prediction.Id, spec.Title)
+
+	go p.watch(ctx, prediction, spec, time.Now().Add(window), lines, unsubscribe)
+	return nil
+}
+
+func (p *Predictions) watch(ctx context.Context, prediction *twitch.Prediction, spec PredictionSpec, locksAt time.Time, lines <-chan *wrapper.LogLine, unsubscribe func()) {
+	defer unsubscribe()
+	defer func() {
+		p.mu.Lock()
+		p.active = nil
+		p.mu.Unlock()
+	}()
+
+	deadline := time.NewTimer(time.Until(locksAt.Add(spec.Duration)))
+	defer deadline.Stop()
+	poll := time.NewTicker(dimensionPollInterval)
+	defer poll.Stop()
+
+	// empty until a read worked, a failed first read is no change of dimension
+	lastDimension := ""
+	if spec.Condition == ConditionDimension {
+		if dimension, err := commands.GetDimension(p.Wrapper, p.Player); err == nil {
+			lastDimension = dimension
+		}
+	}
+
+	for {
+		happened := false
+		select {
+		case ll := <-lines:
+			happened = p.matches(spec, wrapper.ParseGameEvent(ll))
+		case <-poll.C:
+			if !p.Wrapper.Online() {
+				p.end(prediction, twitch.PredictionCanceled, "", "server went offline")
+				return
+			}
+			if spec.Condition == ConditionDimension {
+				dimension, err := commands.GetDimension(p.Wrapper, p.Player)
+				if err != nil {
+					break
+				}
+				happened = lastDimension != "" && dimension != lastDimension &&
+					(spec.Value == "" || dimension == namespacedDimension(spec.Value))
+				lastDimension = dimension
+			}
+		case <-deadline.C:
+			p.end(prediction, twitch.PredictionResolved, prediction.Outcomes[1].Id, spec.DoesNot)
+			return
+		case <-ctx.Done():
+			p.end(prediction, twitch.PredictionCanceled, "", "shutting down")
+			return
+		}
+
+		if !happened {
+			continue
+		}
+		// chat could still vote knowing the answer, so that's no contest
+		if time.Now().Before(locksAt) {
+			p.end(prediction, twitch.PredictionCanceled, "", "it happened before voting closed")
+			return
+		}
+		p.end(prediction, twitch.PredictionResolved, prediction.Outcomes[0].Id, spec.Happens)
+		return
+	}
+}
+
+func (p *Predictions) matches(spec PredictionSpec, ev any) bool {
+	switch ev := ev.(type) {
+	case *wrapper.DeathEvent:
+		return spec.Condition == ConditionDeath && ev.Player == p.Player
+	case *wrapper.AdvancementEvent:
+		return spec.Condition == ConditionAdvancement && ev.Player == p.Player &&
+			(spec.Value == "" || strings.EqualFold(ev.Title, spec.Value))
+	}
+	return false
+}
+
+// end settles the prediction on twitch, it has its own context so it still
+// goes out while we shut down
+func (p *Predictions) end(prediction *twitch.Prediction, status string, winningOutcomeId string, why string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	fmt.Println("Prediction", prediction.Title, status, why)
+	if _, err := p.Helix.EndPrediction(ctx, prediction.BroadcasterId, prediction.Id, status, winningOutcomeId); err != nil {
+		fmt.Println("!! Could not end prediction", err)
+		return
+	}
+	if p.Wrapper.Online() {
+		commands.ActionBar(p.Wrapper, p.Player, fmt.Sprintf("Prediction %s: %s", strings.ToLower(status), why))
+	}
+}
+
+func namespacedDimension(dimension string) string {
+	if strings.Contains(dimension, ":") {
+		return dimension
+	}
+	return "minecraft:" + dimension
+}
@@ -0,0 +1,303 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AlertFields says where in an alert's JSON each value is, as dotted paths
+// like "data.amount" or "event.items.0.name". Id or Time has to be set, or a
+// captured alert could be sent again and again. Ids are only remembered for a
+// day and not across restarts, Time closes that gap too.
+type AlertFields struct {
+	// lets retries of the same alert be dropped, without it the same body is
+	Id string `json:"id"`
+	// when the alert was sent, unix seconds or RFC 3339, alerts older than
+	// Alerts.MaxAge are turned away
+	Time     string `json:"time"`
+	User     string `json:"user"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	Message  string `json:"message"`
+}
+
+// Rates are what one unit of a currency is worth in US dollars
+type Rates map[string]float64
+
+// DefaultRates are rough rates for common currencies, good enough to pick a
+// tier. Config can override and add to them.
+var DefaultRates = Rates{
+	"USD": 1,
+	"EUR": 1.08,
+	"GBP": 1.27,
+	"CAD": 0.73,
+	"AUD": 0.66,
+	"NZD": 0.60,
+	"CHF": 1.12,
+	"SEK": 0.095,
+	"NOK": 0.094,
+	"DKK": 0.145,
+	"PLN": 0.25,
+	"BRL": 0.18,
+	"MXN": 0.055,
+	"JPY": 0.0067,
+	"KRW": 0.00073,
+	"INR": 0.012,
+	"RUB": 0.011,
+}
+
+// Cents converts amount of currency into US cents, false when the currency
+// has no rate
+func (r Rates) Cents(amount float64, currency string) (int, bool) {
+	rate, ok := r[strings.ToUpper(strings.TrimSpace(currency))]
+	if !ok {
+		return 0, false
+	}
+	return int(math.Round(amount * rate * 100)), true
+}
+
+// Alerts receives tips from an alert service like Streamlabs or
+// StreamElements. Every request has to carry an HMAC-SHA256 of its body made
+// with Secret, in hex, in SignatureHeader. Tips come out as Tip events whose
+// Amount is in US cents, What is the message.
+type Alerts struct {
+	Source          string
+	Secret          []byte
+	SignatureHeader string
+	Fields          AlertFields
+	// used when the alert doesn't say
+	DefaultCurrency string
+	Rates           Rates
+	// how far Fields.Time may be from now
+	MaxAge time.Duration
+
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	events chan Event
+}
+
+func NewAlerts(source string, secret string) *Alerts {
+	return &Alerts{
+		Source:          source,
+		Secret:          []byte(secret),
+		SignatureHeader: "X-Signature",
+		DefaultCurrency: "USD",
+		Rates:           DefaultRates,
+		MaxAge:          5 * time.Minute,
+		seen:            map[string]time.Time{},
+		events:          make(chan Event),
+	}
+}
+
+func (a *Alerts) Name() string {
+	return a.Source
+}
+
+// Run hands on what ServeHTTP receives until ctx is done
+func (a *Alerts) Run(ctx context.Context, events chan<- Event) error {
+	for {
+		select {
+		case ev := <-a.events:
+			select {
+			case events <- ev:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (a *Alerts) signature(body []byte) string {
+	mac := hmac.New(sha256.New, a.Secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (a *Alerts) verify(header http.Header, body []byte) bool {
+	got := strings.TrimPrefix(strings.ToLower(header.Get(a.SignatureHeader)), "sha256=")
+	return hmac.Equal([]byte(a.signature(body)), []byte(got))
+}
+
+// seenBefore remembers ids for a day, alert services retry for far less and
+// older alerts are turned away when they carry a time
+func (a *Alerts) seenBefore(id string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now()
+	for seenId, at := range a.seen {
+		if now.Sub(at) > 24*time.Hour {
+			delete(a.seen, seenId)
+		}
+	}
+	if _, ok := a.seen[id]; ok {
+		return true
+	}
+	a.seen[id] = now
+	return false
+}
+
+func (a *Alerts) forget(id string) {
+	a.mu.Lock()
+	delete(a.seen, id)
+	a.mu.Unlock()
+}
+
+func (a *Alerts) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, 1<<20))
+	if err != nil {
+		http.Error(res, "bad body", http.StatusBadRequest)
+		return
+	}
+	if !a.verify(req.Header, body) {
+		fmt.Println("Alert from", a.Source, "with bad signature from", req.RemoteAddr)
+		http.Error(res, "bad signature", http.StatusForbidden)
+		return
+	}
+
+	ev, id, err := a.Parse(body)
+	if err != nil {
+		fmt.Println("!! Alert from", a.Source, "not understood", err)
+		http.Error(res, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if a.seenBefore(id) {
+		res.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// nobody reading means the game isn't running, the service can retry
+	ctx, cancel := context.WithTimeout(req.Context(), 2*time.Second)
+	defer cancel()
+	select {
+	case a.events <- ev:
+		res.WriteHeader(http.StatusNoContent)
+	case <-ctx.Done():
+		a.forget(id)
+		http.Error(res, "not ready", http.StatusServiceUnavailable)
+	}
+}
+
+// Parse turns an alert into a Tip event, along with the id retries of it are
+// dropped by. Without an id field that's the body's HMAC, the body carries
+// the time, so only a replay within MaxAge has the same one.
+func (a *Alerts) Parse(body []byte) (Event, string, error) {
+	if a.Fields.Id == "" && a.Fields.Time == "" {
+		return Event{}, "", errors.New("alert fields need an id or a time, otherwise alerts can be replayed")
+	}
+	var doc any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return Event{}, "", err
+	}
+
+	id, _ := lookup(doc, a.Fields.Id)
+	if a.Fields.Id != "" && id == "" {
+		return Event{}, "", fmt.Errorf("no id at %q", a.Fields.Id)
+	}
+	if a.Fields.Id == "" {
+		id = "body:" + a.signature(body)
+	}
+	if a.Fields.Time != "" {
+		rawTime, _ := lookup(doc, a.Fields.Time)
+		sent, err := parseAlertTime(rawTime)
+		if err != nil {
+			return Event{}, "", err
+		}
+		if age := time.Since(sent); age > a.MaxAge || age < -a.MaxAge {
+			return Event{}, "", fmt.Errorf("alert sent at %s is too old or too far ahead", sent.Format(time.RFC3339))
+		}
+	}
+
+	user, _ := lookup(doc, a.Fields.User)
+	if user == "" {
+		user = "Anonymous"
+	}
+	rawAmount, ok := lookup(doc, a.Fields.Amount)
+	if !ok {
+		return Event{}, "", fmt.Errorf("no amount at %q", a.Fields.Amount)
+	}
+	amount, err := strconv.ParseFloat(strings.TrimSpace(rawAmount), 64)
+	if err != nil || math.IsNaN(amount) || math.IsInf(amount, 0) || amount <= 0 {
+		return Event{}, "", fmt.Errorf("amount %q is not a positive number", rawAmount)
+	}
+	currency, _ := lookup(doc, a.Fields.Currency)
+	if currency == "" {
+		currency = a.DefaultCurrency
+	}
+	cents, ok := a.Rates.Cents(amount, currency)
+	if !ok {
+		return Event{}, "", fmt.Errorf("no rate for currency %q", currency)
+	}
+	message, _ := lookup(doc, a.Fields.Message)
+
+	return Event{
+		Kind:   Tip,
+		Viewer: Viewer{Login: strings.ToLower(user), Name: user},
+		What:   message,
+		Amount: cents,
+	}, id, nil
+}
+
+// parseAlertTime reads unix seconds, with or without a fraction, or RFC 3339
+func parseAlertTime(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if seconds, err := strconv.ParseFloat(raw, 64); err == nil {
+		return time.UnixMilli(int64(seconds * 1000)), nil
+	}
+	sent, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("alert time %q is neither unix seconds nor RFC 3339", raw)
+	}
+	return sent, nil
+}
+
+// lookup follows a dotted path through decoded JSON and returns what is there
+// as a string
+func lookup(doc any, path string) (string, bool) {
+	if path == "" {
+		return "", false
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := doc.(type) {
+		case map[string]any:
+			doc = node[key]
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return "", false
+			}
+			doc = node[idx]
+		default:
+			return "", false
+		}
+	}
+	switch value := doc.(type) {
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	case bool:
+		return strconv.FormatBool(value), true
+	}
+	return "", false
+}
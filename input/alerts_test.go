@@ -0,0 +1,136 @@
+package input_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"minecraftgo/input"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const alertSecret = "tip-secret"
+
+func newAlerts(t *testing.T, fields input.AlertFields) (*input.Alerts, chan input.Event) {
+	t.Helper()
+	alerts := input.NewAlerts("streamlabs", alertSecret)
+	alerts.Fields = fields
+	events := make(chan input.Event, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go alerts.Run(ctx, events)
+	return alerts, events
+}
+
+func postAlert(alerts *input.Alerts, secret string, body string) int {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	req := httptest.NewRequest(http.MethodPost, "/alerts/streamlabs", strings.NewReader(body))
+	req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	res := httptest.NewRecorder()
+	alerts.ServeHTTP(res, req)
+	return res.Code
+}
+
+func nextTip(t *testing.T, events chan input.Event) input.Event {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("no tip")
+		return input.Event{}
+	}
+}
+
+func noTip(t *testing.T, events chan input.Event) {
+	t.Helper()
+	select {
+	case ev := <-events:
+		t.Errorf("unexpected tip %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestAlertSignature(t *testing.T) {
+	alerts, events := newAlerts(t, input.AlertFields{Id: "id", Amount: "amount"})
+	if code := postAlert(alerts, "not the secret", `{"id": "1", "amount": 5}`); code != http.StatusForbidden {
+		t.Errorf("bad signature got %d", code)
+	}
+	noTip(t, events)
+
+	if code := postAlert(alerts, alertSecret, `{"id": "1", "amount": 5}`); code != http.StatusNoContent {
+		t.Errorf("good signature got %d", code)
+	}
+	if tip := nextTip(t, events); tip.Kind != input.Tip || tip.Amount != 500 || tip.Viewer.Name != "Anonymous" {
+		t.Errorf("got %+v", tip)
+	}
+}
+
+func TestAlertDedup(t *testing.T) {
+	alerts, events := newAlerts(t, input.AlertFields{Id: "data.id", Amount: "data.amount"})
+	body := `{"data": {"id": "abc", "amount": "3.50"}}`
+	for attempt := 0; attempt < 2; attempt++ {
+		if code := postAlert(alerts, alertSecret, body); code != http.StatusNoContent {
+			t.Errorf("attempt %d got %d", attempt, code)
+		}
+	}
+	nextTip(t, events)
+	noTip(t, events)
+}
+
+// with only a time the same signed body is dropped while it's fresh
+func TestAlertDedupWithoutId(t *testing.T) {
+	alerts, events := newAlerts(t, input.AlertFields{Time: "sent", User: "from", Amount: "amount"})
+	body := fmt.Sprintf(`{"sent": %d, "from": "Viewer1", "amount": 10}`, time.Now().Unix())
+	for attempt := 0; attempt < 2; attempt++ {
+		if code := postAlert(alerts, alertSecret, body); code != http.StatusNoContent {
+			t.Errorf("attempt %d got %d", attempt, code)
+		}
+	}
+	if tip := nextTip(t, events); tip.Viewer.Login != "viewer1" || tip.Amount != 1000 {
+		t.Errorf("got %+v", tip)
+	}
+	noTip(t, events)
+
+	stale := fmt.Sprintf(`{"sent": %d, "from": "Viewer1", "amount": 10}`, time.Now().Add(-time.Hour).Unix())
+	if code := postAlert(alerts, alertSecret, stale); code != http.StatusBadRequest {
+		t.Errorf("stale alert got %d", code)
+	}
+}
+
+func TestAlertAmounts(t *testing.T) {
+	alerts := input.NewAlerts("streamlabs", alertSecret)
+	alerts.Fields = input.AlertFields{Id: "id", Amount: "amount", Currency: "currency"}
+	alerts.Rates = input.Rates{"USD": 1, "EUR": 1.10, "JPY": 0.0067}
+
+	tests := []struct {
+		body  string
+		cents int
+		ok    bool
+	}{
+		{`{"id": "1", "amount": 5}`, 500, true},
+		{`{"id": "2", "amount": "10", "currency": "eur"}`, 1100, true},
+		{`{"id": "3", "amount": 1000, "currency": "JPY"}`, 670, true},
+		{`{"id": "4", "amount": 5, "currency": "XYZ"}`, 0, false},
+		{`{"id": "5", "amount": "NaN"}`, 0, false},
+		{`{"id": "6", "amount": "+Inf"}`, 0, false},
+		{`{"id": "7", "amount": -5}`, 0, false},
+		{`{"id": "8"}`, 0, false},
+	}
+	for _, test := range tests {
+		ev, _, err := alerts.Parse([]byte(test.body))
+		if (err == nil) != test.ok {
+			t.Errorf("%s: got error %v", test.body, err)
+			continue
+		}
+		if test.ok && ev.Amount != test.cents {
+			t.Errorf("%s: got %d cents, want %d", test.body, ev.Amount, test.cents)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package input
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Console reads viewer events typed by a tester, from stdin or from anyone
+// connecting to a unix socket or a TCP socket on localhost. Whoever connects
+// can act as the broadcaster, so TCP isn't served to other machines. A line
+// is chat from Viewer unless it starts with a header saying who and what:
+//
+//	skeleton
+//	viewer1: skeleton
+//	viewer1 +moderator +vip: poll
+//	viewer2 bits=500: take my bits
+//	viewer3 reward: Summon a creeper
+//	viewer4 sub tier=3000
+//	viewer5 resub=12: a year already
+//	viewer6 gift=5
+//
+// Lines starting with { are read as JSON events, like a Replay file.
+type Console struct {
+	// "" for stdin, otherwise "tcp" or "unix"
+	Network string
+	Address string
+	// who typed lines without a header are from
+	Viewer Viewer
+
+	stdout io.Writer
+	mu     sync.Mutex
+}
+
+// NewConsole takes "-" for stdin, "tcp://localhost:port" or "unix:///path/to/socket"
+func NewConsole(address string) (*Console, error) {
+	c := &Console{
+		Viewer: Viewer{Name: "console", Badges: []string{BadgeBroadcaster}},
+		stdout: os.Stdout,
+	}
+	switch {
+	case address == "-" || address == "":
+	case strings.HasPrefix(address, "tcp://"):
+		c.Network, c.Address = "tcp", strings.TrimPrefix(address, "tcp://")
+		if !loopback(c.Address) {
+			return nil, fmt.Errorf("console address %q has to be on localhost, like tcp://127.0.0.1:9000", address)
+		}
+	case strings.HasPrefix(address, "unix://"):
+		c.Network, c.Address = "unix", strings.TrimPrefix(address, "unix://")
+	default:
+		return nil, fmt.Errorf("console address %q is not -, tcp:// or unix://", address)
+	}
+	return c, nil
+}
+
+// loopback is true for host:port addresses only this machine can connect to,
+// an empty host listens everywhere
+func loopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (c *Console) Name() string {
+	return "console"
+}
+
+func (c *Console) Run(ctx context.Context, events chan<- Event) error {
+	if c.Network == "" {
+		// stdin can't be interrupted, the read just gets abandoned when ctx is done
+		return c.serve(ctx, os.Stdin, c.stdout, events)
+	}
+
+	if c.Network == "unix" {
+		// left over from a run that didn't clean up
+		os.Remove(c.Address)
+	}
+	listener, err := net.Listen(c.Network, c.Address)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	fmt.Println("Console listening on", c.Network, c.Address)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			go func() {
+				<-ctx.Done()
+				conn.Close()
+			}()
+			if err := c.serve(ctx, conn, conn, events); err != nil && ctx.Err() == nil && !errors.Is(err, net.ErrClosed) {
+				fmt.Println("Console connection from", conn.RemoteAddr(), "failed", err)
+			}
+		}()
+	}
+}
+
+// serve reads lines from r until it runs dry, replies to them go to w
+func (c *Console) serve(ctx context.Context, r io.Reader, w io.Writer, events chan<- Event) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ev, err := c.ParseLine(line)
+		if err != nil {
+			c.write(w, "!! "+err.Error())
+			continue
+		}
+		ev.Raw = w
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// ParseLine turns one line of console input into an event
+func (c *Console) ParseLine(line string) (Event, error) {
+	if strings.HasPrefix(line, "{") {
+		var ev Event
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			return Event{}, err
+		}
+		if ev.Kind == "" {
+			ev.Kind = Chat
+		}
+		if ev.Viewer.Name == "" {
+			ev.Viewer = c.Viewer
+		}
+		return ev, nil
+	}
+
+	header, text, hasHeader := strings.Cut(line, ":")
+	fields := strings.Fields(header)
+	// a header on its own, like "viewer4 sub", has no colon
+	if !hasHeader && len(fields) < 2 {
+		return Event{Kind: Chat, Viewer: c.Viewer, What: line}, nil
+	}
+	// anything that isn't all header, like "hello there: hi", is plain chat
+	if len(fields) == 0 || strings.Contains(fields[0], "=") || !allHeaderFields(fields[1:]) {
+		return Event{Kind: Chat, Viewer: c.Viewer, What: line}, nil
+	}
+
+	ev := Event{Kind: Chat, Viewer: Viewer{Name: fields[0], Login: strings.ToLower(fields[0])}, What: strings.TrimSpace(text)}
+	for _, field := range fields[1:] {
+		if badge, ok := strings.CutPrefix(field, "+"); ok {
+			ev.Viewer.Badges = append(ev.Viewer.Badges, badge)
+			continue
+		}
+
+		key, value, _ := strings.Cut(field, "=")
+		amount := 0
+		if value != "" && key != "tier" {
+			var err error
+			if amount, err = strconv.Atoi(value); err != nil || amount < 1 {
+				return Event{}, fmt.Errorf("%s wants a positive number, not %q", key, value)
+			}
+		}
+		switch key {
+		case "bits":
+			ev.Kind, ev.Amount = Cheer, amount
+		case "reward":
+			ev.Kind = Redemption
+		case "sub":
+			ev.Kind, ev.Amount = Subscribe, 1
+		case "resub":
+			ev.Kind, ev.Amount = Resub, max(amount, 1)
+		case "gift":
+			ev.Kind, ev.Amount = GiftSub, max(amount, 1)
+		case "tier":
+			ev.Tier = value
+		}
+	}
+
+	if ev.Kind == Cheer && ev.Amount == 0 {
+		return Event{}, errors.New("bits wants an amount, like bits=100")
+	}
+	if ev.Kind == Redemption && ev.What == "" {
+		return Event{}, errors.New("reward wants a title after the colon")
+	}
+	if (ev.Kind == Subscribe || ev.Kind == Resub || ev.Kind == GiftSub) && ev.Tier == "" {
+		ev.Tier = "1000"
+	}
+	return ev, nil
+}
+
+func allHeaderFields(fields []string) bool {
+	for _, field := range fields {
+		if !isHeaderField(field) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHeaderField(field string) bool {
+	if strings.HasPrefix(field, "+") && len(field) > 1 {
+		return true
+	}
+	key, _, _ := strings.Cut(field, "=")
+	switch key {
+	case "bits", "reward", "sub", "resub", "gift", "tier":
+		return true
+	}
+	return false
+}
+
+func (c *Console) write(w io.Writer, text string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintln(w, text)
+}
+
+// Reply writes back to whoever typed the line
+func (c *Console) Reply(ev Event, text string) {
+	w, ok := ev.Raw.(io.Writer)
+	if !ok {
+		w = c.stdout
+	}
+	c.write(w, "> "+text)
+}
+
+// Complete says what happened to a redemption, there are no points to refund
+func (c *Console) Complete(ev Event, ok bool) {
+	if !ok {
+		c.Reply(ev, "redemption of "+ev.What+" would have been refunded")
+	}
+}
@@ -0,0 +1,60 @@
+package input_test
+
+import (
+	"minecraftgo/input"
+	"reflect"
+	"testing"
+)
+
+// the examples in Console's doc comment
+func TestConsoleParseLine(t *testing.T) {
+	console, err := input.NewConsole("-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		line string
+		want input.Event
+	}{
+		{"skeleton", input.Event{Kind: input.Chat, Viewer: console.Viewer, What: "skeleton"}},
+		{"viewer1: skeleton", input.Event{Kind: input.Chat, Viewer: input.Viewer{Login: "viewer1", Name: "viewer1"}, What: "skeleton"}},
+		{"viewer1 +moderator +vip: poll", input.Event{Kind: input.Chat,
+			Viewer: input.Viewer{Login: "viewer1", Name: "viewer1", Badges: []string{input.BadgeModerator, input.BadgeVip}}, What: "poll"}},
+		{"viewer2 bits=500: take my bits", input.Event{Kind: input.Cheer, Viewer: input.Viewer{Login: "viewer2", Name: "viewer2"}, What: "take my bits", Amount: 500}},
+		{"viewer3 reward: Summon a creeper", input.Event{Kind: input.Redemption, Viewer: input.Viewer{Login: "viewer3", Name: "viewer3"}, What: "Summon a creeper"}},
+		{"viewer4 sub tier=3000", input.Event{Kind: input.Subscribe, Viewer: input.Viewer{Login: "viewer4", Name: "viewer4"}, Amount: 1, Tier: "3000"}},
+		{"viewer5 resub=12: a year already", input.Event{Kind: input.Resub, Viewer: input.Viewer{Login: "viewer5", Name: "viewer5"}, What: "a year already", Amount: 12, Tier: "1000"}},
+		{"viewer6 gift=5", input.Event{Kind: input.GiftSub, Viewer: input.Viewer{Login: "viewer6", Name: "viewer6"}, Amount: 5, Tier: "1000"}},
+		// not all header, so it's chat as typed
+		{"hello there: hi", input.Event{Kind: input.Chat, Viewer: console.Viewer, What: "hello there: hi"}},
+	}
+	for _, test := range tests {
+		ev, err := console.ParseLine(test.line)
+		if err != nil {
+			t.Errorf("%q: %v", test.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(ev, test.want) {
+			t.Errorf("%q: got %+v, want %+v", test.line, ev, test.want)
+		}
+	}
+
+	for _, line := range []string{"viewer2 bits: no amount", "viewer3 reward", "viewer6 gift=none"} {
+		if _, err := console.ParseLine(line); err == nil {
+			t.Errorf("%q: expected an error", line)
+		}
+	}
+}
+
+func TestConsoleOnlyOnLocalhost(t *testing.T) {
+	for _, address := range []string{"-", "tcp://127.0.0.1:9000", "tcp://localhost:9000", "tcp://[::1]:9000", "unix:///tmp/console.sock"} {
+		if _, err := input.NewConsole(address); err != nil {
+			t.Errorf("%s: %v", address, err)
+		}
+	}
+	for _, address := range []string{"tcp://:9000", "tcp://0.0.0.0:9000", "tcp://192.168.1.20:9000", "tcp://example.com:9000"} {
+		if _, err := input.NewConsole(address); err == nil {
+			t.Errorf("%s: expected an error", address)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Package input turns whatever viewers do on any platform into one kind of
+// event the game loop understands.
+package input
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type Kind string
+
+const (
+	// What is the message text
+	Chat Kind = "chat"
+	// What is the reward title, RewardId the platform's id for it
+	Redemption Kind = "redemption"
+	// Amount is the bits or other currency
+	Cheer Kind = "cheer"
+	// Tier is the sub tier
+	Subscribe Kind = "subscribe"
+	// Amount is the months subscribed in total
+	Resub Kind = "resub"
+	// Amount is how many subs were gifted
+	GiftSub Kind = "gift_sub"
+	// a donation through an alert service or a Super Chat, Amount is in US cents
+	Tip Kind = "tip"
+	// anything else, Raw has the platform's own event
+	Other Kind = "other"
+)
+
+// Badges viewers can carry, platforms map their own roles onto these
+const (
+	BadgeBroadcaster = "broadcaster"
+	BadgeModerator   = "moderator"
+	BadgeSubscriber  = "subscriber"
+	BadgeVip         = "vip"
+)
+
+type Viewer struct {
+	Id     string   `json:"id,omitempty"`
+	Login  string   `json:"login,omitempty"`
+	Name   string   `json:"name"`
+	Badges []string `json:"badges,omitempty"`
+}
+
+func (v Viewer) HasBadge(badge string) bool {
+	for _, b := range v.Badges {
+		if b == badge {
+			return true
+		}
+	}
+	return false
+}
+
+// Privileged viewers may run things like polls and predictions
+func (v Viewer) Privileged() bool {
+	return v.HasBadge(BadgeBroadcaster) || v.HasBadge(BadgeModerator)
+}
+
+// Event is something a viewer did: who, what, where from and how much
+type Event struct {
+	Source string `json:"source"`
+	Kind   Kind   `json:"kind"`
+	Viewer Viewer `json:"viewer"`
+	What   string `json:"what,omitempty"`
+	Amount int    `json:"amount,omitempty"`
+	// twitch's "1000", "2000" and "3000", other platforms map onto those
+	Tier string `json:"tier,omitempty"`
+	// lets replies thread under the message, when the platform has threads
+	MessageId string `json:"message_id,omitempty"`
+	RewardId  string `json:"reward_id,omitempty"`
+
+	// the platform's own event and the provider it came from
+	Raw      any      `json:"-"`
+	Provider Provider `json:"-"`
+}
+
+// Provider is a source of viewer events: a streaming platform, a console, a
+// replay file
+type Provider interface {
+	// Name ends up in Event.Source
+	Name() string
+	// Run sends events until ctx is done or the source runs dry
+	Run(ctx context.Context, events chan<- Event) error
+}
+
+// Replier is a provider that can answer viewers
+type Replier interface {
+	Reply(ev Event, text string)
+}
+
+// Completer is a provider that wants to know whether an event was acted on,
+// e.g. to refund channel points when it wasn't
+type Completer interface {
+	Complete(ev Event, ok bool)
+}
+
+// Reply answers ev where it came from, if its provider can
+func Reply(ev Event, text string) {
+	if r, ok := ev.Provider.(Replier); ok && text != "" {
+		r.Reply(ev, text)
+	}
+}
+
+func Complete(ev Event, ok bool) {
+	if c, isCompleter := ev.Provider.(Completer); isCompleter {
+		c.Complete(ev, ok)
+	}
+}
+
+// Run starts every provider and merges what they send. The channel closes
+// once all of them have stopped.
+func Run(ctx context.Context, providers ...Provider) <-chan Event {
+	out := make(chan Event)
+	var wg sync.WaitGroup
+	for _, p := range providers {
+		wg.Add(1)
+		go func(p Provider) {
+			defer wg.Done()
+
+			events := make(chan Event)
+			done := make(chan error, 1)
+			go func() {
+				done <- p.Run(ctx, events)
+				close(events)
+			}()
+			for ev := range events {
+				ev.Source = p.Name()
+				ev.Provider = p
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+				}
+			}
+			if err := <-done; err != nil && ctx.Err() == nil {
+				fmt.Println("Input", p.Name(), "stopped", err)
+			}
+		}(p)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
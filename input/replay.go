@@ -0,0 +1,85 @@
+package input
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Replay plays events from a file, one JSON event per line. A line like
+// {"wait": "2s"} pauses in between, empty lines and lines starting with # are
+// skipped.
+//
+//	{"kind": "chat", "viewer": {"name": "viewer1"}, "what": "skeleton"}
+//	{"wait": "5s"}
+//	{"kind": "cheer", "viewer": {"name": "viewer2"}, "amount": 500}
+type Replay struct {
+	Path string
+}
+
+func NewReplay(path string) *Replay {
+	return &Replay{Path: path}
+}
+
+func (r *Replay) Name() string {
+	return "replay"
+}
+
+type replayLine struct {
+	Event
+	Wait string `json:"wait"`
+}
+
+func (r *Replay) Run(ctx context.Context, events chan<- Event) error {
+	f, err := os.Open(r.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var rl replayLine
+		if err := json.Unmarshal([]byte(line), &rl); err != nil {
+			return fmt.Errorf("%s:%d: %w", r.Path, lineNo, err)
+		}
+		if rl.Wait != "" {
+			wait, err := time.ParseDuration(rl.Wait)
+			if err != nil {
+				return fmt.Errorf("%s:%d: %w", r.Path, lineNo, err)
+			}
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		if rl.Kind == "" {
+			rl.Kind = Chat
+		}
+
+		select {
+		case events <- rl.Event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// Reply prints, there's nobody to answer
+func (r *Replay) Reply(ev Event, text string) {
+	fmt.Println("Reply to", ev.Viewer.Name, ":", text)
+}
@@ -1,41 +1,224 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
-	"github.com/google/uuid"
 	"io"
+	"minecraftgo/actions"
 	"minecraftgo/commands"
+	"minecraftgo/config"
+	"minecraftgo/discord"
+	"minecraftgo/game"
+	"minecraftgo/input"
 	"minecraftgo/secrets"
 	"minecraftgo/twitch"
 	"minecraftgo/wrapper"
+	"minecraftgo/youtube"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
+const (
+	redirectUri       = "http://localhost:3000/startGame"
+	botRedirectUri    = "http://localhost:3000/botLogin"
+	broadcasterScopes = "channel:bot user:read:chat user:write:chat user:bot channel:manage:redemptions channel:manage:polls channel:manage:predictions bits:read channel:read:subscriptions"
+	botScopes         = "user:read:chat user:write:chat user:bot"
+)
+
+var (
+	cfg       *config.Config
+	tokens    = twitch.NewTokenManager(twitch.NewTokenStore("token.dat", "token.key"))
+	botTokens = twitch.NewTokenManager(twitch.NewTokenStore("bot_token.dat", "token.key"))
+	helix     = twitch.NewHelix(tokens.AccessToken)
+	botHelix  = twitch.NewHelix(botTokens.AccessToken)
+	users     = twitch.NewUserCache(helix, 24*time.Hour)
+	appTokens = twitch.NewAppTokenManager()
+	appHelix  = twitch.NewHelix(appTokens.AccessToken)
+	// set when events come in over webhooks
+	webhook *twitch.WebhookHandler
+	// events played on top of twitch's, from -replay
+	replayPath string
+	// where -console reads from, see input.NewConsole
+	consoleAddress string
+	// no twitch at all, only the local providers
+	offline bool
+	// tip webhooks from config, served under /alerts/
+	alerts []*input.Alerts
+	// what chat messages do, from cfg.CommandsFile
+	chatCommands *actions.ChatCommands
+	// channel point rewards, support ladders and predictions, from cfg
+	rewards      actions.RewardMap
+	supportTiers actions.SupportTiers
+	predictions  map[string]game.PredictionSpec
+)
+
+// everything the game reacts to besides chat
+var channelEventTypes = []string{twitch.RedemptionAddType, twitch.CheerType,
+	twitch.SubscribeType, twitch.SubscriptionMessageType, twitch.SubscriptionGiftType,
+	twitch.PollBeginType, twitch.PollProgressType, twitch.PollEndType}
+
 func main() {
+	configPath := flag.String("config", "config.json", "path to the config file")
+	flag.StringVar(&replayPath, "replay", "", "file of viewer events to play once the game is running")
+	flag.StringVar(&consoleAddress, "console", "", "read viewer events from - (stdin), tcp://localhost:port or unix:///path")
+	flag.BoolVar(&offline, "offline", false, "start the game right away without twitch, for play-testing with -console or -replay")
+	flag.Parse()
+
+	var err error
+	cfg, err = config.Load(*configPath)
+	if err != nil {
+		panic(err)
+	}
+	chatCommands, err = actions.LoadChatCommands(cfg.CommandsFile)
+	if err != nil {
+		panic(err)
+	}
+	rewards, supportTiers, predictions = cfg.RewardMap(), cfg.SupportTiers(), cfg.PredictionSpecs()
+
+	tokens.AuthUrl = cfg.Twitch.OAuthUrl()
+	botTokens.AuthUrl = cfg.Twitch.OAuthUrl()
+	helix.BaseUrl = cfg.Twitch.HelixUrl()
+	botHelix.BaseUrl = cfg.Twitch.HelixUrl()
+	appTokens.AuthUrl = cfg.Twitch.OAuthUrl()
+	appHelix.BaseUrl = cfg.Twitch.HelixUrl()
+
+	tokens.Subscribe(func(token twitch.Token) {
+		fmt.Println("!! Token rotated for", token.Login, "valid until", token.ExpiresAt.Format(time.TimeOnly))
+	})
+	botTokens.Subscribe(func(token twitch.Token) {
+		fmt.Println("!! Bot token rotated for", token.Login, "valid until", token.ExpiresAt.Format(time.TimeOnly))
+	})
+	tokens.SubscribeFailure(func(err error) {
+		fmt.Println("!! Twitch login expired, open the start page and log in again:", err)
+	})
+	botTokens.SubscribeFailure(func(err error) {
+		fmt.Println("!! Bot login expired, open the start page and log in the bot again:", err)
+	})
+
+	rates := cfg.Rates()
+	for _, source := range cfg.Alerts {
+		alert := input.NewAlerts(source.Name, source.Secret)
+		if source.SignatureHeader != "" {
+			alert.SignatureHeader = source.SignatureHeader
+		}
+		if source.DefaultCurrency != "" {
+			alert.DefaultCurrency = strings.ToUpper(source.DefaultCurrency)
+		}
+		alert.Fields = source.Fields
+		alert.Rates = rates
+		http.Handle("/alerts/"+source.Name, alert)
+		alerts = append(alerts, alert)
+	}
+
+	if offline {
+		if len(alerts) > 0 {
+			// only the alert webhooks, there is no twitch to log in to
+			go func() {
+				if err := http.ListenAndServe(":3000", nil); err != nil {
+					fmt.Println("!! Alert server stopped", err)
+				}
+			}()
+		}
+		if consoleAddress == "" && replayPath == "" {
+			consoleAddress = "-"
+		}
+		setupWebsocket(setupMinecraftServer())
+		return
+	}
+
 	http.HandleFunc("/", getRoot)
 	http.HandleFunc("/startGame", startGame)
+	http.HandleFunc("/botLogin", botLogin)
+	if cfg.Webhook.Enabled() {
+		webhook = twitch.NewWebhookHandler(cfg.Webhook.Secret)
+		http.Handle("/eventsub", webhook)
+	}
 
-	err := http.ListenAndServe(":3000", nil)
+	err = http.ListenAndServe(":3000", nil)
 	if err != nil {
 		panic(err)
 	}
 }
 
+func authorizeUrl(redirect string, scopes string) string {
+	query := url.Values{
+		"client_id":     {secrets.ClientID},
+		"redirect_uri":  {redirect},
+		"response_type": {"code"},
+		"scope":         {scopes},
+		// lets the streamer pick a different account than the one they are logged in with
+		"force_verify": {"true"},
+	}
+	return cfg.Twitch.OAuthUrl() + "/authorize?" + query.Encode()
+}
+
 func getRoot(res http.ResponseWriter, req *http.Request) {
-	url := "https://id.twitch.tv/oauth2/authorize?client_id=" + secrets.ClientID + "&redirect_uri=http%3A%2F%2Flocalhost%3A3000%2FstartGame&response_type=code&scope=channel%3Abot%20user%3Aread%3Achat%20user%3Abot"
-	io.WriteString(res, "<html><body><a href=\""+url+"\">Click here to start game</a></body></html>")
+	authUrl := authorizeUrl(redirectUri, broadcasterScopes)
+	body := "<a href=\"" + authUrl + "\">Click here to start game</a> as " + cfg.BroadcasterLogin
+	if tokens.HasToken() {
+		body = "<a href=\"/startGame\">Start game as saved user</a> or <a href=\"" + authUrl + "\">log in again</a> as " + cfg.BroadcasterLogin
+	}
+	if cfg.HasBot() {
+		botUrl := authorizeUrl(botRedirectUri, botScopes)
+		if botTokens.HasToken() {
+			body += "<div>Chat bot " + cfg.BotLogin + " is logged in, <a href=\"" + botUrl + "\">log in again</a></div>"
+		} else {
+			body += "<div>Chat bot " + cfg.BotLogin + " needs to <a href=\"" + botUrl + "\">log in</a> first</div>"
+		}
+	}
+	io.WriteString(res, "<html><body>"+body+"</body></html>")
+}
+
+func botLogin(res http.ResponseWriter, req *http.Request) {
+	code := req.URL.Query().Get("code")
+	if err := botTokens.Exchange(code, botRedirectUri); err != nil {
+		fmt.Println("!! Bot login failed", err)
+		io.WriteString(res, "<html><body><div>Bot login failed, <a href=\"/\">try again</a></div></body></html>")
+		return
+	}
+
+	if token, _ := botTokens.Token(); token.Login != cfg.BotLogin {
+		fmt.Println("!! Bot logged in as", token.Login, "but config says", cfg.BotLogin)
+	}
+	http.Redirect(res, req, "/", http.StatusFound)
 }
 
 func startGame(res http.ResponseWriter, req *http.Request) {
 	fmt.Println("Starting game...")
 	params := req.URL.Query()
 
+	// without a code we carry on with the token saved by an earlier login
 	code := params.Get("code")
-	fmt.Println("Using Token", code)
+	if code != "" {
+		if err := tokens.Exchange(code, redirectUri); err != nil {
+			fmt.Println("!! Login failed", err)
+			io.WriteString(res, "<html><body><div>Login failed, <a href=\"/\">try again</a></div></body></html>")
+			return
+		}
+	} else if err := tokens.Load(); err != nil {
+		fmt.Println("!! No usable saved token", err)
+		http.Redirect(res, req, "/", http.StatusFound)
+		return
+	}
+
+	if token, _ := tokens.Token(); token.Login != cfg.BroadcasterLogin {
+		fmt.Println("!! Logged in as", token.Login, "but config says", cfg.BroadcasterLogin)
+	}
+	if _, ok := botTokens.Token(); cfg.HasBot() && !ok {
+		if err := botTokens.Load(); err != nil {
+			fmt.Println("!! No usable bot token", err)
+			http.Redirect(res, req, "/", http.StatusFound)
+			return
+		}
+	}
 
 	wpr := setupMinecraftServer()
-	go setupWebsocket(code, wpr)
+	go setupWebsocket(wpr)
 
 	io.WriteString(res, "<html><body><div>Server is starting</div></body></html>")
 }
@@ -49,83 +232,421 @@ func setupMinecraftServer() *wrapper.Wrapper {
 	return wpr
 }
 
-func setupWebsocket(code string, wpr *wrapper.Wrapper) {
-	conn := twitch.NewConnection()
-	defer conn.Cancel()
+// chatAccount is the account that reads and writes chat, the bot when there is one
+func chatAccount() (*twitch.Helix, string) {
+	if cfg.HasBot() {
+		return botHelix, cfg.BotLogin
+	}
+	return helix, cfg.BroadcasterLogin
+}
+
+// connectEventSub subscribes to everything the game reacts to. When a bot reads
+// chat it gets its own session, subscriptions on one session share a user.
+func connectEventSub(ctx context.Context, broadcasterId string, chatterId string) (<-chan twitch.EventSubEvent, error) {
+	if webhook != nil {
+		return subscribeWebhooks(ctx, broadcasterId, chatterId)
+	}
+	chatHelix, _ := chatAccount()
+	chatSubs := &twitch.Reconciler{Helix: chatHelix, Desired: chatSubscriptions(broadcasterId, chatterId)}
+	channelSubs := &twitch.Reconciler{Helix: helix, Desired: channelSubscriptions(broadcasterId)}
+	websocketTransport := func(sessionId string) twitch.Transport {
+		return twitch.Transport{Method: "websocket", SessionId: sessionId}
+	}
+
+	if !cfg.HasBot() {
+		subs := &twitch.Reconciler{Helix: helix, Desired: append(chatSubs.Desired, channelSubs.Desired...)}
+		client := twitch.NewEventSubClient(func(ctx context.Context, sessionId string) error {
+			return subs.Reconcile(ctx, websocketTransport(sessionId))
+		})
+		client.Url = cfg.Twitch.EventSubUrl()
+		go client.Run(ctx)
+		return client.Events(), nil
+	}
+
+	chatClient := twitch.NewEventSubClient(func(ctx context.Context, sessionId string) error {
+		return chatSubs.Reconcile(ctx, websocketTransport(sessionId))
+	})
+	channelClient := twitch.NewEventSubClient(func(ctx context.Context, sessionId string) error {
+		return channelSubs.Reconcile(ctx, websocketTransport(sessionId))
+	})
+	chatClient.Url = cfg.Twitch.EventSubUrl()
+	channelClient.Url = cfg.Twitch.EventSubUrl()
+	go chatClient.Run(ctx)
+	go channelClient.Run(ctx)
+
+	events := make(chan twitch.EventSubEvent)
+	go func() {
+		defer close(events)
+		chat, channel := chatClient.Events(), channelClient.Events()
+		for chat != nil || channel != nil {
+			var ev twitch.EventSubEvent
+			var ok bool
+			select {
+			case ev, ok = <-chat:
+				if !ok {
+					chat = nil
+					continue
+				}
+			case ev, ok = <-channel:
+				if !ok {
+					channel = nil
+					continue
+				}
+			}
+			events <- ev
+		}
+	}()
+	return events, nil
+}
+
+func chatSubscriptions(broadcasterId string, chatterId string) []twitch.DesiredSubscription {
+	condition := twitch.Condition{BroadcasterId: broadcasterId, UserId: chatterId}
+	return []twitch.DesiredSubscription{{Type: twitch.ChatMessageType, Condition: condition}}
+}
+
+func channelSubscriptions(broadcasterId string) []twitch.DesiredSubscription {
+	var desired []twitch.DesiredSubscription
+	for _, eventType := range channelEventTypes {
+		desired = append(desired, twitch.DesiredSubscription{Type: eventType, Condition: twitch.Condition{BroadcasterId: broadcasterId}})
+	}
+	return desired
+}
+
+// subscribeWebhooks points every subscription at our callback. Webhook
+// subscriptions outlive us, so ones left from an earlier run are kept unless
+// the secret changed since.
+func subscribeWebhooks(ctx context.Context, broadcasterId string, chatterId string) (<-chan twitch.EventSubEvent, error) {
+	if err := appTokens.Load(); err != nil {
+		return nil, fmt.Errorf("app token: %w", err)
+	}
+	go appTokens.Run(ctx)
+
+	subs := &twitch.Reconciler{
+		Helix:   appHelix,
+		Desired: append(chatSubscriptions(broadcasterId, chatterId), channelSubscriptions(broadcasterId)...),
+	}
+	// subscriptions made with another secret would be signed with it
+	secretFile := twitch.NewWebhookSecretFile("webhook_secret.dat", "token.key")
+	changed, err := secretFile.Changed(cfg.Webhook.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("webhook secret: %w", err)
+	}
+	if changed {
+		fmt.Println("Webhook secret changed, making the subscriptions again")
+		subs.Recreate = true
+	}
+	transport := twitch.Transport{Method: "webhook", Callback: cfg.Webhook.CallbackUrl, Secret: cfg.Webhook.Secret}
+	if err := subs.Reconcile(ctx, transport); err != nil {
+		return nil, err
+	}
+	if err := secretFile.Save(cfg.Webhook.Secret); err != nil {
+		fmt.Println("!! Could not save the webhook secret hash:", err)
+	}
+	return webhook.Events(), nil
+}
+
+func setupWebsocket(wpr *wrapper.Wrapper) {
+	// the fixtures don't have items, blocks or sounds, the jar's own report does
+	if reg, err := commands.ServerRegistry("server.jar", "registries"); err != nil {
+		fmt.Println("!! Items, blocks and sounds won't be checked:", err)
+	} else {
+		commands.UseRegistry(reg)
+	}
 
 	wpr.Start()
 	defer wpr.Stop()
 
 	fmt.Println("!! Server loaded")
 
-	authToken := twitch.Auth(code)
-	fmt.Println("!! Got Auth Token", authToken)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var providers []input.Provider
+	var broadcasterId string
+	if !offline {
+		provider, id, err := connectTwitch(ctx)
+		if err != nil {
+			fmt.Println("!!", err)
+			return
+		}
+		providers = append(providers, provider)
+		broadcasterId = id
+	}
+	if replayPath != "" {
+		providers = append(providers, input.NewReplay(replayPath))
+	}
+	if cfg.Discord.Enabled() {
+		providers = append(providers, discordProvider())
+	}
+	if cfg.YouTube.Enabled() {
+		providers = append(providers, youtubeProvider())
+	}
+	for _, alert := range alerts {
+		providers = append(providers, alert)
+	}
+	if consoleAddress != "" {
+		console, err := input.NewConsole(consoleAddress)
+		if err != nil {
+			fmt.Println("!!", err)
+			return
+		}
+		providers = append(providers, console)
+	}
 
-	twitch.SubscribeToEvent(conn, "channel.chat.message", authToken)
+	cooldowns := actions.NewCooldowns(time.Duration(cfg.CommandCooldownSeconds) * time.Second)
 
 	gameOver := false
-	player_name := "tibretS"
+	player_name := cfg.PlayerName
+	attributes := commands.NewAttributeManager(wpr)
+	defer attributes.RemoveAll(player_name)
 
-	for !gameOver {
-		_, data, err := conn.Conn.Read(conn.Context)
-		if err != nil {
-			panic(err)
-		}
+	env := &actions.Env{Wrapper: wpr, Player: player_name, Attributes: attributes}
+	scheduler, err := actions.NewScheduler(env, "pending_reverts.json")
+	if err != nil {
+		panic(err)
+	}
+	scheduler.Start()
+	defer scheduler.Shutdown()
 
-		// process metadata
-		var metadata twitch.MessageMetadata
-		err = json.Unmarshal(data, &metadata)
-		if err != nil {
+	polls := game.NewPolls(helix, broadcasterId, scheduler, wpr, player_name, cfg.PollOptionMap())
+	if cfg.PollChoices > 0 {
+		polls.Choices = cfg.PollChoices
+	}
+	if cfg.PollDurationSeconds > 0 {
+		polls.Duration = time.Duration(cfg.PollDurationSeconds) * time.Second
+	}
+	if cfg.PollIntervalSeconds > 0 && !offline {
+		go runPolls(ctx, polls, time.Duration(cfg.PollIntervalSeconds)*time.Second)
+	}
+	predictor := game.NewPredictions(helix, broadcasterId, wpr, player_name)
+	go chatCommands.Watch(ctx, 2*time.Second)
+
+	inputs := input.Run(ctx, providers...)
+
+	for !gameOver {
+		ev, ok := <-inputs
+		if !ok {
 			break
 		}
 
-		if metadata.Metadata.MessageType == twitch.KeepAlive {
-			continue
-		} else if metadata.Metadata.MessageType == twitch.Notification {
-			payload := twitch.GetMessageText(data)
-
-			commands.Tell(wpr, player_name, payload)
-
-			if payload == "skeleton" {
-				commands.SummonMob(wpr, player_name, commands.Skeleton)
-			} else if payload == "teleport" {
-				commands.TeleportRandom(wpr, player_name, commands.NewVec3(50, 10, 50))
-			} else if payload == "clearskies" {
-				commands.SetWeather(wpr, commands.Clear)
-			} else if payload == "rain" {
-				commands.SetWeather(wpr, commands.Rain)
-			} else if payload == "damage" {
-				commands.Damage(wpr, player_name, 10)
-			} else if payload == "gofast" {
-				attribute_id := uuid.NewString()
-				commands.Attribute(wpr, player_name, commands.MovementSpeed, attribute_id, 2)
-			} else if payload == "slowdown" {
-				attribute_id := uuid.NewString()
-				commands.Attribute(wpr, player_name, commands.MovementSpeed, attribute_id, 0.5)
-			} else if payload == "levelup" {
-				commands.AddLevels(wpr, player_name, 10)
-			} else if payload == "glow" {
-				commands.SetEffect(wpr, player_name, commands.Glowing, 10, 1, false)
-			} else if payload == "silktouch" {
-				commands.Enchant(wpr, player_name, commands.SilkTouch, 1)
-			} else if payload == "kill" {
-				commands.Kill(wpr, player_name)
-			} else if payload == "suitup" {
-				commands.Give(wpr, player_name, []string{"minecraft:diamond_pickaxe",
-					"minecraft:diamond_boots",
-					"minecraft:diamond_helmet",
-					"minecraft:diamond_shovel",
-					"minecraft:diamond_axe",
-					"minecraft:diamond_sword",
-					"minecraft:diamond_chestplate",
-					"minecraft:diamond_leggings"})
+		switch ev.Kind {
+		case input.Redemption:
+			redeem(wpr, scheduler, ev)
+		case input.Cheer, input.Subscribe, input.Resub, input.GiftSub, input.Tip:
+			support(scheduler, ev)
+		case input.Chat:
+			payload := ev.What
+
+			if err := commands.Tell(wpr, player_name, payload); err != nil {
+				fmt.Println("!! Ignoring message from", ev.Viewer.Name, err)
+				continue
 			}
 
-			if payload == "quit" {
+			if payload == "quit" && ev.Viewer.Privileged() {
 				gameOver = true
+				continue
+			}
+			if payload == "poll" && ev.Viewer.Privileged() {
+				// there's no twitch to run it on
+				if offline {
+					input.Reply(ev, "Polls are unavailable offline")
+				} else if err := polls.Start(ctx); err != nil {
+					fmt.Println("!! Could not start poll", err)
+				}
+				continue
+			}
+			if name, ok := strings.CutPrefix(payload, "predict "); ok && ev.Viewer.Privileged() {
+				if spec, ok := predictions[name]; !ok {
+					input.Reply(ev, "No prediction called "+name)
+				} else if err := predictor.Start(ctx, spec); err != nil {
+					fmt.Println("!! Could not start prediction", err)
+				}
+				continue
+			}
+
+			command, ok := chatCommands.Match(payload)
+			if !ok {
+				continue
 			}
+			vars := map[string]string{"user": ev.Viewer.Name, "command": command.Name}
+			if !wpr.Online() {
+				replyTo(ev, cfg.Replies.Offline, vars)
+				continue
+			}
+			if left, ok := cooldowns.Take(command.Name); !ok {
+				vars["seconds"] = strconv.Itoa(int(left.Round(time.Second).Seconds()))
+				replyTo(ev, cfg.Replies.Cooldown, vars)
+				continue
+			}
+			if err := scheduler.Run(command.Spec, command.Duration); err != nil {
+				fmt.Println("!! Command", payload, "failed", err)
+				vars["reason"] = err.Error()
+				replyTo(ev, cfg.Replies.Invalid, vars)
+				continue
+			}
+			replyTo(ev, cfg.Replies.Success, vars)
+		default:
+			polls.Handle(ev.Raw)
 		}
 	}
 
 	fmt.Println("Game ended, connection closed")
 }
+
+// connectTwitch logs in the accounts and subscribes to everything, events come
+// through the returned provider
+func connectTwitch(ctx context.Context) (*twitch.Provider, string, error) {
+	go tokens.Run(ctx)
+	if cfg.HasBot() {
+		go botTokens.Run(ctx)
+	}
+
+	chatHelix, chatLogin := chatAccount()
+	broadcasterId, err := users.UserId(ctx, cfg.BroadcasterLogin)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not look up the broadcaster: %w", err)
+	}
+	chatterId, err := users.UserId(ctx, chatLogin)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not look up the chat account: %w", err)
+	}
+
+	events, err := connectEventSub(ctx, broadcasterId, chatterId)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not connect to twitch: %w", err)
+	}
+	events = twitch.Sequence(ctx, events, twitch.NewDedup(10*time.Minute), 250*time.Millisecond)
+
+	sender := twitch.NewChatSender(chatHelix, broadcasterId, chatterId)
+	go sender.Run(ctx)
+	provider := twitch.NewProvider(events, sender, helix)
+	provider.Rewards = createRewards(ctx, broadcasterId)
+	return provider, broadcasterId, nil
+}
+
+func discordProvider() *discord.Provider {
+	gateway := discord.NewGateway(cfg.Discord.Token, discord.IntentGuilds|discord.IntentGuildMessages|discord.IntentMessageContent)
+	gateway.Url = cfg.Discord.Endpoints.GatewayUrl()
+	rest := discord.NewRest(cfg.Discord.Token)
+	rest.BaseUrl = cfg.Discord.Endpoints.ApiUrl()
+
+	provider := discord.NewProvider(gateway, rest)
+	provider.ApplicationId = cfg.Discord.ApplicationId
+	provider.GuildId = cfg.Discord.GuildId
+	provider.ChannelId = cfg.Discord.ChannelId
+	provider.Prefix = cfg.Discord.Prefix
+	provider.Roles = cfg.Discord.Roles
+	provider.RequireRole = cfg.Discord.RequireRole
+	return provider
+}
+
+func youtubeProvider() *youtube.Provider {
+	client := youtube.NewClient(cfg.YouTube.ApiKey)
+	if cfg.YouTube.Api != "" {
+		client.BaseUrl = cfg.YouTube.Api
+	}
+	provider := youtube.NewProvider(client, cfg.YouTube.VideoId)
+	provider.LiveChatId = cfg.YouTube.LiveChatId
+	provider.Rates = cfg.Rates()
+	if cfg.YouTube.MembershipTiers != nil {
+		provider.MembershipTiers = cfg.YouTube.MembershipTiers
+	}
+	return provider
+}
+
+// runPolls starts a poll every interval while the server is up
+func runPolls(ctx context.Context, polls *game.Polls, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if !polls.Wrapper.Online() {
+			continue
+		}
+		if err := polls.Start(ctx); err != nil {
+			fmt.Println("!! Could not start poll", err)
+		}
+	}
+}
+
+// replyTo answers a viewer where they wrote, quiet templates send nothing
+func replyTo(ev input.Event, template string, vars map[string]string) {
+	input.Reply(ev, config.Format(template, vars))
+}
+
+// createRewards makes the channel point rewards ours so redemptions can be
+// fulfilled or refunded, it returns the ids of the ones that are
+func createRewards(ctx context.Context, broadcasterId string) map[string]bool {
+	if len(rewards) == 0 {
+		return map[string]bool{}
+	}
+	titles := make([]string, 0, len(rewards))
+	for title := range rewards {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	wanted := make([]twitch.CreateCustomRewardRequest, 0, len(titles))
+	for _, title := range titles {
+		// redemptions wait in the queue until we fulfill or refund them
+		wanted = append(wanted, twitch.CreateCustomRewardRequest{Title: title, Cost: rewards[title].Cost, IsEnabled: true})
+	}
+
+	created, err := helix.EnsureCustomRewards(ctx, broadcasterId, wanted)
+	if err != nil {
+		fmt.Println("!! Not every channel point reward is ours, those won't be fulfilled or refunded:", err)
+	}
+	ids := map[string]bool{}
+	for _, reward := range created {
+		ids[reward.Id] = true
+	}
+	return ids
+}
+
+// runs the action for a reward we know about and refunds the points if it didn't work
+func redeem(wpr *wrapper.Wrapper, scheduler *actions.Scheduler, ev input.Event) {
+	reward, ok := rewards.Lookup(ev.RewardId, ev.What)
+	if !ok {
+		return
+	}
+
+	vars := map[string]string{"user": ev.Viewer.Name, "command": ev.What}
+	done, template := true, cfg.Replies.Success
+	if !wpr.Online() {
+		done, template = false, cfg.Replies.Offline
+	} else if err := scheduler.Run(reward.Spec, reward.Duration); err != nil {
+		fmt.Println("!! Reward", ev.What, "failed", err)
+		vars["reason"] = err.Error()
+		done, template = false, cfg.Replies.Invalid
+	}
+	replyTo(ev, template, vars)
+	input.Complete(ev, done)
+}
+
+// support runs the tiered action for bits, subs and tips
+func support(scheduler *actions.Scheduler, ev input.Event) {
+	var ran bool
+	var err error
+	switch ev.Kind {
+	case input.Cheer:
+		ran, err = scheduler.RunTier(supportTiers.Cheer, ev.Amount, "")
+	case input.Subscribe:
+		ran, err = scheduler.RunTier(supportTiers.Subscribe, ev.Amount, ev.Tier)
+	case input.Resub:
+		ran, err = scheduler.RunTier(supportTiers.Resub, ev.Amount, ev.Tier)
+	case input.GiftSub:
+		ran, err = scheduler.RunTier(supportTiers.GiftSub, ev.Amount, ev.Tier)
+	case input.Tip:
+		ran, err = scheduler.RunTier(supportTiers.Tip, ev.Amount, "")
+	}
+
+	if err != nil {
+		fmt.Println("!! Support action failed", err)
+	} else if ran {
+		fmt.Println("!! Support action ran for", ev.Kind, "from", ev.Viewer.Name, "on", ev.Source)
+	}
+}
@@ -0,0 +1,113 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// twitch rejects chat messages longer than this many characters
+const MaxChatMessageLength = 500
+
+// ChatSender posts messages to the broadcaster's chat one at a time. Accounts
+// that aren't moderators get 20 messages every 30 seconds, Interval keeps us
+// under that.
+type ChatSender struct {
+	BroadcasterId string
+	SenderId      string
+	Interval      time.Duration
+
+	helix *Helix
+	queue chan SendChatMessageRequest
+}
+
+func NewChatSender(helix *Helix, broadcasterId string, senderId string) *ChatSender {
+	return &ChatSender{
+		BroadcasterId: broadcasterId,
+		SenderId:      senderId,
+		Interval:      1500 * time.Millisecond,
+		helix:         helix,
+		queue:         make(chan SendChatMessageRequest, 64),
+	}
+}
+
+func (cs *ChatSender) Send(text string) {
+	cs.Reply("", text)
+}
+
+// Reply threads the message under parentMessageId, long messages are split and
+// only the first part is threaded
+func (cs *ChatSender) Reply(parentMessageId string, text string) {
+	for _, part := range SplitChatMessage(text, MaxChatMessageLength) {
+		msg := SendChatMessageRequest{
+			BroadcasterId:        cs.BroadcasterId,
+			SenderId:             cs.SenderId,
+			Message:              part,
+			ReplyParentMessageId: parentMessageId,
+		}
+		parentMessageId = ""
+
+		select {
+		case cs.queue <- msg:
+		default:
+			fmt.Println("Chat queue full, dropping message", part)
+		}
+	}
+}
+
+// Run sends queued messages until ctx is done
+func (cs *ChatSender) Run(ctx context.Context) {
+	ticker := time.NewTicker(cs.Interval)
+	defer ticker.Stop()
+
+	for {
+		var msg SendChatMessageRequest
+		select {
+		case msg = <-cs.queue:
+		case <-ctx.Done():
+			return
+		}
+
+		sent, err := cs.helix.SendChatMessage(ctx, msg)
+		if err != nil {
+			fmt.Println("Could not send chat message", err)
+		} else if !sent.IsSent && sent.DropReason != nil {
+			fmt.Println("Chat message dropped", sent.DropReason.Code, sent.DropReason.Message)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// SplitChatMessage breaks text into parts of at most limit characters, on
+// spaces where it can
+func SplitChatMessage(text string, limit int) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+
+	var parts []string
+	for utf8.RuneCountInString(text) > limit {
+		runes := []rune(text)
+		cut := limit
+		for idx := limit; idx > limit/2; idx-- {
+			if runes[idx] == ' ' {
+				cut = idx
+				break
+			}
+		}
+		parts = append(parts, strings.TrimSpace(string(runes[:cut])))
+		text = strings.TrimSpace(string(runes[cut:]))
+	}
+	if text != "" {
+		parts = append(parts, text)
+	}
+	return parts
+}
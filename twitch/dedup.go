@@ -0,0 +1,127 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Dedup remembers message ids for TTL. Twitch delivers at least once, so the
+// same notification can show up again after a reconnect or a webhook retry.
+type Dedup struct {
+	TTL time.Duration
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewDedup(ttl time.Duration) *Dedup {
+	return &Dedup{TTL: ttl, seen: map[string]time.Time{}}
+}
+
+// Seen reports whether id came by within TTL, and remembers it if not
+func (d *Dedup) Seen(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	for seenId, at := range d.seen {
+		if now.Sub(at) > d.TTL {
+			delete(d.seen, seenId)
+		}
+	}
+	if _, ok := d.seen[id]; ok {
+		return true
+	}
+	d.seen[id] = now
+	return false
+}
+
+// Forget lets id through again, for messages that were seen but not handled
+func (d *Dedup) Forget(id string) {
+	d.mu.Lock()
+	delete(d.seen, id)
+	d.mu.Unlock()
+}
+
+type heldEvent struct {
+	ev      EventSubEvent
+	arrived time.Time
+}
+
+// Sequence drops duplicates from in and hands the rest on in message_timestamp
+// order per subscription. Every message is held back for delay so one that
+// overtook an older message on the way can be put behind it. The returned
+// channel closes once in is closed and everything held has gone out.
+func Sequence(ctx context.Context, in <-chan EventSubEvent, dedup *Dedup, delay time.Duration) <-chan EventSubEvent {
+	out := make(chan EventSubEvent)
+	go func() {
+		defer close(out)
+
+		held := map[string][]heldEvent{}
+		released := map[string]time.Time{}
+		ticker := time.NewTicker(max(delay/4, 10*time.Millisecond))
+		defer ticker.Stop()
+
+		send := func(ev EventSubEvent) bool {
+			select {
+			case out <- ev:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		// release sends what has been held long enough, or everything when flush is set
+		release := func(now time.Time, flush bool) bool {
+			for sub, queue := range held {
+				for len(queue) > 0 && (flush || now.Sub(queue[0].arrived) >= delay) {
+					ev := queue[0].ev
+					queue = queue[1:]
+					released[sub] = ev.MessageTimestamp()
+					if !send(ev) {
+						return false
+					}
+				}
+				if len(queue) == 0 {
+					delete(held, sub)
+				} else {
+					held[sub] = queue
+				}
+			}
+			return true
+		}
+
+		for {
+			select {
+			case ev, ok := <-in:
+				if !ok {
+					release(time.Now(), true)
+					return
+				}
+				if dedup.Seen(ev.MessageId()) {
+					fmt.Println("Dropping duplicate EventSub message", ev.MessageId())
+					continue
+				}
+				sub := ev.SubscriptionId()
+				if last, ok := released[sub]; ok && ev.MessageTimestamp().Before(last) {
+					// too late to put it in its place, better late than never
+					fmt.Println("EventSub message", ev.MessageId(), "arrived out of order")
+				}
+				queue := append(held[sub], heldEvent{ev: ev, arrived: time.Now()})
+				sort.SliceStable(queue, func(i, j int) bool {
+					return queue[i].ev.MessageTimestamp().Before(queue[j].ev.MessageTimestamp())
+				})
+				held[sub] = queue
+			case now := <-ticker.C:
+				if !release(now, false) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
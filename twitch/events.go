@@ -0,0 +1,311 @@
+package twitch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	ChatMessageType         = "channel.chat.message"
+	RedemptionAddType       = "channel.channel_points_custom_reward_redemption.add"
+	RedemptionUpdateType    = "channel.channel_points_custom_reward_redemption.update"
+	CheerType               = "channel.cheer"
+	SubscribeType           = "channel.subscribe"
+	SubscriptionMessageType = "channel.subscription.message"
+	SubscriptionGiftType    = "channel.subscription.gift"
+	FollowType              = "channel.follow"
+	RaidType                = "channel.raid"
+	PollBeginType           = "channel.poll.begin"
+	PollProgressType        = "channel.poll.progress"
+	PollEndType             = "channel.poll.end"
+	PredictionBeginType     = "channel.prediction.begin"
+	PredictionProgressType  = "channel.prediction.progress"
+	PredictionLockType      = "channel.prediction.lock"
+	PredictionEndType       = "channel.prediction.end"
+	HypeTrainBeginType      = "channel.hype_train.begin"
+	HypeTrainProgressType   = "channel.hype_train.progress"
+	HypeTrainEndType        = "channel.hype_train.end"
+	AdBreakBeginType        = "channel.ad_break.begin"
+)
+
+const (
+	SubscriptionTierOne   = "1000"
+	SubscriptionTierTwo   = "2000"
+	SubscriptionTierThree = "3000"
+)
+
+type Broadcaster struct {
+	BroadcasterUserId    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+type User struct {
+	UserId    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+type Badge struct {
+	SetId string `json:"set_id"`
+	Id    string `json:"id"`
+	Info  string `json:"info"`
+}
+
+type MessageFragment struct {
+	Type      string `json:"type"`
+	Text      string `json:"text"`
+	Cheermote *struct {
+		Prefix string `json:"prefix"`
+		Bits   int    `json:"bits"`
+		Tier   int    `json:"tier"`
+	} `json:"cheermote"`
+	Emote *struct {
+		Id         string `json:"id"`
+		EmoteSetId string `json:"emote_set_id"`
+	} `json:"emote"`
+	Mention *User `json:"mention"`
+}
+
+type ChatMessageEvent struct {
+	Broadcaster
+	ChatterUserId    string `json:"chatter_user_id"`
+	ChatterUserLogin string `json:"chatter_user_login"`
+	ChatterUserName  string `json:"chatter_user_name"`
+	MessageId        string `json:"message_id"`
+	Message          struct {
+		Text      string            `json:"text"`
+		Fragments []MessageFragment `json:"fragments"`
+	} `json:"message"`
+	MessageType string  `json:"message_type"`
+	Badges      []Badge `json:"badges"`
+	Color       string  `json:"color"`
+	Cheer       *struct {
+		Bits int `json:"bits"`
+	} `json:"cheer"`
+	Reply *struct {
+		ParentMessageId   string `json:"parent_message_id"`
+		ParentMessageBody string `json:"parent_message_body"`
+		ParentUserId      string `json:"parent_user_id"`
+		ParentUserLogin   string `json:"parent_user_login"`
+		ParentUserName    string `json:"parent_user_name"`
+		ThreadMessageId   string `json:"thread_message_id"`
+		ThreadUserId      string `json:"thread_user_id"`
+		ThreadUserLogin   string `json:"thread_user_login"`
+		ThreadUserName    string `json:"thread_user_name"`
+	} `json:"reply"`
+	ChannelPointsCustomRewardId string `json:"channel_points_custom_reward_id"`
+}
+
+func (e ChatMessageEvent) HasBadge(setId string) bool {
+	for _, b := range e.Badges {
+		if b.SetId == setId {
+			return true
+		}
+	}
+	return false
+}
+
+type RedemptionEvent struct {
+	Id string `json:"id"`
+	Broadcaster
+	User
+	UserInput string `json:"user_input"`
+	Status    string `json:"status"`
+	Reward    struct {
+		Id     string `json:"id"`
+		Title  string `json:"title"`
+		Cost   int    `json:"cost"`
+		Prompt string `json:"prompt"`
+	} `json:"reward"`
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
+
+type CheerEvent struct {
+	IsAnonymous bool `json:"is_anonymous"`
+	User
+	Broadcaster
+	Message string `json:"message"`
+	Bits    int    `json:"bits"`
+}
+
+type SubscribeEvent struct {
+	User
+	Broadcaster
+	Tier   string `json:"tier"`
+	IsGift bool   `json:"is_gift"`
+}
+
+type SubscriptionMessageEvent struct {
+	User
+	Broadcaster
+	Tier    string `json:"tier"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	CumulativeMonths int `json:"cumulative_months"`
+	StreakMonths     int `json:"streak_months"`
+	DurationMonths   int `json:"duration_months"`
+}
+
+type SubscriptionGiftEvent struct {
+	User
+	Broadcaster
+	Total           int    `json:"total"`
+	Tier            string `json:"tier"`
+	CumulativeTotal int    `json:"cumulative_total"`
+	IsAnonymous     bool   `json:"is_anonymous"`
+}
+
+type FollowEvent struct {
+	User
+	Broadcaster
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+type RaidEvent struct {
+	FromBroadcasterUserId    string `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string `json:"from_broadcaster_user_name"`
+	ToBroadcasterUserId      string `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin   string `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName    string `json:"to_broadcaster_user_name"`
+	Viewers                  int    `json:"viewers"`
+}
+
+type PollChoice struct {
+	Id                 string `json:"id"`
+	Title              string `json:"title"`
+	BitsVotes          int    `json:"bits_votes"`
+	ChannelPointsVotes int    `json:"channel_points_votes"`
+	Votes              int    `json:"votes"`
+}
+
+type VotingSettings struct {
+	IsEnabled     bool `json:"is_enabled"`
+	AmountPerVote int  `json:"amount_per_vote"`
+}
+
+// PollEvent covers begin, progress and end; Status and EndedAt are only set on end
+type PollEvent struct {
+	Id string `json:"id"`
+	Broadcaster
+	Title               string         `json:"title"`
+	Choices             []PollChoice   `json:"choices"`
+	BitsVoting          VotingSettings `json:"bits_voting"`
+	ChannelPointsVoting VotingSettings `json:"channel_points_voting"`
+	Status              string         `json:"status"`
+	StartedAt           time.Time      `json:"started_at"`
+	EndsAt              time.Time      `json:"ends_at"`
+	EndedAt             time.Time      `json:"ended_at"`
+}
+
+type Predictor struct {
+	User
+	ChannelPointsWon  int `json:"channel_points_won"`
+	ChannelPointsUsed int `json:"channel_points_used"`
+}
+
+type PredictionOutcome struct {
+	Id            string      `json:"id"`
+	Title         string      `json:"title"`
+	Color         string      `json:"color"`
+	Users         int         `json:"users"`
+	ChannelPoints int         `json:"channel_points"`
+	TopPredictors []Predictor `json:"top_predictors"`
+}
+
+// PredictionEvent covers begin, progress, lock and end
+type PredictionEvent struct {
+	Id string `json:"id"`
+	Broadcaster
+	Title            string              `json:"title"`
+	Outcomes         []PredictionOutcome `json:"outcomes"`
+	WinningOutcomeId string              `json:"winning_outcome_id"`
+	Status           string              `json:"status"`
+	StartedAt        time.Time           `json:"started_at"`
+	LocksAt          time.Time           `json:"locks_at"`
+	LockedAt         time.Time           `json:"locked_at"`
+	EndedAt          time.Time           `json:"ended_at"`
+}
+
+type HypeTrainContribution struct {
+	User
+	Type  string `json:"type"`
+	Total int    `json:"total"`
+}
+
+// HypeTrainEvent covers begin, progress and end
+type HypeTrainEvent struct {
+	Id string `json:"id"`
+	Broadcaster
+	Total            int                     `json:"total"`
+	Progress         int                     `json:"progress"`
+	Goal             int                     `json:"goal"`
+	Level            int                     `json:"level"`
+	TopContributions []HypeTrainContribution `json:"top_contributions"`
+	LastContribution *HypeTrainContribution  `json:"last_contribution"`
+	StartedAt        time.Time               `json:"started_at"`
+	ExpiresAt        time.Time               `json:"expires_at"`
+	EndedAt          time.Time               `json:"ended_at"`
+	CooldownEndsAt   time.Time               `json:"cooldown_ends_at"`
+}
+
+type AdBreakEvent struct {
+	Broadcaster
+	DurationSeconds    int       `json:"duration_seconds"`
+	StartedAt          time.Time `json:"started_at"`
+	IsAutomatic        bool      `json:"is_automatic"`
+	RequesterUserId    string    `json:"requester_user_id"`
+	RequesterUserLogin string    `json:"requester_user_login"`
+	RequesterUserName  string    `json:"requester_user_name"`
+}
+
+// RawEvent is what subscription types we don't know about decode to
+type RawEvent struct {
+	Type string
+	Data json.RawMessage
+}
+
+var eventDecoders = map[string]func() any{
+	ChatMessageType:         func() any { return &ChatMessageEvent{} },
+	RedemptionAddType:       func() any { return &RedemptionEvent{} },
+	RedemptionUpdateType:    func() any { return &RedemptionEvent{} },
+	CheerType:               func() any { return &CheerEvent{} },
+	SubscribeType:           func() any { return &SubscribeEvent{} },
+	SubscriptionMessageType: func() any { return &SubscriptionMessageEvent{} },
+	SubscriptionGiftType:    func() any { return &SubscriptionGiftEvent{} },
+	FollowType:              func() any { return &FollowEvent{} },
+	RaidType:                func() any { return &RaidEvent{} },
+	PollBeginType:           func() any { return &PollEvent{} },
+	PollProgressType:        func() any { return &PollEvent{} },
+	PollEndType:             func() any { return &PollEvent{} },
+	PredictionBeginType:     func() any { return &PredictionEvent{} },
+	PredictionProgressType:  func() any { return &PredictionEvent{} },
+	PredictionLockType:      func() any { return &PredictionEvent{} },
+	PredictionEndType:       func() any { return &PredictionEvent{} },
+	HypeTrainBeginType:      func() any { return &HypeTrainEvent{} },
+	HypeTrainProgressType:   func() any { return &HypeTrainEvent{} },
+	HypeTrainEndType:        func() any { return &HypeTrainEvent{} },
+	AdBreakBeginType:        func() any { return &AdBreakEvent{} },
+}
+
+// DecodeEvent turns the event object of a notification into one of the typed
+// events above, as a pointer. Unknown types come back as a *RawEvent.
+func DecodeEvent(subscriptionType string, data json.RawMessage) (any, error) {
+	newEvent, ok := eventDecoders[subscriptionType]
+	if !ok {
+		return &RawEvent{Type: subscriptionType, Data: data}, nil
+	}
+
+	ev := newEvent()
+	if err := json.Unmarshal(data, ev); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", subscriptionType, err)
+	}
+	return ev, nil
+}
+
+func (n NotificationEvent) Decode() (any, error) {
+	return DecodeEvent(n.SubscriptionType, n.Event)
+}
@@ -0,0 +1,352 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// keepalive messages can arrive a little late, this much slack is allowed on top
+// of keepalive_timeout_seconds before the connection is considered dead
+const keepaliveGrace = 5 * time.Second
+
+// how long a reconnect_url gets to welcome us before we give up on it and
+// start a fresh session instead
+const reconnectTimeout = 10 * time.Second
+
+var ErrKeepaliveTimeout = errors.New("eventsub: no message within keepalive timeout")
+
+type Subscription struct {
+	Id        string            `json:"id"`
+	Status    string            `json:"status"`
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Cost      int               `json:"cost"`
+	Condition map[string]string `json:"condition"`
+	Transport Transport         `json:"transport"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// EventSubEvent is either a NotificationEvent or a RevocationEvent
+type EventSubEvent interface {
+	MessageId() string
+	MessageTimestamp() time.Time
+	SubscriptionId() string
+}
+
+type NotificationEvent struct {
+	Id                  string
+	Timestamp           time.Time
+	SubscriptionType    string
+	SubscriptionVersion string
+	Subscription        Subscription
+	Event               json.RawMessage
+	// the whole message as received
+	Raw []byte
+}
+
+func (n NotificationEvent) MessageId() string {
+	return n.Id
+}
+
+func (n NotificationEvent) MessageTimestamp() time.Time {
+	return n.Timestamp
+}
+
+func (n NotificationEvent) SubscriptionId() string {
+	return n.Subscription.Id
+}
+
+type RevocationEvent struct {
+	Id           string
+	Timestamp    time.Time
+	Subscription Subscription
+}
+
+func (r RevocationEvent) MessageId() string {
+	return r.Id
+}
+
+func (r RevocationEvent) MessageTimestamp() time.Time {
+	return r.Timestamp
+}
+
+func (r RevocationEvent) SubscriptionId() string {
+	return r.Subscription.Id
+}
+
+type eventSubPayload struct {
+	Session      Session         `json:"session"`
+	Subscription Subscription    `json:"subscription"`
+	Event        json.RawMessage `json:"event"`
+}
+
+type eventSubMessage struct {
+	MessageMetadata
+	Payload eventSubPayload `json:"payload"`
+}
+
+// EventSubClient keeps a websocket session open, following reconnect requests
+// and starting over when keepalives stop. OnSession is called for every new
+// session so subscriptions can be created; sessions reached through a
+// session_reconnect keep their subscriptions and don't call it.
+type EventSubClient struct {
+	Url              string
+	KeepaliveTimeout time.Duration
+	// slack on top of the keepalive timeout, keepaliveGrace when 0
+	KeepaliveGrace time.Duration
+	// how long to wait on a reconnect_url, reconnectTimeout when 0
+	ReconnectTimeout time.Duration
+	OnSession        func(ctx context.Context, sessionId string) error
+
+	events chan EventSubEvent
+}
+
+func NewEventSubClient(onSession func(ctx context.Context, sessionId string) error) *EventSubClient {
+	return &EventSubClient{
+		Url:       twitchWebsocketUrl,
+		OnSession: onSession,
+		events:    make(chan EventSubEvent, 16),
+	}
+}
+
+func (c *EventSubClient) Events() <-chan EventSubEvent {
+	return c.events
+}
+
+// Run blocks until ctx is done, the events channel is closed when it returns
+func (c *EventSubClient) Run(ctx context.Context) error {
+	defer close(c.events)
+
+	backoff := time.Second
+	for {
+		conn, session, err := c.dial(ctx, c.Url)
+		if err == nil && c.OnSession != nil {
+			err = c.OnSession(ctx, session.Id)
+			if err != nil {
+				conn.Close(websocket.StatusNormalClosure, "")
+			}
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fmt.Println("EventSub connection failed, retrying in", backoff, err)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff = min(backoff*2, time.Minute)
+			continue
+		}
+		backoff = time.Second
+
+		err = c.readSession(ctx, conn, session)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		fmt.Println("EventSub session lost, starting a new one", err)
+	}
+}
+
+func (c *EventSubClient) dial(ctx context.Context, rawUrl string) (*websocket.Conn, Session, error) {
+	if c.KeepaliveTimeout > 0 && rawUrl == c.Url {
+		u, err := url.Parse(rawUrl)
+		if err != nil {
+			return nil, Session{}, err
+		}
+		q := u.Query()
+		q.Set("keepalive_timeout_seconds", strconv.Itoa(int(c.KeepaliveTimeout.Seconds())))
+		u.RawQuery = q.Encode()
+		rawUrl = u.String()
+	}
+
+	conn, _, err := websocket.Dial(ctx, rawUrl, nil)
+	if err != nil {
+		return nil, Session{}, err
+	}
+	// notifications can be bigger than the default 32KiB
+	conn.SetReadLimit(1 << 20)
+
+	// the welcome has to arrive within 10 seconds or twitch drops us anyway
+	welcomeCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	msg, _, err := readEventSubMessage(welcomeCtx, conn)
+	if err != nil {
+		conn.Close(websocket.StatusNormalClosure, "")
+		return nil, Session{}, err
+	}
+	if msg.Metadata.MessageType != Welcome {
+		conn.Close(websocket.StatusNormalClosure, "")
+		return nil, Session{}, fmt.Errorf("eventsub: expected welcome, got %s", msg.Metadata.MessageType)
+	}
+
+	session := msg.Payload.Session
+	fmt.Println("EventSub session", session.Id, "keepalive", session.KeepaliveTimeoutSeconds, "s")
+	return conn, session, nil
+}
+
+func readEventSubMessage(ctx context.Context, conn *websocket.Conn) (*eventSubMessage, []byte, error) {
+	_, data, err := conn.Read(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var msg eventSubMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, nil, fmt.Errorf("eventsub: bad message: %w", err)
+	}
+	return &msg, data, nil
+}
+
+type eventSubRead struct {
+	msg  *eventSubMessage
+	data []byte
+	err  error
+}
+
+// readAll reads conn until it fails, the failure is the last thing sent.
+// Closing quit lets go of a connection that isn't read anymore.
+func readAll(ctx context.Context, conn *websocket.Conn, quit <-chan struct{}) <-chan eventSubRead {
+	reads := make(chan eventSubRead)
+	go func() {
+		for {
+			msg, data, err := readEventSubMessage(ctx, conn)
+			select {
+			case reads <- eventSubRead{msg, data, err}:
+			case <-quit:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return reads
+}
+
+type eventSubDial struct {
+	conn    *websocket.Conn
+	session Session
+	err     error
+}
+
+// readSession delivers events until the connection dies. When twitch asks us
+// to reconnect the old connection is read until the new one is welcomed, so
+// nothing sent in between is lost.
+func (c *EventSubClient) readSession(ctx context.Context, conn *websocket.Conn, session Session) error {
+	quit := make(chan struct{})
+	var dialed chan eventSubDial
+	defer func() {
+		close(quit)
+		conn.Close(websocket.StatusNormalClosure, "")
+		if dialed != nil {
+			go func() {
+				if d := <-dialed; d.err == nil {
+					d.conn.Close(websocket.StatusNormalClosure, "")
+				}
+			}()
+		}
+	}()
+	reads := readAll(ctx, conn, quit)
+
+	grace := c.KeepaliveGrace
+	if grace <= 0 {
+		grace = keepaliveGrace
+	}
+	for {
+		keepalive := session.KeepaliveTimeoutSeconds
+		if keepalive <= 0 {
+			keepalive = 10
+		}
+		timeout := time.NewTimer(time.Duration(keepalive)*time.Second + grace)
+
+		var read eventSubRead
+		select {
+		case read = <-reads:
+			timeout.Stop()
+		case d := <-dialed:
+			timeout.Stop()
+			dialed = nil
+			if d.err != nil {
+				// Run starts a fresh session and OnSession subscribes again
+				return fmt.Errorf("eventsub: reconnect: %w", d.err)
+			}
+			close(quit)
+			conn.Close(websocket.StatusNormalClosure, "")
+			conn, session = d.conn, d.session
+			quit = make(chan struct{})
+			reads = readAll(ctx, conn, quit)
+			continue
+		case <-timeout.C:
+			return ErrKeepaliveTimeout
+		case <-ctx.Done():
+			timeout.Stop()
+			return ctx.Err()
+		}
+
+		if read.err != nil {
+			// the old connection may go first, the new one takes over
+			if dialed != nil {
+				reads = nil
+				continue
+			}
+			return read.err
+		}
+
+		msg := read.msg
+		switch msg.Metadata.MessageType {
+		case KeepAlive:
+		case Notification:
+			c.deliver(ctx, NotificationEvent{
+				Id:                  msg.Metadata.MessageId,
+				Timestamp:           msg.Metadata.MessageTimestamp,
+				SubscriptionType:    msg.Metadata.SubscriptionType,
+				SubscriptionVersion: msg.Metadata.SubscriptionVersion,
+				Subscription:        msg.Payload.Subscription,
+				Event:               msg.Payload.Event,
+				Raw:                 read.data,
+			})
+		case Revocation:
+			fmt.Println("Subscription revoked", msg.Payload.Subscription.Type, msg.Payload.Subscription.Status)
+			c.deliver(ctx, RevocationEvent{
+				Id:           msg.Metadata.MessageId,
+				Timestamp:    msg.Metadata.MessageTimestamp,
+				Subscription: msg.Payload.Subscription,
+			})
+		case Reconnect:
+			if dialed != nil {
+				continue
+			}
+			fmt.Println("EventSub reconnect requested")
+			dialed = make(chan eventSubDial, 1)
+			go func(dialed chan<- eventSubDial, reconnectUrl string) {
+				timeout := c.ReconnectTimeout
+				if timeout <= 0 {
+					timeout = reconnectTimeout
+				}
+				// the conn outlives this context, it only bounds the dial and welcome
+				dialCtx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+				conn, session, err := c.dial(dialCtx, reconnectUrl)
+				dialed <- eventSubDial{conn, session, err}
+			}(dialed, msg.Payload.Session.ReconnectUrl)
+		default:
+			fmt.Println("Unknown EventSub message", msg.Metadata.MessageType)
+		}
+	}
+}
+
+func (c *EventSubClient) deliver(ctx context.Context, ev EventSubEvent) {
+	select {
+	case c.events <- ev:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,175 @@
+package twitch_test
+
+import (
+	"context"
+	"encoding/json"
+	"minecraftgo/twitch"
+	"minecraftgo/twitch/twitchmock"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// startEventSub connects a client to a fresh mock with 1s keepalives, every
+// session it's welcomed to subscribes to chat
+func startEventSub(t *testing.T) (*twitchmock.Server, *twitch.EventSubClient, func() []string) {
+	t.Helper()
+	mock := twitchmock.NewServer()
+	mock.KeepaliveTimeout = time.Second
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+	endpoints := twitchmock.Endpoints(srv.URL)
+
+	token := mock.Token("streamer")
+	broadcaster := mock.User("streamer")
+	helix := twitch.NewHelix(func() string { return token })
+	helix.BaseUrl = endpoints.HelixUrl()
+	helix.ClientId = "test"
+
+	var mu sync.Mutex
+	var sessions []string
+	client := twitch.NewEventSubClient(func(ctx context.Context, sessionId string) error {
+		mu.Lock()
+		sessions = append(sessions, sessionId)
+		mu.Unlock()
+		_, _, err := helix.CreateEventSubSubscription(ctx, &twitch.WebsocketSubscriptionMessage{
+			Type:      twitch.ChatMessageType,
+			Version:   "1",
+			Transport: twitch.Transport{Method: "websocket", SessionId: sessionId},
+			Condition: twitch.Condition{BroadcasterId: broadcaster.Id, UserId: broadcaster.Id},
+		})
+		return err
+	})
+	client.Url = endpoints.EventSubUrl()
+	client.KeepaliveGrace = 100 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go client.Run(ctx)
+
+	started := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, sessions...)
+	}
+	waitFor(t, "a subscription", func() bool { return len(mock.Subscriptions()) == 1 })
+	return mock, client, started
+}
+
+func waitFor(t *testing.T, what string, ok func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !ok() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func nextEvent(t *testing.T, client *twitch.EventSubClient) twitch.EventSubEvent {
+	t.Helper()
+	select {
+	case ev := <-client.Events():
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+		return nil
+	}
+}
+
+// nextChat is the text of the next notification, which has to be chat
+func nextChat(t *testing.T, client *twitch.EventSubClient) string {
+	t.Helper()
+	n, ok := nextEvent(t, client).(twitch.NotificationEvent)
+	if !ok || n.SubscriptionType != twitch.ChatMessageType {
+		t.Fatalf("expected a chat notification, got %#v", n)
+	}
+	var chat twitch.ChatMessageEvent
+	if err := json.Unmarshal(n.Event, &chat); err != nil {
+		t.Fatal(err)
+	}
+	return chat.Message.Text
+}
+
+func TestEventSubWelcome(t *testing.T) {
+	mock, client, started := startEventSub(t)
+	if sessions := started(); len(sessions) != 1 || mock.Subscriptions()[0].Transport.SessionId != sessions[0] {
+		t.Fatalf("sessions %v, subscription %+v", sessions, mock.Subscriptions())
+	}
+
+	mock.Chat("viewer1", "skeleton")
+	if text := nextChat(t, client); text != "skeleton" {
+		t.Errorf("got %q", text)
+	}
+}
+
+func TestEventSubKeepaliveTimeout(t *testing.T) {
+	mock, client, started := startEventSub(t)
+	mock.Keepalives(false)
+	waitFor(t, "a new session", func() bool { return len(started()) == 2 })
+	mock.Keepalives(true)
+
+	// the new session subscribed again, chat gets through
+	mock.Chat("viewer1", "rain")
+	if text := nextChat(t, client); text != "rain" {
+		t.Errorf("got %q", text)
+	}
+}
+
+func TestEventSubReconnect(t *testing.T) {
+	mock, client, started := startEventSub(t)
+	mock.WelcomeDelay = 300 * time.Millisecond
+	mock.Reconnect()
+	// goes out on the old connection while the new one waits for its welcome
+	mock.Chat("viewer1", "before")
+	if text := nextChat(t, client); text != "before" {
+		t.Errorf("got %q", text)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	mock.Chat("viewer1", "after")
+	if text := nextChat(t, client); text != "after" {
+		t.Errorf("got %q", text)
+	}
+	// the session and its subscription moved over as they were
+	if sessions := started(); len(sessions) != 1 {
+		t.Errorf("%d sessions, want 1", len(sessions))
+	}
+	if subs := mock.Subscriptions(); len(subs) != 1 || subs[0].Status != "enabled" {
+		t.Errorf("subscriptions %+v", subs)
+	}
+}
+
+// a reconnect_url that never welcomes us is given up on for a fresh session
+func TestEventSubReconnectTimeout(t *testing.T) {
+	mock, client, started := startEventSub(t)
+	client.ReconnectTimeout = 200 * time.Millisecond
+	mock.WelcomeDelay = 500 * time.Millisecond
+	mock.Reconnect()
+	waitFor(t, "a new session", func() bool { return len(started()) == 2 })
+	waitFor(t, "its subscription", func() bool {
+		for _, sub := range mock.Subscriptions() {
+			if sub.Transport.SessionId == started()[1] && sub.Status == "enabled" {
+				return true
+			}
+		}
+		return false
+	})
+
+	mock.Chat("viewer1", "fresh")
+	if text := nextChat(t, client); text != "fresh" {
+		t.Errorf("got %q", text)
+	}
+}
+
+func TestEventSubRevocation(t *testing.T) {
+	mock, client, _ := startEventSub(t)
+	mock.Revoke(twitch.ChatMessageType, "authorization_revoked")
+
+	r, ok := nextEvent(t, client).(twitch.RevocationEvent)
+	if !ok || r.Subscription.Type != twitch.ChatMessageType || r.Subscription.Status != "authorization_revoked" {
+		t.Errorf("got %#v", r)
+	}
+}
@@ -0,0 +1,427 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"minecraftgo/secrets"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const twitchHelixUrl = "https://api.twitch.tv/helix"
+
+// HelixError is what helix returns for anything that isn't a 2xx
+type HelixError struct {
+	StatusCode int    `json:"status"`
+	ErrorName  string `json:"error"`
+	Message    string `json:"message"`
+	Method     string `json:"-"`
+	Path       string `json:"-"`
+}
+
+func (e *HelixError) Error() string {
+	return fmt.Sprintf("helix %s %s: %d %s: %s", e.Method, e.Path, e.StatusCode, e.ErrorName, e.Message)
+}
+
+func IsHelixStatus(err error, status int) bool {
+	var he *HelixError
+	return errors.As(err, &he) && he.StatusCode == status
+}
+
+// Helix is a client for the twitch API. Token is asked for on every request so
+// a rotated token is picked up straight away.
+type Helix struct {
+	BaseUrl    string
+	ClientId   string
+	Token      func() string
+	HTTP       *http.Client
+	MaxRetries int
+
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+func NewHelix(token func() string) *Helix {
+	return &Helix{
+		BaseUrl:    twitchHelixUrl,
+		ClientId:   secrets.ClientID,
+		Token:      token,
+		HTTP:       &http.Client{Timeout: 30 * time.Second},
+		MaxRetries: 3,
+		remaining:  -1,
+	}
+}
+
+// waitForBucket holds a request back while the rate limit bucket is empty
+func (h *Helix) waitForBucket(ctx context.Context) error {
+	h.mu.Lock()
+	wait := time.Duration(0)
+	if h.remaining == 0 {
+		wait = time.Until(h.reset)
+	}
+	h.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	fmt.Println("Helix rate limit reached, waiting", wait.Round(time.Second))
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (h *Helix) updateBucket(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	h.remaining = remaining
+	h.reset = time.Unix(reset, 0)
+	h.mu.Unlock()
+}
+
+func (h *Helix) do(ctx context.Context, method string, path string, query url.Values, body any, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	target := h.BaseUrl + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := h.waitForBucket(ctx); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Add("Authorization", "Bearer "+h.Token())
+		req.Header.Add("Client-Id", h.ClientId)
+		if body != nil {
+			req.Header.Add("Content-Type", "application/json")
+		}
+
+		res, err := h.HTTP.Do(req)
+		if err != nil {
+			return err
+		}
+		resBody, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+		h.updateBucket(res.Header)
+
+		if res.StatusCode == http.StatusTooManyRequests && attempt < h.MaxRetries {
+			h.mu.Lock()
+			h.remaining = 0
+			if h.reset.Before(time.Now()) {
+				h.reset = time.Now().Add(time.Duration(attempt+1) * time.Second)
+			}
+			h.mu.Unlock()
+			continue
+		}
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			herr := &HelixError{StatusCode: res.StatusCode, Method: method, Path: path}
+			json.Unmarshal(resBody, herr)
+			herr.StatusCode = res.StatusCode
+			if herr.ErrorName == "" {
+				herr.ErrorName = http.StatusText(res.StatusCode)
+			}
+			return herr
+		}
+
+		if out == nil || len(resBody) == 0 {
+			return nil
+		}
+		return json.Unmarshal(resBody, out)
+	}
+}
+
+type helixData[T any] struct {
+	Data       []T `json:"data"`
+	Pagination struct {
+		Cursor string `json:"cursor"`
+	} `json:"pagination"`
+}
+
+func firstOf[T any](data []T, what string) (*T, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("helix: no %s in response", what)
+	}
+	return &data[0], nil
+}
+
+// users
+
+func (h *Helix) GetUsers(ctx context.Context, logins ...string) ([]UserInfo, error) {
+	var res helixData[UserInfo]
+	err := h.do(ctx, http.MethodGet, "/users", url.Values{"login": logins}, nil, &res)
+	return res.Data, err
+}
+
+// eventsub subscriptions
+
+type SubscriptionList struct {
+	Subscriptions []Subscription
+	Total         int
+	TotalCost     int
+	MaxTotalCost  int
+}
+
+type subscriptionResponse struct {
+	helixData[Subscription]
+	Total        int `json:"total"`
+	TotalCost    int `json:"total_cost"`
+	MaxTotalCost int `json:"max_total_cost"`
+}
+
+// ListEventSubSubscriptions pages through every subscription, status and
+// subscriptionType are optional filters
+func (h *Helix) ListEventSubSubscriptions(ctx context.Context, status string, subscriptionType string) (*SubscriptionList, error) {
+	list := &SubscriptionList{}
+	cursor := ""
+	for {
+		query := url.Values{}
+		if status != "" {
+			query.Set("status", status)
+		}
+		if subscriptionType != "" {
+			query.Set("type", subscriptionType)
+		}
+		if cursor != "" {
+			query.Set("after", cursor)
+		}
+
+		var res subscriptionResponse
+		if err := h.do(ctx, http.MethodGet, "/eventsub/subscriptions", query, nil, &res); err != nil {
+			return nil, err
+		}
+		list.Subscriptions = append(list.Subscriptions, res.Data...)
+		list.Total, list.TotalCost, list.MaxTotalCost = res.Total, res.TotalCost, res.MaxTotalCost
+
+		cursor = res.Pagination.Cursor
+		if cursor == "" {
+			return list, nil
+		}
+	}
+}
+
+func (h *Helix) CreateEventSubSubscription(ctx context.Context, msg *WebsocketSubscriptionMessage) (*Subscription, *SubscriptionList, error) {
+	var res subscriptionResponse
+	if err := h.do(ctx, http.MethodPost, "/eventsub/subscriptions", nil, msg, &res); err != nil {
+		return nil, nil, err
+	}
+	sub, err := firstOf(res.Data, "subscription")
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, &SubscriptionList{Total: res.Total, TotalCost: res.TotalCost, MaxTotalCost: res.MaxTotalCost}, nil
+}
+
+func (h *Helix) DeleteEventSubSubscription(ctx context.Context, id string) error {
+	return h.do(ctx, http.MethodDelete, "/eventsub/subscriptions", url.Values{"id": {id}}, nil, nil)
+}
+
+// chat
+
+type SendChatMessageRequest struct {
+	BroadcasterId        string `json:"broadcaster_id"`
+	SenderId             string `json:"sender_id"`
+	Message              string `json:"message"`
+	ReplyParentMessageId string `json:"reply_parent_message_id,omitempty"`
+}
+
+type SentChatMessage struct {
+	MessageId  string `json:"message_id"`
+	IsSent     bool   `json:"is_sent"`
+	DropReason *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"drop_reason"`
+}
+
+func (h *Helix) SendChatMessage(ctx context.Context, msg SendChatMessageRequest) (*SentChatMessage, error) {
+	var res helixData[SentChatMessage]
+	if err := h.do(ctx, http.MethodPost, "/chat/messages", nil, msg, &res); err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "chat message")
+}
+
+// polls
+
+type CreatePollRequest struct {
+	BroadcasterId string `json:"broadcaster_id"`
+	Title         string `json:"title"`
+	Choices       []struct {
+		Title string `json:"title"`
+	} `json:"choices"`
+	Duration                   int  `json:"duration"`
+	ChannelPointsVotingEnabled bool `json:"channel_points_voting_enabled,omitempty"`
+	ChannelPointsPerVote       int  `json:"channel_points_per_vote,omitempty"`
+}
+
+func (r *CreatePollRequest) AddChoice(title string) {
+	r.Choices = append(r.Choices, struct {
+		Title string `json:"title"`
+	}{Title: title})
+}
+
+type Poll struct {
+	Id            string       `json:"id"`
+	BroadcasterId string       `json:"broadcaster_id"`
+	Title         string       `json:"title"`
+	Choices       []PollChoice `json:"choices"`
+	Status        string       `json:"status"`
+	Duration      int          `json:"duration"`
+	StartedAt     time.Time    `json:"started_at"`
+	EndedAt       time.Time    `json:"ended_at"`
+}
+
+func (h *Helix) CreatePoll(ctx context.Context, poll CreatePollRequest) (*Poll, error) {
+	var res helixData[Poll]
+	if err := h.do(ctx, http.MethodPost, "/polls", nil, poll, &res); err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "poll")
+}
+
+// EndPoll ends a poll early, status is TERMINATED to show the result or ARCHIVED to hide it
+func (h *Helix) EndPoll(ctx context.Context, broadcasterId string, id string, status string) (*Poll, error) {
+	body := map[string]string{"broadcaster_id": broadcasterId, "id": id, "status": status}
+	var res helixData[Poll]
+	if err := h.do(ctx, http.MethodPatch, "/polls", nil, body, &res); err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "poll")
+}
+
+// predictions
+
+type CreatePredictionRequest struct {
+	BroadcasterId string `json:"broadcaster_id"`
+	Title         string `json:"title"`
+	Outcomes      []struct {
+		Title string `json:"title"`
+	} `json:"outcomes"`
+	PredictionWindow int `json:"prediction_window"`
+}
+
+func (r *CreatePredictionRequest) AddOutcome(title string) {
+	r.Outcomes = append(r.Outcomes, struct {
+		Title string `json:"title"`
+	}{Title: title})
+}
+
+type Prediction struct {
+	Id               string              `json:"id"`
+	BroadcasterId    string              `json:"broadcaster_id"`
+	Title            string              `json:"title"`
+	WinningOutcomeId string              `json:"winning_outcome_id"`
+	Outcomes         []PredictionOutcome `json:"outcomes"`
+	PredictionWindow int                 `json:"prediction_window"`
+	Status           string              `json:"status"`
+	CreatedAt        time.Time           `json:"created_at"`
+	EndedAt          time.Time           `json:"ended_at"`
+	LockedAt         time.Time           `json:"locked_at"`
+}
+
+func (h *Helix) CreatePrediction(ctx context.Context, prediction CreatePredictionRequest) (*Prediction, error) {
+	var res helixData[Prediction]
+	if err := h.do(ctx, http.MethodPost, "/predictions", nil, prediction, &res); err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "prediction")
+}
+
+const (
+	PredictionResolved = "RESOLVED"
+	PredictionCanceled = "CANCELED"
+	PredictionLocked   = "LOCKED"
+)
+
+// EndPrediction resolves (RESOLVED with a winning outcome), cancels (CANCELED,
+// refunding everyone) or locks (LOCKED) a prediction
+func (h *Helix) EndPrediction(ctx context.Context, broadcasterId string, id string, status string, winningOutcomeId string) (*Prediction, error) {
+	body := map[string]string{"broadcaster_id": broadcasterId, "id": id, "status": status}
+	if winningOutcomeId != "" {
+		body["winning_outcome_id"] = winningOutcomeId
+	}
+	var res helixData[Prediction]
+	if err := h.do(ctx, http.MethodPatch, "/predictions", nil, body, &res); err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "prediction")
+}
+
+// custom rewards and redemptions
+
+type CustomReward struct {
+	Id                  string `json:"id"`
+	BroadcasterId       string `json:"broadcaster_id"`
+	Title               string `json:"title"`
+	Prompt              string `json:"prompt"`
+	Cost                int    `json:"cost"`
+	IsEnabled           bool   `json:"is_enabled"`
+	IsUserInputRequired bool   `json:"is_user_input_required"`
+	IsPaused            bool   `json:"is_paused"`
+}
+
+type CreateCustomRewardRequest struct {
+	Title                             string `json:"title"`
+	Cost                              int    `json:"cost"`
+	Prompt                            string `json:"prompt,omitempty"`
+	IsEnabled                         bool   `json:"is_enabled"`
+	IsGlobalCooldownEnabled           bool   `json:"is_global_cooldown_enabled,omitempty"`
+	GlobalCooldownSeconds             int    `json:"global_cooldown_seconds,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool   `json:"should_redemptions_skip_request_queue"`
+}
+
+// GetCustomRewards lists the channel's rewards, onlyManageable limits it to the
+// ones we created and can therefore fulfill or refund
+func (h *Helix) GetCustomRewards(ctx context.Context, broadcasterId string, onlyManageable bool) ([]CustomReward, error) {
+	query := url.Values{"broadcaster_id": {broadcasterId}}
+	if onlyManageable {
+		query.Set("only_manageable_rewards", "true")
+	}
+	var res helixData[CustomReward]
+	err := h.do(ctx, http.MethodGet, "/channel_points/custom_rewards", query, nil, &res)
+	return res.Data, err
+}
+
+func (h *Helix) CreateCustomReward(ctx context.Context, broadcasterId string, reward CreateCustomRewardRequest) (*CustomReward, error) {
+	var res helixData[CustomReward]
+	err := h.do(ctx, http.MethodPost, "/channel_points/custom_rewards", url.Values{"broadcaster_id": {broadcasterId}}, reward, &res)
+	if err != nil {
+		return nil, err
+	}
+	return firstOf(res.Data, "custom reward")
+}
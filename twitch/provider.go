@@ -0,0 +1,139 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"minecraftgo/input"
+)
+
+// Provider turns EventSub notifications into input events. Replies go to chat
+// through Sender, redemptions are fulfilled or refunded through Helix.
+type Provider struct {
+	Events <-chan EventSubEvent
+	Sender *ChatSender
+	Helix  *Helix
+	// ids of the rewards we created, helix refuses to complete any other
+	Rewards map[string]bool
+}
+
+func NewProvider(events <-chan EventSubEvent, sender *ChatSender, helix *Helix) *Provider {
+	return &Provider{Events: events, Sender: sender, Helix: helix, Rewards: map[string]bool{}}
+}
+
+func (p *Provider) Name() string {
+	return "twitch"
+}
+
+func (p *Provider) Run(ctx context.Context, events chan<- input.Event) error {
+	for {
+		var msg EventSubEvent
+		var ok bool
+		select {
+		case msg, ok = <-p.Events:
+			if !ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		switch msg := msg.(type) {
+		case RevocationEvent:
+			fmt.Println("!! Lost subscription", msg.Subscription.Type, msg.Subscription.Status)
+		case NotificationEvent:
+			decoded, err := msg.Decode()
+			if err != nil {
+				fmt.Println("!! Could not decode", msg.SubscriptionType, err)
+				continue
+			}
+			select {
+			case events <- toInput(decoded):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+func viewer(u User) input.Viewer {
+	return input.Viewer{Id: u.UserId, Login: u.UserLogin, Name: u.UserName}
+}
+
+// toInput maps the events the game knows about, the rest go through as Other
+func toInput(decoded any) input.Event {
+	ev := input.Event{Kind: input.Other, Raw: decoded}
+	switch e := decoded.(type) {
+	case *ChatMessageEvent:
+		ev.Kind = input.Chat
+		ev.Viewer = input.Viewer{Id: e.ChatterUserId, Login: e.ChatterUserLogin, Name: e.ChatterUserName}
+		for _, b := range e.Badges {
+			ev.Viewer.Badges = append(ev.Viewer.Badges, b.SetId)
+		}
+		ev.What = e.Message.Text
+		ev.MessageId = e.MessageId
+	case *RedemptionEvent:
+		// updates to a redemption are not something to act on again
+		if e.Status != "" && e.Status != "unfulfilled" {
+			break
+		}
+		ev.Kind = input.Redemption
+		ev.Viewer = viewer(e.User)
+		ev.What = e.Reward.Title
+		ev.RewardId = e.Reward.Id
+		ev.Amount = e.Reward.Cost
+	case *CheerEvent:
+		ev.Kind = input.Cheer
+		ev.Viewer = viewer(e.User)
+		ev.What = e.Message
+		ev.Amount = e.Bits
+	case *SubscribeEvent:
+		// gifted subs are handled once, by the gift event
+		if e.IsGift {
+			break
+		}
+		ev.Kind = input.Subscribe
+		ev.Viewer = viewer(e.User)
+		ev.Amount = 1
+		ev.Tier = e.Tier
+	case *SubscriptionMessageEvent:
+		ev.Kind = input.Resub
+		ev.Viewer = viewer(e.User)
+		ev.What = e.Message.Text
+		ev.Amount = e.CumulativeMonths
+		ev.Tier = e.Tier
+	case *SubscriptionGiftEvent:
+		ev.Kind = input.GiftSub
+		ev.Viewer = viewer(e.User)
+		ev.Amount = e.Total
+		ev.Tier = e.Tier
+	}
+	return ev
+}
+
+// Reply threads under chat messages and sends everything else to chat as is
+func (p *Provider) Reply(ev input.Event, text string) {
+	if ev.MessageId != "" {
+		p.Sender.Reply(ev.MessageId, text)
+		return
+	}
+	p.Sender.Send(text)
+}
+
+// Complete fulfills a redemption that worked and refunds one that didn't
+func (p *Provider) Complete(ev input.Event, ok bool) {
+	redemption, isRedemption := ev.Raw.(*RedemptionEvent)
+	if !isRedemption {
+		return
+	}
+	if !p.Rewards[redemption.Reward.Id] || redemption.Status != "unfulfilled" {
+		fmt.Println("!! Redemption", redemption.Reward.Title, "is", redemption.Status, "and can't be fulfilled or refunded by us")
+		return
+	}
+	status := RedemptionFulfilled
+	if !ok {
+		status = RedemptionCanceled
+	}
+	if err := p.Helix.UpdateRedemptionStatus(context.Background(), redemption, status); err != nil {
+		fmt.Println("!!", err)
+	}
+}
@@ -0,0 +1,141 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	SubscriptionEnabled             = "enabled"
+	SubscriptionVerificationPending = "webhook_callback_verification_pending"
+)
+
+type DesiredSubscription struct {
+	Type string
+	// "1" when empty
+	Version   string
+	Condition Condition
+}
+
+func (d DesiredSubscription) version() string {
+	if d.Version == "" {
+		return "1"
+	}
+	return d.Version
+}
+
+// Reconciler makes the subscriptions on twitch match Desired: it keeps what
+// is already there, creates what is missing and deletes what is stale or broken
+type Reconciler struct {
+	Helix   *Helix
+	Desired []DesiredSubscription
+	// replace the subscriptions to the transport even if they look right,
+	// for webhooks whose secret changed
+	Recreate bool
+}
+
+// conditionMap turns a Condition into the map twitch sends back, without the
+// fields that aren't set
+func conditionMap(c Condition) map[string]string {
+	data, _ := json.Marshal(c)
+	m := map[string]string{}
+	json.Unmarshal(data, &m)
+	return m
+}
+
+func sameCondition(a map[string]string, b map[string]string) bool {
+	count := 0
+	for k, v := range a {
+		if v == "" {
+			continue
+		}
+		if b[k] != v {
+			return false
+		}
+		count++
+	}
+	for _, v := range b {
+		if v != "" {
+			count--
+		}
+	}
+	return count == 0
+}
+
+// sameTransport reports whether sub delivers to transport, the webhook secret
+// is never sent back so it can't be compared, see WebhookSecretFile
+func sameTransport(sub Subscription, transport Transport) bool {
+	if sub.Transport.Method != transport.Method {
+		return false
+	}
+	if transport.Method == "webhook" {
+		return sub.Transport.Callback == transport.Callback
+	}
+	return sub.Transport.SessionId == transport.SessionId
+}
+
+// Reconcile brings the subscriptions for transport in line, a websocket
+// transport has to carry the id of the session just welcomed
+func (r *Reconciler) Reconcile(ctx context.Context, transport Transport) error {
+	list, err := r.Helix.ListEventSubSubscriptions(ctx, "", "")
+	if err != nil {
+		return fmt.Errorf("listing subscriptions: %w", err)
+	}
+
+	cost := &SubscriptionList{Total: list.Total, TotalCost: list.TotalCost, MaxTotalCost: list.MaxTotalCost}
+	satisfied := make([]bool, len(r.Desired))
+	for _, sub := range list.Subscriptions {
+		healthy := sub.Status == SubscriptionEnabled || sub.Status == SubscriptionVerificationPending
+		if healthy && !sameTransport(sub, transport) {
+			// someone else's, e.g. the other websocket session
+			continue
+		}
+
+		wanted := -1
+		for idx, d := range r.Desired {
+			if !satisfied[idx] && d.Type == sub.Type && d.version() == sub.Version && sameCondition(conditionMap(d.Condition), sub.Condition) {
+				wanted = idx
+				break
+			}
+		}
+		if healthy && wanted >= 0 && !r.Recreate {
+			satisfied[wanted] = true
+			continue
+		}
+
+		fmt.Println("Deleting subscription", sub.Type, sub.Status)
+		if err := r.Helix.DeleteEventSubSubscription(ctx, sub.Id); err != nil && !IsHelixStatus(err, http.StatusNotFound) {
+			return fmt.Errorf("deleting subscription %s: %w", sub.Id, err)
+		}
+		cost.Total--
+		cost.TotalCost -= sub.Cost
+	}
+
+	for idx, d := range r.Desired {
+		if satisfied[idx] {
+			continue
+		}
+		msg := &WebsocketSubscriptionMessage{Type: d.Type, Version: d.version(), Transport: transport, Condition: d.Condition}
+		sub, latest, err := r.Helix.CreateEventSubSubscription(ctx, msg)
+		if IsHelixStatus(err, http.StatusConflict) {
+			fmt.Println("Subscription to", d.Type, "already exists")
+			continue
+		}
+		if IsHelixStatus(err, http.StatusTooManyRequests) {
+			return fmt.Errorf("subscribing to %s: subscription cost limit of %d reached", d.Type, cost.MaxTotalCost)
+		}
+		if err != nil {
+			return fmt.Errorf("subscribing to %s: %w", d.Type, err)
+		}
+		fmt.Println("Subscription to", d.Type, ":", sub.Status)
+		cost = latest
+	}
+
+	fmt.Println("Subscriptions cost", cost.TotalCost, "of", cost.MaxTotalCost)
+	if cost.MaxTotalCost > 0 && cost.TotalCost*10 >= cost.MaxTotalCost*9 {
+		fmt.Println("!! Subscriptions are close to the cost limit")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	RedemptionFulfilled = "FULFILLED"
+	RedemptionCanceled  = "CANCELED"
+)
+
+// UpdateRedemptionStatus marks a redemption as FULFILLED, or CANCELED which
+// refunds the viewer's points. Only works for rewards created with our client
+// id and needs the channel:manage:redemptions scope.
+func (h *Helix) UpdateRedemptionStatus(ctx context.Context, redemption *RedemptionEvent, status string) error {
+	query := url.Values{
+		"id":             {redemption.Id},
+		"broadcaster_id": {redemption.BroadcasterUserId},
+		"reward_id":      {redemption.Reward.Id},
+	}
+	err := h.do(ctx, http.MethodPatch, "/channel_points/custom_rewards/redemptions", query, map[string]string{"status": status}, nil)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Redemption", redemption.Reward.Title, "for", redemption.UserName, status)
+	return nil
+}
+
+// EnsureCustomRewards makes sure every wanted reward exists and was created
+// with our client id, the only kind whose redemptions we may fulfill or
+// refund. Rewards left from an earlier run are found by title and kept as they
+// are, missing ones are created. A title taken by a reward made elsewhere,
+// e.g. on the dashboard, can't be created and is reported.
+func (h *Helix) EnsureCustomRewards(ctx context.Context, broadcasterId string, wanted []CreateCustomRewardRequest) ([]CustomReward, error) {
+	existing, err := h.GetCustomRewards(ctx, broadcasterId, true)
+	if err != nil {
+		return nil, err
+	}
+	byTitle := map[string]CustomReward{}
+	for _, reward := range existing {
+		byTitle[strings.ToLower(reward.Title)] = reward
+	}
+
+	var rewards []CustomReward
+	var errs []error
+	for _, want := range wanted {
+		if reward, ok := byTitle[strings.ToLower(want.Title)]; ok {
+			rewards = append(rewards, reward)
+			continue
+		}
+		reward, err := h.CreateCustomReward(ctx, broadcasterId, want)
+		if IsHelixStatus(err, http.StatusBadRequest) && strings.Contains(err.Error(), "DUPLICATE") {
+			err = fmt.Errorf("a reward called %q already exists but was made outside this app, delete it so it can be created: %w", want.Title, err)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		fmt.Println("Created reward", reward.Title, "for", reward.Cost, "points")
+		rewards = append(rewards, *reward)
+	}
+	return rewards, errors.Join(errs...)
+}
@@ -0,0 +1,56 @@
+package twitch_test
+
+import (
+	"context"
+	"minecraftgo/twitch"
+	"minecraftgo/twitch/twitchmock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func mockHelix(t *testing.T) (*twitchmock.Server, *twitch.Helix) {
+	t.Helper()
+	mock := twitchmock.NewServer()
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+
+	token := mock.Token("streamer")
+	helix := twitch.NewHelix(func() string { return token })
+	helix.BaseUrl = twitchmock.Endpoints(srv.URL).HelixUrl()
+	helix.ClientId = "test"
+	return mock, helix
+}
+
+func TestEnsureCustomRewards(t *testing.T) {
+	mock, helix := mockHelix(t)
+	ctx := context.Background()
+	wanted := []twitch.CreateCustomRewardRequest{{Title: "Butterfingers", Cost: 500}, {Title: "Make it rain", Cost: 300}}
+
+	created, err := helix.EnsureCustomRewards(ctx, "1", wanted)
+	if err != nil || len(created) != 2 {
+		t.Fatalf("created %v: %v", created, err)
+	}
+	// the next run finds them again instead of making new ones
+	again, err := helix.EnsureCustomRewards(ctx, "1", append(wanted, twitch.CreateCustomRewardRequest{Title: "Hard mode", Cost: 1000}))
+	if err != nil || len(again) != 3 {
+		t.Fatalf("got %v: %v", again, err)
+	}
+	if again[0].Id != created[0].Id || again[1].Id != created[1].Id {
+		t.Errorf("rewards were made again: %v then %v", created, again)
+	}
+	if rewards := mock.Rewards(); len(rewards) != 3 {
+		t.Errorf("mock has %d rewards, want 3", len(rewards))
+	}
+}
+
+func TestRedemptionOfForeignReward(t *testing.T) {
+	_, helix := mockHelix(t)
+	redemption := &twitch.RedemptionEvent{Id: "r1", Status: "unfulfilled"}
+	redemption.Reward.Id = "made-on-the-dashboard"
+
+	err := helix.UpdateRedemptionStatus(context.Background(), redemption, twitch.RedemptionCanceled)
+	if !twitch.IsHelixStatus(err, http.StatusForbidden) {
+		t.Errorf("expected a 403, got %v", err)
+	}
+}
@@ -0,0 +1,439 @@
+package twitch
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"minecraftgo/secrets"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// refresh this long before twitch says the token expires
+	refreshMargin    = 5 * time.Minute
+	validateInterval = time.Hour
+	// longest wait between tries after upkeep failed
+	maxUpkeepBackoff = 30 * time.Minute
+)
+
+var (
+	ErrNoToken      = errors.New("no stored token")
+	ErrInvalidToken = errors.New("token is no longer valid")
+	// the refresh token or code was turned down, only a new login helps
+	ErrInvalidGrant = errors.New("invalid grant")
+)
+
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Scopes       []string  `json:"scopes"`
+	Login        string    `json:"login"`
+	UserId       string    `json:"user_id"`
+}
+
+type validateResponse struct {
+	ClientId  string   `json:"client_id"`
+	Login     string   `json:"login"`
+	Scopes    []string `json:"scopes"`
+	UserId    string   `json:"user_id"`
+	ExpiresIn int      `json:"expires_in"`
+}
+
+// TokenStore keeps the token on disk encrypted with a key kept next to it,
+// so a copied token file alone is useless
+type TokenStore struct {
+	Path    string
+	KeyPath string
+}
+
+func NewTokenStore(path string, keyPath string) *TokenStore {
+	return &TokenStore{Path: path, KeyPath: keyPath}
+}
+
+func (ts *TokenStore) key() ([]byte, error) {
+	key, err := os.ReadFile(ts.KeyPath)
+	if err == nil {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("token key %s: expected 32 bytes, got %d", ts.KeyPath, len(key))
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, os.WriteFile(ts.KeyPath, key, 0600)
+}
+
+func (ts *TokenStore) gcm() (cipher.AEAD, error) {
+	key, err := ts.key()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (ts *TokenStore) Save(token *Token) error {
+	gcm, err := ts.gcm()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	return os.WriteFile(ts.Path, gcm.Seal(nonce, nonce, data, nil), 0600)
+}
+
+func (ts *TokenStore) Load() (*Token, error) {
+	sealed, err := os.ReadFile(ts.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := ts.gcm()
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("token file %s is corrupt", ts.Path)
+	}
+	data, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting token: %w", err)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// TokenManager owns the user token: it validates it hourly as twitch asks,
+// refreshes it before it expires and tells subscribers whenever it changes
+type TokenManager struct {
+	// oauth2 base url, see Endpoints
+	AuthUrl     string
+	app         bool
+	store       *TokenStore
+	mu          sync.Mutex
+	token       *Token
+	subscribers []func(Token)
+	failures    []func(error)
+}
+
+func NewTokenManager(store *TokenStore) *TokenManager {
+	return &TokenManager{AuthUrl: twitchOAuthUrl, store: store}
+}
+
+// NewAppTokenManager looks after an app access token from the client
+// credentials grant, used for webhook subscriptions. It isn't stored since a
+// new one is only a request away.
+func NewAppTokenManager() *TokenManager {
+	return &TokenManager{AuthUrl: twitchOAuthUrl, app: true}
+}
+
+func (tm *TokenManager) AccessToken() string {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	if tm.token == nil {
+		return ""
+	}
+	return tm.token.AccessToken
+}
+
+func (tm *TokenManager) Token() (Token, bool) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	if tm.token == nil {
+		return Token{}, false
+	}
+	return *tm.token, true
+}
+
+// Subscribe registers fn to be called with every new token
+func (tm *TokenManager) Subscribe(fn func(Token)) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.subscribers = append(tm.subscribers, fn)
+}
+
+// SubscribeFailure registers fn to be called when upkeep gives up on the
+// token, e.g. because it was revoked
+func (tm *TokenManager) SubscribeFailure(fn func(error)) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.failures = append(tm.failures, fn)
+}
+
+func (tm *TokenManager) fail(err error) {
+	tm.mu.Lock()
+	failures := append([]func(error){}, tm.failures...)
+	tm.mu.Unlock()
+	for _, fn := range failures {
+		fn(err)
+	}
+}
+
+// HasToken reports whether there is a token to start with, in memory or on
+// disk, without asking twitch whether it still works
+func (tm *TokenManager) HasToken() bool {
+	if _, ok := tm.Token(); ok {
+		return true
+	}
+	if tm.store == nil {
+		return false
+	}
+	_, err := tm.store.Load()
+	return err == nil
+}
+
+// Exchange trades an authorization code from the oauth redirect for a token
+func (tm *TokenManager) Exchange(code string, redirectUri string) error {
+	token, err := tm.requestToken(url.Values{
+		"client_id":     {secrets.ClientID},
+		"client_secret": {secrets.ClientSecret},
+		"code":          {code},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {redirectUri},
+	})
+	if err != nil {
+		return err
+	}
+	return tm.rotate(token)
+}
+
+// Load picks up the stored token, refreshing it if it no longer validates
+func (tm *TokenManager) Load() error {
+	if tm.app {
+		return tm.Refresh()
+	}
+	token, err := tm.store.Load()
+	if err != nil {
+		return err
+	}
+
+	tm.mu.Lock()
+	tm.token = token
+	tm.mu.Unlock()
+
+	return tm.Validate()
+}
+
+// Validate asks twitch about the token and keeps what it says, refreshing the
+// token if it no longer works
+func (tm *TokenManager) Validate() error {
+	token, ok := tm.Token()
+	if !ok {
+		return ErrNoToken
+	}
+
+	info, err := tm.validateToken(token.AccessToken)
+	if errors.Is(err, ErrInvalidToken) {
+		fmt.Println("Token no longer valid, refreshing")
+		return tm.Refresh()
+	}
+	if err != nil {
+		return err
+	}
+
+	tm.mu.Lock()
+	tm.token.Login = info.Login
+	tm.token.UserId = info.UserId
+	tm.token.Scopes = info.Scopes
+	tm.token.ExpiresAt = time.Now().Add(time.Duration(info.ExpiresIn) * time.Second)
+	updated := *tm.token
+	tm.mu.Unlock()
+
+	// the access token is the same, so subscribers aren't told, but the
+	// stored expiry and scopes would be stale
+	if tm.store != nil {
+		if err := tm.store.Save(&updated); err != nil {
+			fmt.Println("problem saving token", err)
+		}
+	}
+	return nil
+}
+
+func (tm *TokenManager) Refresh() error {
+	if tm.app {
+		token, err := tm.requestToken(url.Values{
+			"client_id":     {secrets.ClientID},
+			"client_secret": {secrets.ClientSecret},
+			"grant_type":    {"client_credentials"},
+		})
+		if err != nil {
+			return err
+		}
+		return tm.rotate(token)
+	}
+
+	old, ok := tm.Token()
+	if !ok || old.RefreshToken == "" {
+		return ErrNoToken
+	}
+
+	token, err := tm.requestToken(url.Values{
+		"client_id":     {secrets.ClientID},
+		"client_secret": {secrets.ClientSecret},
+		"refresh_token": {old.RefreshToken},
+		"grant_type":    {"refresh_token"},
+	})
+	if err != nil {
+		return err
+	}
+	return tm.rotate(token)
+}
+
+func (tm *TokenManager) rotate(token *Token) error {
+	if info, err := tm.validateToken(token.AccessToken); err == nil {
+		token.Login = info.Login
+		token.UserId = info.UserId
+	}
+
+	tm.mu.Lock()
+	tm.token = token
+	subscribers := append([]func(Token){}, tm.subscribers...)
+	tm.mu.Unlock()
+
+	if tm.store != nil {
+		if err := tm.store.Save(token); err != nil {
+			fmt.Println("problem saving token", err)
+		}
+	}
+	for _, fn := range subscribers {
+		fn(*token)
+	}
+	return nil
+}
+
+// Run validates hourly and refreshes ahead of expiry until ctx is done or
+// twitch turns the refresh token down, failed tries are repeated with backoff
+func (tm *TokenManager) Run(ctx context.Context) {
+	validate := time.NewTicker(validateInterval)
+	defer validate.Stop()
+
+	var backoff time.Duration
+	for {
+		token, _ := tm.Token()
+		wait := time.Until(token.ExpiresAt) - refreshMargin
+		if token.ExpiresAt.IsZero() {
+			wait = validateInterval
+		}
+		if backoff > 0 {
+			wait = backoff
+		}
+		refresh := time.NewTimer(max(wait, time.Second))
+
+		var err error
+		select {
+		case <-ctx.Done():
+			refresh.Stop()
+			return
+		case <-validate.C:
+			err = tm.Validate()
+		case <-refresh.C:
+			err = tm.Refresh()
+		}
+		refresh.Stop()
+
+		if err == nil {
+			backoff = 0
+			continue
+		}
+		if errors.Is(err, ErrInvalidGrant) {
+			fmt.Println("!! Token for", token.Login, "can't be refreshed anymore, log in again", err)
+			tm.fail(err)
+			return
+		}
+		backoff = min(max(backoff*2, 10*time.Second), maxUpkeepBackoff)
+		fmt.Println("Token upkeep failed, trying again in", backoff, err)
+	}
+}
+
+func (tm *TokenManager) requestToken(form url.Values) (*Token, error) {
+	client := http.Client{Timeout: 30 * time.Second}
+	res, err := client.PostForm(tm.AuthUrl+"/token", form)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	// a bad code or refresh token, unlike a bad client, won't get any better
+	if (res.StatusCode == http.StatusBadRequest || res.StatusCode == http.StatusUnauthorized) && form.Get("grant_type") != "client_credentials" {
+		return nil, fmt.Errorf("%w: token request %s: %s", ErrInvalidGrant, res.Status, string(resBody))
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request %s: %s", res.Status, string(resBody))
+	}
+
+	var authRes AuthResponse
+	if err := json.Unmarshal(resBody, &authRes); err != nil {
+		return nil, err
+	}
+	return &Token{
+		AccessToken:  authRes.AccessToken,
+		RefreshToken: authRes.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(authRes.ExpiresIn) * time.Second),
+		Scopes:       authRes.Scope,
+	}, nil
+}
+
+func (tm *TokenManager) validateToken(accessToken string) (*validateResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, tm.AuthUrl+"/validate", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "OAuth "+accessToken)
+
+	client := http.Client{Timeout: 30 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return nil, ErrInvalidToken
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("validating token: %s", res.Status)
+	}
+
+	var info validateResponse
+	if err := json.NewDecoder(res.Body).Decode(&info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
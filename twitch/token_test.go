@@ -0,0 +1,35 @@
+package twitch_test
+
+import (
+	"minecraftgo/twitch"
+	"minecraftgo/twitch/twitchmock"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateSavesToken(t *testing.T) {
+	mock := twitchmock.NewServer()
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	store := twitch.NewTokenStore(filepath.Join(dir, "token.dat"), filepath.Join(dir, "token.key"))
+	if err := store.Save(&twitch.Token{AccessToken: mock.Token("streamer")}); err != nil {
+		t.Fatal(err)
+	}
+	tokens := twitch.NewTokenManager(store)
+	tokens.AuthUrl = twitchmock.Endpoints(srv.URL).OAuthUrl()
+	if err := tokens.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	// what validate learned is there for the next run
+	saved, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Login != "streamer" || saved.ExpiresAt.IsZero() {
+		t.Errorf("stored token wasn't updated: %+v", saved)
+	}
+}
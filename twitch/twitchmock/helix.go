@@ -0,0 +1,332 @@
+package twitchmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"minecraftgo/twitch"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func writeJSON(res http.ResponseWriter, status int, body any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(body)
+}
+
+func writeError(res http.ResponseWriter, status int, message string) {
+	writeJSON(res, status, map[string]any{"error": http.StatusText(status), "status": status, "message": message})
+}
+
+func data(items ...any) map[string]any {
+	return map[string]any{"data": items}
+}
+
+// helix checks the headers every helix call needs and keeps the rate limit
+// bucket, like the real api it answers 429 once the bucket is empty
+func (s *Server) helix(next func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		s.mu.Lock()
+		g, known := s.tokens[token]
+		now := time.Now()
+		if now.After(s.reset) {
+			s.remaining = rateLimit
+			s.reset = now.Add(time.Minute)
+		}
+		limited := s.remaining == 0
+		if !limited {
+			s.remaining--
+		}
+		res.Header().Set("Ratelimit-Limit", strconv.Itoa(rateLimit))
+		res.Header().Set("Ratelimit-Remaining", strconv.Itoa(s.remaining))
+		res.Header().Set("Ratelimit-Reset", strconv.FormatInt(s.reset.Unix(), 10))
+		s.mu.Unlock()
+
+		switch {
+		case req.Header.Get("Client-Id") == "":
+			writeError(res, http.StatusUnauthorized, "Client-Id header required")
+		case !ok || !known:
+			writeError(res, http.StatusUnauthorized, "Invalid OAuth token")
+		case limited:
+			writeError(res, http.StatusTooManyRequests, "Too Many Requests")
+		default:
+			next(res, req, g.login)
+		}
+	}
+}
+
+func (s *Server) handleUsers(res http.ResponseWriter, req *http.Request, login string) {
+	logins := req.URL.Query()["login"]
+	if len(logins) == 0 {
+		logins = []string{login}
+	}
+	users := make([]any, 0, len(logins))
+	for _, l := range logins {
+		users = append(users, s.User(l))
+	}
+	writeJSON(res, http.StatusOK, data(users...))
+}
+
+func (s *Server) subscriptionTotals() map[string]any {
+	total, cost := 0, 0
+	for _, sub := range s.subscriptions {
+		total++
+		cost += sub.Cost
+	}
+	return map[string]any{"total": total, "total_cost": cost, "max_total_cost": 10000}
+}
+
+func (s *Server) handleListSubscriptions(res http.ResponseWriter, req *http.Request, login string) {
+	status, subscriptionType := req.URL.Query().Get("status"), req.URL.Query().Get("type")
+
+	s.mu.Lock()
+	body := s.subscriptionTotals()
+	subs := []any{}
+	for _, sub := range s.subscriptions {
+		if (status == "" || sub.Status == status) && (subscriptionType == "" || sub.Type == subscriptionType) {
+			subs = append(subs, *sub)
+		}
+	}
+	s.mu.Unlock()
+
+	body["data"] = subs
+	body["pagination"] = map[string]any{}
+	writeJSON(res, http.StatusOK, body)
+}
+
+func (s *Server) handleCreateSubscription(res http.ResponseWriter, req *http.Request, login string) {
+	var msg struct {
+		Type      string            `json:"type"`
+		Version   string            `json:"version"`
+		Condition map[string]string `json:"condition"`
+		Transport twitch.Transport  `json:"transport"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&msg); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	if msg.Transport.Method != "websocket" {
+		writeError(res, http.StatusBadRequest, "only websocket transport is mocked")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sessions[msg.Transport.SessionId]; !ok {
+		writeError(res, http.StatusBadRequest, "websocket transport session does not exist or has already disconnected")
+		return
+	}
+	for _, sub := range s.subscriptions {
+		if sub.Type == msg.Type && sub.Transport.SessionId == msg.Transport.SessionId &&
+			fmt.Sprint(sub.Condition) == fmt.Sprint(msg.Condition) {
+			writeError(res, http.StatusConflict, "subscription already exists")
+			return
+		}
+	}
+
+	sub := &twitch.Subscription{
+		Id:        uuid.NewString(),
+		Status:    "enabled",
+		Type:      msg.Type,
+		Version:   msg.Version,
+		Condition: msg.Condition,
+		Transport: msg.Transport,
+		CreatedAt: time.Now().UTC(),
+	}
+	s.subscriptions[sub.Id] = sub
+
+	body := s.subscriptionTotals()
+	body["data"] = []any{*sub}
+	writeJSON(res, http.StatusAccepted, body)
+}
+
+func (s *Server) handleDeleteSubscription(res http.ResponseWriter, req *http.Request, login string) {
+	id := req.URL.Query().Get("id")
+	s.mu.Lock()
+	_, ok := s.subscriptions[id]
+	delete(s.subscriptions, id)
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(res, http.StatusNotFound, "subscription not found")
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleChatMessage(res http.ResponseWriter, req *http.Request, login string) {
+	var msg twitch.SendChatMessageRequest
+	if err := json.NewDecoder(req.Body).Decode(&msg); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	fmt.Println("twitchmock chat:", msg.Message)
+
+	s.mu.Lock()
+	s.chat = append(s.chat, msg)
+	s.mu.Unlock()
+	writeJSON(res, http.StatusOK, data(map[string]any{"message_id": uuid.NewString(), "is_sent": true}))
+}
+
+func (s *Server) handleCreatePoll(res http.ResponseWriter, req *http.Request, login string) {
+	var body twitch.CreatePollRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	poll := &twitch.Poll{
+		Id:            uuid.NewString(),
+		BroadcasterId: body.BroadcasterId,
+		Title:         body.Title,
+		Status:        "ACTIVE",
+		Duration:      body.Duration,
+		StartedAt:     time.Now().UTC(),
+	}
+	for _, c := range body.Choices {
+		poll.Choices = append(poll.Choices, twitch.PollChoice{Id: uuid.NewString(), Title: c.Title})
+	}
+	s.mu.Lock()
+	s.polls[poll.Id] = poll
+	s.mu.Unlock()
+	writeJSON(res, http.StatusOK, data(*poll))
+}
+
+func (s *Server) handleEndPoll(res http.ResponseWriter, req *http.Request, login string) {
+	var body struct {
+		Id     string `json:"id"`
+		Status string `json:"status"`
+	}
+	json.NewDecoder(req.Body).Decode(&body)
+
+	s.mu.Lock()
+	poll, ok := s.polls[body.Id]
+	if ok {
+		poll.Status = body.Status
+		poll.EndedAt = time.Now().UTC()
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeError(res, http.StatusNotFound, "poll not found")
+		return
+	}
+	writeJSON(res, http.StatusOK, data(*poll))
+}
+
+// Poll returns a poll the bot created, for injecting progress and end events
+func (s *Server) Poll(id string) (twitch.Poll, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	poll, ok := s.polls[id]
+	if !ok {
+		return twitch.Poll{}, false
+	}
+	return *poll, true
+}
+
+func (s *Server) handleCreatePrediction(res http.ResponseWriter, req *http.Request, login string) {
+	var body twitch.CreatePredictionRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	prediction := &twitch.Prediction{
+		Id:               uuid.NewString(),
+		BroadcasterId:    body.BroadcasterId,
+		Title:            body.Title,
+		PredictionWindow: body.PredictionWindow,
+		Status:           "ACTIVE",
+		CreatedAt:        time.Now().UTC(),
+	}
+	for _, o := range body.Outcomes {
+		prediction.Outcomes = append(prediction.Outcomes, twitch.PredictionOutcome{Id: uuid.NewString(), Title: o.Title})
+	}
+	s.mu.Lock()
+	s.predictions[prediction.Id] = prediction
+	s.mu.Unlock()
+	writeJSON(res, http.StatusOK, data(*prediction))
+}
+
+func (s *Server) handleEndPrediction(res http.ResponseWriter, req *http.Request, login string) {
+	var body struct {
+		Id               string `json:"id"`
+		Status           string `json:"status"`
+		WinningOutcomeId string `json:"winning_outcome_id"`
+	}
+	json.NewDecoder(req.Body).Decode(&body)
+
+	s.mu.Lock()
+	prediction, ok := s.predictions[body.Id]
+	if ok {
+		prediction.Status = body.Status
+		prediction.WinningOutcomeId = body.WinningOutcomeId
+		prediction.EndedAt = time.Now().UTC()
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeError(res, http.StatusNotFound, "prediction not found")
+		return
+	}
+	writeJSON(res, http.StatusOK, data(*prediction))
+}
+
+// every reward in the mock was created by the bot, so all of them are manageable
+func (s *Server) handleListRewards(res http.ResponseWriter, req *http.Request, login string) {
+	s.mu.Lock()
+	rewards := []any{}
+	for _, r := range s.rewards {
+		rewards = append(rewards, *r)
+	}
+	s.mu.Unlock()
+	writeJSON(res, http.StatusOK, data(rewards...))
+}
+
+func (s *Server) handleCreateReward(res http.ResponseWriter, req *http.Request, login string) {
+	var body twitch.CreateCustomRewardRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.rewards {
+		if strings.EqualFold(r.Title, body.Title) {
+			writeError(res, http.StatusBadRequest, "CREATE_CUSTOM_REWARD_DUPLICATE_REWARD")
+			return
+		}
+	}
+	reward := &twitch.CustomReward{
+		Id:            uuid.NewString(),
+		BroadcasterId: req.URL.Query().Get("broadcaster_id"),
+		Title:         body.Title,
+		Prompt:        body.Prompt,
+		Cost:          body.Cost,
+		IsEnabled:     body.IsEnabled,
+	}
+	s.rewards[reward.Id] = reward
+	writeJSON(res, http.StatusOK, data(*reward))
+}
+
+// handleRedemption answers 403 for rewards the bot didn't create, like helix
+func (s *Server) handleRedemption(res http.ResponseWriter, req *http.Request, login string) {
+	var body struct {
+		Status string `json:"status"`
+	}
+	json.NewDecoder(req.Body).Decode(&body)
+	s.mu.Lock()
+	_, ours := s.rewards[req.URL.Query().Get("reward_id")]
+	s.mu.Unlock()
+	if !ours {
+		writeError(res, http.StatusForbidden, "The ID in header Client-Id must match the client ID used to create the custom reward.")
+		return
+	}
+	fmt.Println("twitchmock redemption", req.URL.Query().Get("id"), body.Status)
+	writeJSON(res, http.StatusOK, data(map[string]any{"id": req.URL.Query().Get("id"), "status": body.Status}))
+}
@@ -0,0 +1,126 @@
+package twitchmock
+
+import (
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// tokens never run out in the mock, but the bot still sees a realistic lifetime
+const tokenLifetime = 4 * 60 * 60
+
+// handleAuthorize stands in for twitch's login page, it asks which account to
+// log in as so the broadcaster and a bot can both sign in
+func (s *Server) handleAuthorize(res http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	page := `<html><body><form action="approve">
+<input type="hidden" name="redirect_uri" value="` + html.EscapeString(q.Get("redirect_uri")) + `">
+<input type="hidden" name="scope" value="` + html.EscapeString(q.Get("scope")) + `">
+<input type="hidden" name="state" value="` + html.EscapeString(q.Get("state")) + `">
+Log in as <input name="login" value="` + html.EscapeString(s.DefaultLogin) + `"> <button>Authorize</button>
+</form></body></html>`
+	res.Header().Set("Content-Type", "text/html")
+	res.Write([]byte(page))
+}
+
+func (s *Server) handleApprove(res http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirect.Host == "" {
+		writeError(res, http.StatusBadRequest, "bad redirect_uri")
+		return
+	}
+
+	code := uuid.NewString()
+	s.mu.Lock()
+	s.codes[code] = strings.ToLower(q.Get("login")) + " " + q.Get("scope")
+	s.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("scope", q.Get("scope"))
+	if state := q.Get("state"); state != "" {
+		params.Set("state", state)
+	}
+	redirect.RawQuery = params.Encode()
+	http.Redirect(res, req, redirect.String(), http.StatusFound)
+}
+
+// Token hands out a token for login without going through the browser, for
+// tests that drive the clients directly
+func (s *Server) Token(login string) string {
+	token := uuid.NewString()
+	s.mu.Lock()
+	s.userLocked(login)
+	s.tokens[token] = grant{login: strings.ToLower(login)}
+	s.mu.Unlock()
+	return token
+}
+
+func (s *Server) handleToken(res http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var login, scope string
+	s.mu.Lock()
+	switch req.PostForm.Get("grant_type") {
+	case "authorization_code":
+		grant, ok := s.codes[req.PostForm.Get("code")]
+		delete(s.codes, req.PostForm.Get("code"))
+		if ok {
+			login, scope, _ = strings.Cut(grant, " ")
+		}
+	case "refresh_token":
+		// refresh tokens are "refresh:<login>:<scope>"
+		if rest, ok := strings.CutPrefix(req.PostForm.Get("refresh_token"), "refresh:"); ok {
+			login, scope, _ = strings.Cut(rest, ":")
+		}
+	}
+	if login == "" {
+		s.mu.Unlock()
+		if req.PostForm.Get("grant_type") == "refresh_token" {
+			writeError(res, http.StatusBadRequest, "Invalid refresh token")
+		} else {
+			writeError(res, http.StatusBadRequest, "Invalid authorization code")
+		}
+		return
+	}
+	access := uuid.NewString()
+	s.tokens[access] = grant{login: login, scopes: strings.Fields(scope)}
+	s.userLocked(login)
+	s.mu.Unlock()
+
+	writeJSON(res, http.StatusOK, map[string]any{
+		"access_token":  access,
+		"refresh_token": "refresh:" + login + ":" + scope,
+		"expires_in":    tokenLifetime,
+		"scope":         strings.Fields(scope),
+		"token_type":    "bearer",
+	})
+}
+
+func (s *Server) handleValidate(res http.ResponseWriter, req *http.Request) {
+	token, _ := strings.CutPrefix(req.Header.Get("Authorization"), "OAuth ")
+	s.mu.Lock()
+	g, ok := s.tokens[token]
+	if !ok {
+		s.mu.Unlock()
+		writeError(res, http.StatusUnauthorized, "invalid access token")
+		return
+	}
+	user := s.userLocked(g.login)
+	s.mu.Unlock()
+
+	writeJSON(res, http.StatusOK, map[string]any{
+		"client_id":  req.Header.Get("Client-Id"),
+		"login":      user.Login,
+		"user_id":    user.Id,
+		"scopes":     append([]string{}, g.scopes...),
+		"expires_in": tokenLifetime,
+	})
+}
@@ -0,0 +1,109 @@
+package twitchmock
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Step is one thing the injector does. Scripts are one step per line as json,
+// blank lines and lines starting with # are skipped:
+//
+//	{"wait": "5s"}
+//	{"chat": {"user": "viewer1", "text": "skeleton"}}
+//	{"type": "channel.cheer", "event": {"bits": 500, "user_name": "viewer1"}}
+//	{"redeem": {"user": "viewer1", "reward": "Butterfingers"}}
+//	{"reconnect": true}
+//	{"revoke": "channel.cheer"}
+type Step struct {
+	Wait  string          `json:"wait,omitempty"`
+	Type  string          `json:"type,omitempty"`
+	Event json.RawMessage `json:"event,omitempty"`
+	Chat  *struct {
+		User string `json:"user"`
+		Text string `json:"text"`
+	} `json:"chat,omitempty"`
+	Redeem *struct {
+		User   string `json:"user"`
+		Reward string `json:"reward"`
+	} `json:"redeem,omitempty"`
+	Reconnect  bool   `json:"reconnect,omitempty"`
+	Disconnect bool   `json:"disconnect,omitempty"`
+	Revoke     string `json:"revoke,omitempty"`
+}
+
+func (s *Server) Do(ctx context.Context, step Step) error {
+	switch {
+	case step.Wait != "":
+		d, err := time.ParseDuration(step.Wait)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	case step.Chat != nil:
+		if s.Chat(step.Chat.User, step.Chat.Text) == 0 {
+			return fmt.Errorf("nobody is subscribed to chat")
+		}
+	case step.Redeem != nil:
+		if s.Redeem(step.Redeem.User, step.Redeem.Reward) == 0 {
+			return fmt.Errorf("nobody is subscribed to redemptions")
+		}
+	case step.Type != "":
+		if s.Inject(step.Type, step.Event) == 0 {
+			return fmt.Errorf("nobody is subscribed to %s", step.Type)
+		}
+	case step.Reconnect:
+		s.Reconnect()
+	case step.Disconnect:
+		s.Disconnect()
+	case step.Revoke != "":
+		s.Revoke(step.Revoke, "authorization_revoked")
+	default:
+		return fmt.Errorf("step does nothing")
+	}
+	return nil
+}
+
+// RunScript runs the steps in r one after another, steps that fail are
+// reported and skipped
+func (s *Server) RunScript(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var step Step
+		if err := json.Unmarshal([]byte(text), &step); err != nil {
+			return fmt.Errorf("script line %d: %w", line, err)
+		}
+		if err := s.Do(ctx, step); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fmt.Println("twitchmock script line", line, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// handleInject runs steps posted to /mock/inject, one per line like a script
+func (s *Server) handleInject(res http.ResponseWriter, req *http.Request) {
+	if err := s.RunScript(req.Context(), req.Body); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,448 @@
+// Package twitchmock is a stand-in for the parts of twitch the bot talks to:
+// the EventSub websocket, the Helix endpoints it calls and the oauth2 token
+// endpoints. Events are injected from Go, over HTTP or from a script.
+package twitchmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"minecraftgo/twitch"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/google/uuid"
+)
+
+// every mock helix bucket holds this many requests a minute
+const rateLimit = 800
+
+type session struct {
+	id        string
+	host      string
+	keepalive time.Duration
+	send      chan []byte
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+type grant struct {
+	login  string
+	scopes []string
+}
+
+type Server struct {
+	// how long sessions may go quiet, overridden by keepalive_timeout_seconds
+	KeepaliveTimeout time.Duration
+	// the login /oauth2/authorize suggests
+	DefaultLogin string
+	// how long a connection waits for its welcome, a reconnecting session
+	// stays on its old connection meanwhile
+	WelcomeDelay time.Duration
+
+	mu            sync.Mutex
+	sessions      map[string]*session
+	subscriptions map[string]*twitch.Subscription
+	users         map[string]twitch.UserInfo
+	codes         map[string]string
+	tokens        map[string]grant
+	chat          []twitch.SendChatMessageRequest
+	polls         map[string]*twitch.Poll
+	predictions   map[string]*twitch.Prediction
+	rewards       map[string]*twitch.CustomReward
+	remaining     int
+	reset         time.Time
+	noKeepalives  bool
+
+	mux *http.ServeMux
+}
+
+func NewServer() *Server {
+	s := &Server{
+		KeepaliveTimeout: 10 * time.Second,
+		DefaultLogin:     "streamer",
+		sessions:         map[string]*session{},
+		subscriptions:    map[string]*twitch.Subscription{},
+		users:            map[string]twitch.UserInfo{},
+		codes:            map[string]string{},
+		tokens:           map[string]grant{},
+		polls:            map[string]*twitch.Poll{},
+		predictions:      map[string]*twitch.Prediction{},
+		rewards:          map[string]*twitch.CustomReward{},
+		mux:              http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /ws", s.handleWebsocket)
+	s.mux.HandleFunc("GET /oauth2/authorize", s.handleAuthorize)
+	s.mux.HandleFunc("GET /oauth2/approve", s.handleApprove)
+	s.mux.HandleFunc("POST /oauth2/token", s.handleToken)
+	s.mux.HandleFunc("GET /oauth2/validate", s.handleValidate)
+	s.mux.HandleFunc("GET /helix/users", s.helix(s.handleUsers))
+	s.mux.HandleFunc("GET /helix/eventsub/subscriptions", s.helix(s.handleListSubscriptions))
+	s.mux.HandleFunc("POST /helix/eventsub/subscriptions", s.helix(s.handleCreateSubscription))
+	s.mux.HandleFunc("DELETE /helix/eventsub/subscriptions", s.helix(s.handleDeleteSubscription))
+	s.mux.HandleFunc("POST /helix/chat/messages", s.helix(s.handleChatMessage))
+	s.mux.HandleFunc("POST /helix/polls", s.helix(s.handleCreatePoll))
+	s.mux.HandleFunc("PATCH /helix/polls", s.helix(s.handleEndPoll))
+	s.mux.HandleFunc("POST /helix/predictions", s.helix(s.handleCreatePrediction))
+	s.mux.HandleFunc("PATCH /helix/predictions", s.helix(s.handleEndPrediction))
+	s.mux.HandleFunc("GET /helix/channel_points/custom_rewards", s.helix(s.handleListRewards))
+	s.mux.HandleFunc("POST /helix/channel_points/custom_rewards", s.helix(s.handleCreateReward))
+	s.mux.HandleFunc("PATCH /helix/channel_points/custom_rewards/redemptions", s.helix(s.handleRedemption))
+	s.mux.HandleFunc("POST /mock/inject", s.handleInject)
+	return s
+}
+
+func (s *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	fmt.Println("twitchmock", req.Method, req.URL.Path)
+	s.mux.ServeHTTP(res, req)
+}
+
+// Endpoints points the twitch clients at this server running on baseUrl,
+// e.g. http://localhost:8080
+func Endpoints(baseUrl string) twitch.Endpoints {
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+	return twitch.Endpoints{
+		EventSub: "ws" + strings.TrimPrefix(baseUrl, "http") + "/ws",
+		Helix:    baseUrl + "/helix",
+		OAuth:    baseUrl + "/oauth2",
+	}
+}
+
+// User returns the fake account for login, made up the first time it's asked for
+func (s *Server) User(login string) twitch.UserInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.userLocked(login)
+}
+
+func (s *Server) userLocked(login string) twitch.UserInfo {
+	login = strings.ToLower(login)
+	if u, ok := s.users[login]; ok {
+		return u
+	}
+	h := fnv.New32a()
+	h.Write([]byte(login))
+	u := twitch.UserInfo{Id: strconv.FormatUint(uint64(h.Sum32()%100000000), 10), Login: login, DisplayName: login}
+	s.users[login] = u
+	return u
+}
+
+// ChatMessages returns what the bot said in chat so far
+func (s *Server) ChatMessages() []twitch.SendChatMessageRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]twitch.SendChatMessageRequest{}, s.chat...)
+}
+
+func (s *Server) Subscriptions() []twitch.Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subs := make([]twitch.Subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		subs = append(subs, *sub)
+	}
+	return subs
+}
+
+// websocket
+
+func metadata(messageType string) map[string]any {
+	return map[string]any{
+		"message_id":        uuid.NewString(),
+		"message_type":      messageType,
+		"message_timestamp": time.Now().UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func sessionPayload(sess *session, status string, reconnectUrl string) map[string]any {
+	payload := map[string]any{
+		"id":                        sess.id,
+		"status":                    status,
+		"keepalive_timeout_seconds": int(sess.keepalive.Seconds()),
+		"connected_at":              time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if reconnectUrl != "" {
+		payload["reconnect_url"] = reconnectUrl
+	}
+	return map[string]any{"session": payload}
+}
+
+func (s *Server) handleWebsocket(res http.ResponseWriter, req *http.Request) {
+	keepalive := s.KeepaliveTimeout
+	if secs, err := strconv.Atoi(req.URL.Query().Get("keepalive_timeout_seconds")); err == nil && secs >= 10 && secs <= 600 {
+		keepalive = time.Duration(secs) * time.Second
+	}
+
+	conn, err := websocket.Accept(res, req, nil)
+	if err != nil {
+		return
+	}
+	ctx := conn.CloseRead(context.Background())
+
+	// a reconnect keeps the old session id and its subscriptions, the old
+	// connection gets everything until the new one is welcomed
+	id := req.URL.Query().Get("reconnect")
+	reconnect := id != ""
+	if !reconnect {
+		id = uuid.NewString()
+	}
+	sess := &session{id: id, host: req.Host, keepalive: keepalive, send: make(chan []byte, 64)}
+	sess.ctx, sess.cancel = context.WithCancel(ctx)
+	if !reconnect {
+		s.mu.Lock()
+		s.sessions[id] = sess
+		s.mu.Unlock()
+	}
+
+	select {
+	case <-time.After(s.WelcomeDelay):
+	case <-sess.ctx.Done():
+		s.dropSession(sess)
+		return
+	}
+	welcome, _ := json.Marshal(map[string]any{"metadata": metadata(twitch.Welcome), "payload": sessionPayload(sess, "connected", "")})
+	if err := conn.Write(sess.ctx, websocket.MessageText, welcome); err != nil {
+		s.dropSession(sess)
+		return
+	}
+
+	if reconnect {
+		s.mu.Lock()
+		old := s.sessions[id]
+		s.sessions[id] = sess
+		s.mu.Unlock()
+		if old != nil {
+			old.cancel()
+		}
+	}
+
+	// quiet sessions get a keepalive a little before they'd time out
+	ticker := time.NewTicker(keepalive * 3 / 4)
+	defer ticker.Stop()
+	for {
+		var msg []byte
+		select {
+		case msg = <-sess.send:
+			ticker.Reset(keepalive * 3 / 4)
+		case <-ticker.C:
+			if s.skippingKeepalives() {
+				continue
+			}
+			msg, _ = json.Marshal(map[string]any{"metadata": metadata(twitch.KeepAlive), "payload": map[string]any{}})
+		case <-sess.ctx.Done():
+			conn.Close(websocket.StatusNormalClosure, "")
+			s.dropSession(sess)
+			return
+		}
+		if err := conn.Write(sess.ctx, websocket.MessageText, msg); err != nil {
+			s.dropSession(sess)
+			return
+		}
+	}
+}
+
+// dropSession forgets a closed connection, its subscriptions stop working
+// unless a reconnect already took the session over
+func (s *Server) dropSession(sess *session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions[sess.id] != sess {
+		return
+	}
+	delete(s.sessions, sess.id)
+	for _, sub := range s.subscriptions {
+		if sub.Transport.SessionId == sess.id {
+			sub.Status = "websocket_disconnected"
+		}
+	}
+}
+
+func (s *Server) sendTo(sessionId string, msg any) bool {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return false
+	}
+	s.mu.Lock()
+	sess, ok := s.sessions[sessionId]
+	s.mu.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case sess.send <- data:
+		return true
+	case <-sess.ctx.Done():
+		return false
+	}
+}
+
+// Inject sends event as a notification on every enabled subscription of
+// subscriptionType and returns how many got it
+func (s *Server) Inject(subscriptionType string, event any) int {
+	s.mu.Lock()
+	var subs []twitch.Subscription
+	for _, sub := range s.subscriptions {
+		if sub.Type == subscriptionType && sub.Status == "enabled" {
+			subs = append(subs, *sub)
+		}
+	}
+	s.mu.Unlock()
+
+	delivered := 0
+	for _, sub := range subs {
+		md := metadata(twitch.Notification)
+		md["subscription_type"] = sub.Type
+		md["subscription_version"] = sub.Version
+		msg := map[string]any{"metadata": md, "payload": map[string]any{"subscription": sub, "event": event}}
+		if s.sendTo(sub.Transport.SessionId, msg) {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+// Chat injects a chat message from login to every chat subscription
+func (s *Server) Chat(login string, text string) int {
+	s.mu.Lock()
+	user := s.userLocked(login)
+	var broadcasterId string
+	for _, sub := range s.subscriptions {
+		if sub.Type == twitch.ChatMessageType {
+			broadcasterId = sub.Condition["broadcaster_user_id"]
+		}
+	}
+	broadcaster := s.userById(broadcasterId)
+	s.mu.Unlock()
+
+	event := map[string]any{
+		"broadcaster_user_id":    broadcaster.Id,
+		"broadcaster_user_login": broadcaster.Login,
+		"broadcaster_user_name":  broadcaster.DisplayName,
+		"chatter_user_id":        user.Id,
+		"chatter_user_login":     user.Login,
+		"chatter_user_name":      user.DisplayName,
+		"message_id":             uuid.NewString(),
+		"message": map[string]any{
+			"text":      text,
+			"fragments": []map[string]any{{"type": "text", "text": text}},
+		},
+		"message_type": "text",
+		"badges":       []map[string]any{},
+		"color":        "",
+	}
+	if user.Id == broadcaster.Id {
+		event["badges"] = []map[string]any{{"set_id": "broadcaster", "id": "1", "info": ""}}
+	}
+	return s.Inject(twitch.ChatMessageType, event)
+}
+
+// Redeem injects a redemption of the reward called title, made by the bot or
+// made up, to every redemption subscription
+func (s *Server) Redeem(login string, title string) int {
+	s.mu.Lock()
+	user := s.userLocked(login)
+	reward := twitch.CustomReward{Id: uuid.NewString(), Title: title, Cost: 100}
+	for _, r := range s.rewards {
+		if strings.EqualFold(r.Title, title) {
+			reward = *r
+		}
+	}
+	s.mu.Unlock()
+
+	return s.Inject(twitch.RedemptionAddType, map[string]any{
+		"id":                  uuid.NewString(),
+		"broadcaster_user_id": reward.BroadcasterId,
+		"user_id":             user.Id,
+		"user_login":          user.Login,
+		"user_name":           user.DisplayName,
+		"status":              "unfulfilled",
+		"reward":              map[string]any{"id": reward.Id, "title": reward.Title, "cost": reward.Cost},
+		"redeemed_at":         time.Now().UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// Rewards returns the channel point rewards the bot created
+func (s *Server) Rewards() []twitch.CustomReward {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rewards := make([]twitch.CustomReward, 0, len(s.rewards))
+	for _, r := range s.rewards {
+		rewards = append(rewards, *r)
+	}
+	return rewards
+}
+
+func (s *Server) userById(id string) twitch.UserInfo {
+	for _, u := range s.users {
+		if u.Id == id {
+			return u
+		}
+	}
+	return twitch.UserInfo{Id: id}
+}
+
+// Reconnect asks every session to move to a new connection
+func (s *Server) Reconnect() {
+	s.mu.Lock()
+	sessions := make([]*session, 0, len(s.sessions))
+	for _, sess := range s.sessions {
+		sessions = append(sessions, sess)
+	}
+	s.mu.Unlock()
+
+	for _, sess := range sessions {
+		reconnectUrl := "ws://" + sess.host + "/ws?reconnect=" + sess.id
+		msg := map[string]any{"metadata": metadata(twitch.Reconnect), "payload": sessionPayload(sess, "reconnecting", reconnectUrl)}
+		s.sendTo(sess.id, msg)
+	}
+}
+
+// Revoke revokes every subscription of subscriptionType
+func (s *Server) Revoke(subscriptionType string, status string) {
+	s.mu.Lock()
+	var revoked []twitch.Subscription
+	for id, sub := range s.subscriptions {
+		if sub.Type == subscriptionType {
+			sub.Status = status
+			revoked = append(revoked, *sub)
+			delete(s.subscriptions, id)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, sub := range revoked {
+		md := metadata(twitch.Revocation)
+		md["subscription_type"] = sub.Type
+		md["subscription_version"] = sub.Version
+		s.sendTo(sub.Transport.SessionId, map[string]any{"metadata": md, "payload": map[string]any{"subscription": sub}})
+	}
+}
+
+// Keepalives turns keepalive messages on and off, off lets quiet sessions
+// time out on the bot's side
+func (s *Server) Keepalives(on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.noKeepalives = !on
+}
+
+func (s *Server) skippingKeepalives() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.noKeepalives
+}
+
+// Disconnect drops every websocket without a goodbye, like a network outage
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sess := range s.sessions {
+		sess.cancel()
+	}
+}
@@ -0,0 +1,59 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type cachedUser struct {
+	info    UserInfo
+	fetched time.Time
+}
+
+// UserCache remembers login to user id lookups, ids never change but logins
+// can be renamed so entries still expire
+type UserCache struct {
+	helix *Helix
+	ttl   time.Duration
+	mu    sync.Mutex
+	users map[string]cachedUser
+}
+
+func NewUserCache(helix *Helix, ttl time.Duration) *UserCache {
+	return &UserCache{helix: helix, ttl: ttl, users: map[string]cachedUser{}}
+}
+
+func (uc *UserCache) UserId(ctx context.Context, login string) (string, error) {
+	info, err := uc.User(ctx, login)
+	if err != nil {
+		return "", err
+	}
+	return info.Id, nil
+}
+
+func (uc *UserCache) User(ctx context.Context, login string) (UserInfo, error) {
+	login = strings.ToLower(login)
+
+	uc.mu.Lock()
+	cached, ok := uc.users[login]
+	uc.mu.Unlock()
+	if ok && time.Since(cached.fetched) < uc.ttl {
+		return cached.info, nil
+	}
+
+	infos, err := uc.helix.GetUsers(ctx, login)
+	if err != nil {
+		return UserInfo{}, err
+	}
+	if len(infos) == 0 {
+		return UserInfo{}, fmt.Errorf("no twitch user named %s", login)
+	}
+
+	uc.mu.Lock()
+	uc.users[login] = cachedUser{info: infos[0], fetched: time.Now()}
+	uc.mu.Unlock()
+	return infos[0], nil
+}
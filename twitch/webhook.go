@@ -0,0 +1,186 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	WebhookVerification = "webhook_callback_verification"
+
+	// twitch says to drop anything older than this
+	webhookMaxAge = 10 * time.Minute
+)
+
+// WebhookHandler is the EventSub callback endpoint. It answers the
+// verification challenge, checks every message was signed with Secret and
+// hands notifications and revocations on like the websocket client does.
+type WebhookHandler struct {
+	Secret []byte
+
+	seen   *Dedup
+	events chan EventSubEvent
+}
+
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		Secret: []byte(secret),
+		seen:   NewDedup(webhookMaxAge),
+		events: make(chan EventSubEvent, 16),
+	}
+}
+
+func (wh *WebhookHandler) Events() <-chan EventSubEvent {
+	return wh.events
+}
+
+// verify checks the signature, which is an HMAC over id, timestamp and body
+func (wh *WebhookHandler) verify(header http.Header, body []byte) bool {
+	mac := hmac.New(sha256.New, wh.Secret)
+	mac.Write([]byte(header.Get("Twitch-Eventsub-Message-Id")))
+	mac.Write([]byte(header.Get("Twitch-Eventsub-Message-Timestamp")))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(header.Get("Twitch-Eventsub-Message-Signature")))
+}
+
+func (wh *WebhookHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, 1<<20))
+	if err != nil {
+		http.Error(res, "bad body", http.StatusBadRequest)
+		return
+	}
+
+	if !wh.verify(req.Header, body) {
+		fmt.Println("EventSub webhook with bad signature from", req.RemoteAddr)
+		http.Error(res, "bad signature", http.StatusForbidden)
+		return
+	}
+
+	id := req.Header.Get("Twitch-Eventsub-Message-Id")
+	timestamp, err := time.Parse(time.RFC3339Nano, req.Header.Get("Twitch-Eventsub-Message-Timestamp"))
+	now := time.Now()
+	if err != nil || now.Sub(timestamp) > webhookMaxAge || timestamp.Sub(now) > webhookMaxAge {
+		http.Error(res, "stale message", http.StatusForbidden)
+		return
+	}
+
+	var payload eventSubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(res, "bad payload", http.StatusBadRequest)
+		return
+	}
+
+	messageType := req.Header.Get("Twitch-Eventsub-Message-Type")
+	// a retried verification still needs its challenge back, so it isn't deduped
+	if messageType == WebhookVerification {
+		var challenge struct {
+			Challenge string `json:"challenge"`
+		}
+		json.Unmarshal(body, &challenge)
+		fmt.Println("EventSub webhook verified for", payload.Subscription.Type)
+		res.Header().Set("Content-Type", "text/plain")
+		io.WriteString(res, challenge.Challenge)
+		return
+	}
+	// a retry of something we already handled is acknowledged and dropped
+	if wh.seen.Seen(id) {
+		res.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var ev EventSubEvent
+	switch messageType {
+	case Notification:
+		ev = NotificationEvent{
+			Id:                  id,
+			Timestamp:           timestamp,
+			SubscriptionType:    req.Header.Get("Twitch-Eventsub-Subscription-Type"),
+			SubscriptionVersion: req.Header.Get("Twitch-Eventsub-Subscription-Version"),
+			Subscription:        payload.Subscription,
+			Event:               payload.Event,
+			Raw:                 body,
+		}
+	case Revocation:
+		fmt.Println("Subscription revoked", payload.Subscription.Type, payload.Subscription.Status)
+		ev = RevocationEvent{Id: id, Timestamp: timestamp, Subscription: payload.Subscription}
+	default:
+		res.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// twitch wants an answer within a few seconds, nobody reading means the
+	// game isn't running
+	ctx, cancel := context.WithTimeout(req.Context(), 2*time.Second)
+	defer cancel()
+	select {
+	case wh.events <- ev:
+		res.WriteHeader(http.StatusNoContent)
+	case <-ctx.Done():
+		// so it gets through when twitch retries it
+		wh.seen.Forget(id)
+		http.Error(res, "not ready", http.StatusServiceUnavailable)
+	}
+}
+
+// WebhookSecretFile keeps a hash of the secret the webhook subscriptions were
+// made with. Twitch never sends the secret back, this is how a new one in the
+// config is noticed.
+type WebhookSecretFile struct {
+	Path string
+	// the token key, so the hash can't be checked against guesses without it
+	KeyPath string
+}
+
+func NewWebhookSecretFile(path string, keyPath string) *WebhookSecretFile {
+	return &WebhookSecretFile{Path: path, KeyPath: keyPath}
+}
+
+func (f *WebhookSecretFile) hash(secret string) ([]byte, error) {
+	key, err := NewTokenStore("", f.KeyPath).key()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(secret))
+	return []byte(hex.EncodeToString(mac.Sum(nil))), nil
+}
+
+// Changed reports whether secret isn't the one last saved, nothing saved yet
+// counts as changed
+func (f *WebhookSecretFile) Changed(secret string) (bool, error) {
+	saved, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	hash, err := f.hash(secret)
+	if err != nil {
+		return false, err
+	}
+	return !hmac.Equal(bytes.TrimSpace(saved), hash), nil
+}
+
+func (f *WebhookSecretFile) Save(secret string) error {
+	hash, err := f.hash(secret)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(f.Path, hash, 0600)
+}
@@ -0,0 +1,101 @@
+package twitch_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"minecraftgo/twitch"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const webhookSecret = "0123456789abcdef"
+
+// postWebhook sends body the way twitch does, signed with secret
+func postWebhook(t *testing.T, wh *twitch.WebhookHandler, secret string, messageType string, id string, timestamp time.Time, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	ts := timestamp.UTC().Format(time.RFC3339Nano)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + ts + body))
+
+	req := httptest.NewRequest(http.MethodPost, "/eventsub", strings.NewReader(body))
+	req.Header.Set("Twitch-Eventsub-Message-Id", id)
+	req.Header.Set("Twitch-Eventsub-Message-Timestamp", ts)
+	req.Header.Set("Twitch-Eventsub-Message-Type", messageType)
+	req.Header.Set("Twitch-Eventsub-Message-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	req.Header.Set("Twitch-Eventsub-Subscription-Type", twitch.ChatMessageType)
+	req.Header.Set("Twitch-Eventsub-Subscription-Version", "1")
+	res := httptest.NewRecorder()
+	wh.ServeHTTP(res, req)
+	return res
+}
+
+const chatBody = `{"subscription": {"type": "channel.chat.message"}, "event": {"message": {"text": "skeleton"}}}`
+
+func TestWebhookSignature(t *testing.T) {
+	wh := twitch.NewWebhookHandler(webhookSecret)
+	if res := postWebhook(t, wh, "not the secret", twitch.Notification, "m1", time.Now(), chatBody); res.Code != http.StatusForbidden {
+		t.Errorf("bad signature got %d", res.Code)
+	}
+	if res := postWebhook(t, wh, webhookSecret, twitch.Notification, "m2", time.Now().Add(-time.Hour), chatBody); res.Code != http.StatusForbidden {
+		t.Errorf("stale timestamp got %d", res.Code)
+	}
+	select {
+	case ev := <-wh.Events():
+		t.Errorf("rejected message came through: %#v", ev)
+	default:
+	}
+}
+
+func TestWebhookReplay(t *testing.T) {
+	wh := twitch.NewWebhookHandler(webhookSecret)
+	if res := postWebhook(t, wh, webhookSecret, twitch.Notification, "m1", time.Now(), chatBody); res.Code != http.StatusNoContent {
+		t.Fatalf("got %d", res.Code)
+	}
+	if n, ok := (<-wh.Events()).(twitch.NotificationEvent); !ok || n.Id != "m1" {
+		t.Errorf("got %#v", n)
+	}
+
+	// twitch retrying it is acknowledged but not handed on again
+	if res := postWebhook(t, wh, webhookSecret, twitch.Notification, "m1", time.Now(), chatBody); res.Code != http.StatusNoContent {
+		t.Errorf("replay got %d", res.Code)
+	}
+	select {
+	case ev := <-wh.Events():
+		t.Errorf("replay came through: %#v", ev)
+	default:
+	}
+}
+
+func TestWebhookChallenge(t *testing.T) {
+	wh := twitch.NewWebhookHandler(webhookSecret)
+	body := `{"challenge": "pogchamp-kappa-360noscope", "subscription": {"type": "channel.chat.message"}}`
+	// the retry has the same id and still needs the challenge
+	for attempt := 0; attempt < 2; attempt++ {
+		res := postWebhook(t, wh, webhookSecret, twitch.WebhookVerification, "v1", time.Now(), body)
+		if res.Code != http.StatusOK || res.Body.String() != "pogchamp-kappa-360noscope" {
+			t.Errorf("attempt %d got %d %q", attempt, res.Code, res.Body.String())
+		}
+	}
+}
+
+func TestWebhookSecretFile(t *testing.T) {
+	dir := t.TempDir()
+	f := twitch.NewWebhookSecretFile(filepath.Join(dir, "webhook_secret.dat"), filepath.Join(dir, "token.key"))
+	if changed, err := f.Changed(webhookSecret); err != nil || !changed {
+		t.Errorf("nothing saved yet got %v, %v", changed, err)
+	}
+	if err := f.Save(webhookSecret); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := f.Changed(webhookSecret); err != nil || changed {
+		t.Errorf("same secret got %v, %v", changed, err)
+	}
+	if changed, err := f.Changed("fedcba9876543210"); err != nil || !changed {
+		t.Errorf("new secret got %v, %v", changed, err)
+	}
+}
@@ -0,0 +1,78 @@
+package wrapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+type DeathEvent struct {
+	Player string
+	// the rest of the death message, e.g. "was slain by Zombie"
+	Cause string
+}
+
+type AdvancementEvent struct {
+	Player string
+	// advancement, goal or challenge
+	Kind  string
+	Title string
+}
+
+type JoinEvent struct {
+	Player string
+}
+
+type LeaveEvent struct {
+	Player string
+}
+
+var (
+	advancementRegex = regexp.MustCompile(`^(\S+) has (made the advancement|reached the goal|completed the challenge) \[(.*)\]$`)
+	joinRegex        = regexp.MustCompile(`^(\S+) joined the game$`)
+	leaveRegex       = regexp.MustCompile(`^(\S+) left the game$`)
+	playerLineRegex  = regexp.MustCompile(`^([A-Za-z0-9_]{1,16}) (.+)$`)
+)
+
+var advancementKinds = map[string]string{
+	"made the advancement":    "advancement",
+	"reached the goal":        "goal",
+	"completed the challenge": "challenge",
+}
+
+// vanilla death messages all start with the player's name followed by one of these
+var deathPhrases = []string{
+	"was ", "walked into", "drowned", "died", "experienced kinetic energy",
+	"blew up", "hit the ground too hard", "fell ", "went up in flames",
+	"went off with a bang", "burned to death", "tried to swim in lava",
+	"suffocated", "starved to death", "froze to death", "withered away",
+	"didn't want to live", "discovered the floor was lava", "left the confines",
+	"was killed", "pummeled", "squashed", "skewered",
+}
+
+// ParseGameEvent recognises deaths, advancements, joins and leaves in a log
+// line, nil when the line is none of those
+func ParseGameEvent(ll *LogLine) any {
+	out := ll.output
+	// chat and commands are "<player> text" and "[player: text]", never events
+	if out == "" || strings.HasPrefix(out, "<") || strings.HasPrefix(out, "[") {
+		return nil
+	}
+
+	if m := advancementRegex.FindStringSubmatch(out); m != nil {
+		return &AdvancementEvent{Player: m[1], Kind: advancementKinds[m[2]], Title: m[3]}
+	}
+	if m := joinRegex.FindStringSubmatch(out); m != nil {
+		return &JoinEvent{Player: m[1]}
+	}
+	if m := leaveRegex.FindStringSubmatch(out); m != nil {
+		return &LeaveEvent{Player: m[1]}
+	}
+	if m := playerLineRegex.FindStringSubmatch(out); m != nil {
+		for _, phrase := range deathPhrases {
+			if strings.HasPrefix(m[2], phrase) {
+				return &DeathEvent{Player: m[1], Cause: m[2]}
+			}
+		}
+	}
+	return nil
+}
@@ -7,6 +7,11 @@ import (
 	"io"
 	"os/exec"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"github.com/looplab/fsm"
 )
@@ -67,6 +72,11 @@ type LogLine struct {
 	output     string
 }
 
+// Output is the message part of the line, without time, thread and level
+func (ll *LogLine) Output() string {
+	return ll.output
+}
+
 func (ll *LogLine) Match(regex *regexp.Regexp) bool {
 	return regex.Match([]byte(ll.output))
 }
@@ -107,6 +117,15 @@ var eventToRegexp = map[Event]*regexp.Regexp{
 	StopEvent:    regexp.MustCompile(`Stopping (.*) server`),
 }
 
+func ParseVersion(line string) string {
+	ll := ParseToLogLine(line)
+	matches := eventToRegexp[StartEvent].FindStringSubmatch(ll.output)
+	if len(matches) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(matches[1])
+}
+
 func LogParser(line string) Event {
 	ll := ParseToLogLine(line)
 	for e, r := range eventToRegexp {
@@ -125,11 +144,32 @@ const (
 	ServerStopping = "stopping"
 )
 
+// how long SendCommand waits for the line the server answers with
+const responseTimeout = 10 * time.Second
+
 type Wrapper struct {
-	console    *Console
-	machine    *fsm.FSM
-	LastLine   string
-	OutputChan chan string
+	console *Console
+	machine *fsm.FSM
+	// set by the log reader, read from anywhere
+	versionMu sync.Mutex
+	version   string
+	logMu     sync.Mutex
+	logSubs   map[chan *LogLine]struct{}
+	LastLine  string
+
+	// one command at a time, so every caller gets the answer to its own
+	cmdMu sync.Mutex
+	// outputMu guards output, where the next line goes while a command waits
+	outputMu sync.Mutex
+	output   chan string
+}
+
+// Version is the game version announced in the server log on startup, empty
+// until the server has printed it
+func (w *Wrapper) Version() string {
+	w.versionMu.Lock()
+	defer w.versionMu.Unlock()
+	return w.version
 }
 
 func (w *Wrapper) Start() error {
@@ -177,21 +217,64 @@ func (w *Wrapper) processLogEvents() {
 	for {
 		line, err := w.console.ReadLine()
 		w.LastLine = line
-		if w.OutputChan != nil {
-			w.OutputChan <- w.LastLine
-		}
+		w.deliverOutput(line)
 		if err == io.EOF {
 			w.updateState(StoppedEvent)
 			continue
 		}
 
+		w.publishLog(line)
 		event := LogParser(line)
 		fmt.Println("Processing Event", string(event))
+		if event == StartEvent {
+			version := ParseVersion(line)
+			w.versionMu.Lock()
+			w.version = version
+			w.versionMu.Unlock()
+			fmt.Println("Server version", version)
+		}
 		w.updateState(event)
 		fmt.Println("Current state", w.machine.Current())
 	}
 }
 
+// SubscribeLog gets every line the server logs from now on until the returned
+// func is called. Slow readers miss lines rather than holding up the server.
+func (w *Wrapper) SubscribeLog() (<-chan *LogLine, func()) {
+	ch := make(chan *LogLine, 64)
+	w.logMu.Lock()
+	if w.logSubs == nil {
+		w.logSubs = map[chan *LogLine]struct{}{}
+	}
+	w.logSubs[ch] = struct{}{}
+	w.logMu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			w.logMu.Lock()
+			delete(w.logSubs, ch)
+			w.logMu.Unlock()
+		})
+	}
+}
+
+func (w *Wrapper) publishLog(line string) {
+	w.logMu.Lock()
+	defer w.logMu.Unlock()
+	if len(w.logSubs) == 0 {
+		return
+	}
+
+	ll := ParseToLogLine(line)
+	for ch := range w.logSubs {
+		select {
+		case ch <- ll:
+		default:
+		}
+	}
+}
+
 func (w *Wrapper) updateState(ev Event) error {
 	if ev == EmptyEvent {
 		return nil
@@ -199,16 +282,59 @@ func (w *Wrapper) updateState(ev Event) error {
 	return w.machine.Event(context.Background(), string(ev))
 }
 
+func (w *Wrapper) Online() bool {
+	return w.machine.Is(ServerOnline)
+}
+
+// HasControlCharacters reports whether s has anything like a line break that
+// would let it run more than one console command
+func HasControlCharacters(s string) bool {
+	return strings.ContainsFunc(s, unicode.IsControl)
+}
+
+// deliverOutput hands line to the command waiting for an answer, if any
+func (w *Wrapper) deliverOutput(line string) {
+	w.outputMu.Lock()
+	defer w.outputMu.Unlock()
+	if w.output == nil {
+		return
+	}
+	w.output <- line
+	w.output = nil
+}
+
+func (w *Wrapper) expectOutput(ch chan string) {
+	w.outputMu.Lock()
+	w.output = ch
+	w.outputMu.Unlock()
+}
+
+// SendCommand runs cmd on the server console and returns the next line the
+// server logs. Calls from several goroutines wait their turn.
 func (w *Wrapper) SendCommand(cmd string) string {
-	if w.machine.Is(ServerOnline) {
-		ch := make(chan string)
-		defer close(ch)
-		w.OutputChan = ch
-		w.console.WriteCmd(cmd)
-		response := <-w.OutputChan
-		w.OutputChan = nil
-		return response
+	if HasControlCharacters(cmd) {
+		fmt.Println("!! Refusing command with control characters", strconv.Quote(cmd))
+		return "Command has control characters"
+	}
+	if !w.machine.Is(ServerOnline) {
+		return "Server not online"
 	}
 
-	return "Server not online"
+	w.cmdMu.Lock()
+	defer w.cmdMu.Unlock()
+
+	// buffered so the log reader never waits on us
+	ch := make(chan string, 1)
+	w.expectOutput(ch)
+	if err := w.console.WriteCmd(cmd); err != nil {
+		w.expectOutput(nil)
+		return "Could not send command: " + err.Error()
+	}
+	select {
+	case response := <-ch:
+		return response
+	case <-time.After(responseTimeout):
+		w.expectOutput(nil)
+		return "No response from server"
+	}
 }
@@ -0,0 +1,201 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"minecraftgo/input"
+	"strconv"
+	"time"
+)
+
+// the lowest amount of each of YouTube's Super Chat tiers in US cents, for
+// currencies without a rate. YouTube sorts every currency into these itself.
+var superChatTierCents = []int{100, 200, 500, 1000, 2000, 5000, 10000, 20000, 30000, 40000, 50000}
+
+// Provider polls a live chat. Chat becomes chat events, Super Chats and Super
+// Stickers become tips and memberships become subs, so the same tier tables
+// as for twitch and the alert services apply. YouTube chat can't be written
+// to with an api key, so there are no replies.
+type Provider struct {
+	Client *Client
+	// the video to find the chat of, unless LiveChatId is set
+	VideoId    string
+	LiveChatId string
+	// membership level name to sub tier, unknown levels are "1000"
+	MembershipTiers map[string]string
+	// turns Super Chat amounts into US cents
+	Rates input.Rates
+	// polls never come quicker than this, even when YouTube asks for it
+	MinPollInterval time.Duration
+}
+
+func NewProvider(client *Client, videoId string) *Provider {
+	return &Provider{
+		Client:          client,
+		VideoId:         videoId,
+		MembershipTiers: map[string]string{},
+		Rates:           input.DefaultRates,
+		MinPollInterval: time.Second,
+	}
+}
+
+func (p *Provider) Name() string {
+	return "youtube"
+}
+
+func (p *Provider) Run(ctx context.Context, events chan<- input.Event) error {
+	liveChatId := p.LiveChatId
+	if liveChatId == "" {
+		var err error
+		if liveChatId, err = p.Client.LiveChatId(ctx, p.VideoId); err != nil {
+			return err
+		}
+	}
+
+	pageToken := ""
+	// the first page is chat from before we started, nothing to act on
+	backlog := true
+	backoff := time.Second
+	for {
+		list, err := p.Client.LiveChatMessages(ctx, liveChatId, pageToken)
+		if IsReason(err, ReasonLiveChatEnded) || IsReason(err, ReasonLiveChatNotFound) {
+			fmt.Println("YouTube chat is over")
+			return nil
+		}
+		if IsReason(err, ReasonQuotaExceeded) {
+			return err
+		}
+		wait := backoff
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fmt.Println("YouTube chat poll failed, retrying in", backoff, err)
+			backoff = min(backoff*2, time.Minute)
+		} else {
+			backoff = time.Second
+			pageToken = list.NextPageToken
+			// a missing interval would otherwise poll nonstop and eat the quota
+			wait = max(time.Duration(list.PollingIntervalMillis)*time.Millisecond, p.MinPollInterval)
+
+			for _, msg := range list.Items {
+				ev, ok := p.toInput(msg)
+				if !ok || backlog {
+					continue
+				}
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				if msg.Snippet.Type == ChatEndedType {
+					return nil
+				}
+			}
+			backlog = false
+			if list.OfflineAt != nil {
+				fmt.Println("YouTube stream went offline at", list.OfflineAt.Format(time.TimeOnly))
+				return nil
+			}
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (p *Provider) tier(level string) string {
+	if tier, ok := p.MembershipTiers[level]; ok {
+		return tier
+	}
+	return "1000"
+}
+
+// cents is what a Super Chat is worth in US cents, from its amount when the
+// currency has a rate and from its tier when it doesn't
+func (p *Provider) cents(details *SuperChatDetails) (int, bool) {
+	if micros, err := strconv.ParseInt(details.AmountMicros, 10, 64); err == nil && micros > 0 {
+		if cents, ok := p.Rates.Cents(float64(micros)/1e6, details.Currency); ok {
+			return cents, true
+		}
+	}
+	if details.Tier < 1 {
+		return 0, false
+	}
+	fmt.Println("No rate for", details.Currency, "going by Super Chat tier", details.Tier)
+	return superChatTierCents[min(details.Tier, len(superChatTierCents))-1], true
+}
+
+func (p *Provider) toInput(msg LiveChatMessage) (input.Event, bool) {
+	author := msg.AuthorDetails
+	ev := input.Event{
+		Viewer:    input.Viewer{Id: author.ChannelId, Login: author.ChannelId, Name: author.DisplayName},
+		MessageId: msg.Id,
+		Raw:       msg,
+	}
+	if author.IsChatOwner {
+		ev.Viewer.Badges = append(ev.Viewer.Badges, input.BadgeBroadcaster)
+	}
+	if author.IsChatModerator {
+		ev.Viewer.Badges = append(ev.Viewer.Badges, input.BadgeModerator)
+	}
+	if author.IsChatSponsor {
+		ev.Viewer.Badges = append(ev.Viewer.Badges, input.BadgeSubscriber)
+	}
+
+	snippet := msg.Snippet
+	switch snippet.Type {
+	case TextMessageType:
+		ev.Kind = input.Chat
+		ev.What = snippet.DisplayMessage
+		if snippet.TextMessageDetails != nil {
+			ev.What = snippet.TextMessageDetails.MessageText
+		}
+	case SuperChatType, SuperStickerType:
+		details := snippet.SuperChatDetails
+		if details == nil {
+			details = snippet.SuperStickerDetails
+		}
+		if details == nil {
+			return ev, false
+		}
+		cents, ok := p.cents(details)
+		if !ok {
+			return ev, false
+		}
+		ev.Kind = input.Tip
+		ev.What = details.UserComment
+		ev.Amount = cents
+	case NewSponsorType:
+		if snippet.NewSponsorDetails == nil {
+			return ev, false
+		}
+		ev.Kind = input.Subscribe
+		ev.Amount = 1
+		ev.Tier = p.tier(snippet.NewSponsorDetails.MemberLevelName)
+	case MemberMilestoneType:
+		if snippet.MemberMilestoneChatDetails == nil {
+			return ev, false
+		}
+		ev.Kind = input.Resub
+		ev.What = snippet.MemberMilestoneChatDetails.UserComment
+		ev.Amount = snippet.MemberMilestoneChatDetails.MemberMonth
+		ev.Tier = p.tier(snippet.MemberMilestoneChatDetails.MemberLevelName)
+	case MembershipGiftingType:
+		if snippet.MembershipGiftingDetails == nil {
+			return ev, false
+		}
+		ev.Kind = input.GiftSub
+		ev.Amount = snippet.MembershipGiftingDetails.GiftMembershipsCount
+		ev.Tier = p.tier(snippet.MembershipGiftingDetails.GiftMembershipsLevelName)
+	case ChatEndedType:
+		ev.Kind = input.Other
+	default:
+		// gift receipts and the like, the gifting event already counted them
+		return ev, false
+	}
+	return ev, true
+}
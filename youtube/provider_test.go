@@ -0,0 +1,151 @@
+package youtube_test
+
+import (
+	"context"
+	"minecraftgo/input"
+	"minecraftgo/youtube"
+	"minecraftgo/youtube/youtubemock"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// start runs a provider against a fresh mock that polls every 50ms
+func start(t *testing.T, setup func(mock *youtubemock.Server, provider *youtube.Provider)) (*youtubemock.Server, <-chan input.Event, <-chan error) {
+	t.Helper()
+	mock := youtubemock.NewServer()
+	mock.PollingInterval = 50 * time.Millisecond
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+
+	client := youtube.NewClient("key")
+	client.BaseUrl = youtubemock.ApiUrl(srv.URL)
+	provider := youtube.NewProvider(client, mock.VideoId)
+	provider.MembershipTiers = map[string]string{"Diamond": "3000"}
+	provider.MinPollInterval = 10 * time.Millisecond
+	if setup != nil {
+		setup(mock, provider)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	events := make(chan input.Event, 16)
+	done := make(chan error, 1)
+	go func() { done <- provider.Run(ctx, events) }()
+	return mock, events, done
+}
+
+// waitPolls waits until the provider has been past the backlog
+func waitPolls(t *testing.T, mock *youtubemock.Server, polls int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for mock.Polls() < polls {
+		if time.Now().After(deadline) {
+			t.Fatalf("only %d polls", mock.Polls())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func next(t *testing.T, events <-chan input.Event) input.Event {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event")
+		return input.Event{}
+	}
+}
+
+func TestBacklogSkipped(t *testing.T) {
+	mock, events, _ := start(t, func(mock *youtubemock.Server, _ *youtube.Provider) {
+		mock.Chat(youtubemock.Author{Name: "early"}, "kill")
+	})
+	waitPolls(t, mock, 1)
+	mock.Chat(youtubemock.Author{Name: "viewer1", Badges: []string{"moderator"}}, "skeleton")
+
+	ev := next(t, events)
+	if ev.Kind != input.Chat || ev.What != "skeleton" || ev.Viewer.Name != "viewer1" {
+		t.Fatalf("got %+v, want viewer1's skeleton", ev)
+	}
+	if !ev.Viewer.Privileged() {
+		t.Error("moderator is not privileged")
+	}
+}
+
+func TestSupportMapping(t *testing.T) {
+	mock, events, _ := start(t, nil)
+	waitPolls(t, mock, 1)
+	author := youtubemock.Author{Name: "fan"}
+	mock.SuperChat(author, 5, "EUR", "creeper please")
+	mock.SuperChat(author, 3, "XYZ", "")
+	mock.Member(author, "Diamond")
+	mock.Milestone(author, "Iron", 6, "half a year")
+	mock.Gift(author, "Iron", 5)
+
+	tests := []struct {
+		kind   input.Kind
+		amount int
+		tier   string
+		what   string
+	}{
+		{input.Tip, 540, "", "creeper please"},
+		// no rate, so the lowest amount of its tier
+		{input.Tip, 200, "", ""},
+		{input.Subscribe, 1, "3000", ""},
+		{input.Resub, 6, "1000", "half a year"},
+		{input.GiftSub, 5, "1000", ""},
+	}
+	for _, want := range tests {
+		ev := next(t, events)
+		if ev.Kind != want.kind || ev.Amount != want.amount || ev.Tier != want.tier || ev.What != want.what {
+			t.Errorf("got %s %d %q %q, want %s %d %q %q", ev.Kind, ev.Amount, ev.Tier, ev.What, want.kind, want.amount, want.tier, want.what)
+		}
+	}
+}
+
+func TestChatEnded(t *testing.T) {
+	mock, events, done := start(t, nil)
+	waitPolls(t, mock, 1)
+	mock.End()
+
+	if ev := next(t, events); ev.Kind != input.Other {
+		t.Errorf("got %s, want the chat ended event", ev.Kind)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run returned %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run didn't return after chat ended")
+	}
+}
+
+func TestChatEndedBeforeStart(t *testing.T) {
+	_, _, done := start(t, func(mock *youtubemock.Server, provider *youtube.Provider) {
+		// the video isn't live anymore, but its chat id is still known
+		provider.LiveChatId = mock.LiveChatId
+		mock.End()
+	})
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run returned %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run didn't return on liveChatEnded")
+	}
+}
+
+func TestMinPollInterval(t *testing.T) {
+	mock, _, _ := start(t, func(mock *youtubemock.Server, _ *youtube.Provider) {
+		// as if YouTube left pollingIntervalMillis out
+		mock.PollingInterval = 0
+	})
+	time.Sleep(100 * time.Millisecond)
+	if polls := mock.Polls(); polls > 12 {
+		t.Errorf("%d polls in 100ms with a 10ms minimum", polls)
+	}
+}
@@ -0,0 +1,197 @@
+// Package youtube reads a YouTube live chat by polling LiveChatMessages, the
+// way YouTube wants it: no faster than the pollingIntervalMillis it asks for.
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const youtubeApiUrl = "https://www.googleapis.com/youtube/v3"
+
+const (
+	TextMessageType            = "textMessageEvent"
+	SuperChatType              = "superChatEvent"
+	SuperStickerType           = "superStickerEvent"
+	NewSponsorType             = "newSponsorEvent"
+	MemberMilestoneType        = "memberMilestoneChatEvent"
+	MembershipGiftingType      = "membershipGiftingEvent"
+	GiftMembershipReceivedType = "giftMembershipReceivedEvent"
+	ChatEndedType              = "chatEndedEvent"
+)
+
+// error reasons the api answers with
+const (
+	ReasonLiveChatEnded     = "liveChatEnded"
+	ReasonLiveChatNotFound  = "liveChatNotFound"
+	ReasonRateLimitExceeded = "rateLimitExceeded"
+	ReasonQuotaExceeded     = "quotaExceeded"
+)
+
+type TextMessageDetails struct {
+	MessageText string `json:"messageText"`
+}
+
+type NewSponsorDetails struct {
+	MemberLevelName string `json:"memberLevelName"`
+	IsUpgrade       bool   `json:"isUpgrade"`
+}
+
+type MemberMilestoneChatDetails struct {
+	MemberLevelName string `json:"memberLevelName"`
+	MemberMonth     int    `json:"memberMonth"`
+	UserComment     string `json:"userComment"`
+}
+
+type MembershipGiftingDetails struct {
+	GiftMembershipsCount     int    `json:"giftMembershipsCount"`
+	GiftMembershipsLevelName string `json:"giftMembershipsLevelName"`
+}
+
+type SuperChatDetails struct {
+	AmountMicros        string `json:"amountMicros"`
+	Currency            string `json:"currency"`
+	AmountDisplayString string `json:"amountDisplayString"`
+	UserComment         string `json:"userComment"`
+	// 1 to 11, whatever the currency
+	Tier int `json:"tier"`
+}
+
+type LiveChatMessage struct {
+	Id      string `json:"id"`
+	Snippet struct {
+		Type                       string                      `json:"type"`
+		LiveChatId                 string                      `json:"liveChatId"`
+		AuthorChannelId            string                      `json:"authorChannelId"`
+		PublishedAt                time.Time                   `json:"publishedAt"`
+		DisplayMessage             string                      `json:"displayMessage"`
+		TextMessageDetails         *TextMessageDetails         `json:"textMessageDetails,omitempty"`
+		SuperChatDetails           *SuperChatDetails           `json:"superChatDetails,omitempty"`
+		SuperStickerDetails        *SuperChatDetails           `json:"superStickerDetails,omitempty"`
+		NewSponsorDetails          *NewSponsorDetails          `json:"newSponsorDetails,omitempty"`
+		MemberMilestoneChatDetails *MemberMilestoneChatDetails `json:"memberMilestoneChatDetails,omitempty"`
+		MembershipGiftingDetails   *MembershipGiftingDetails   `json:"membershipGiftingDetails,omitempty"`
+	} `json:"snippet"`
+	AuthorDetails struct {
+		ChannelId       string `json:"channelId"`
+		DisplayName     string `json:"displayName"`
+		IsVerified      bool   `json:"isVerified"`
+		IsChatOwner     bool   `json:"isChatOwner"`
+		IsChatSponsor   bool   `json:"isChatSponsor"`
+		IsChatModerator bool   `json:"isChatModerator"`
+	} `json:"authorDetails"`
+}
+
+type LiveChatMessageList struct {
+	NextPageToken         string            `json:"nextPageToken"`
+	PollingIntervalMillis int               `json:"pollingIntervalMillis"`
+	OfflineAt             *time.Time        `json:"offlineAt,omitempty"`
+	Items                 []LiveChatMessage `json:"items"`
+}
+
+type ApiError struct {
+	StatusCode int
+	Message    string
+	Reason     string
+	Path       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("youtube %s: %d %s (%s)", e.Path, e.StatusCode, e.Message, e.Reason)
+}
+
+// IsReason reports whether err is an ApiError for reason, e.g. liveChatEnded
+func IsReason(err error, reason string) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.Reason == reason
+}
+
+// Client reads public live chat data with an api key
+type Client struct {
+	BaseUrl string
+	ApiKey  string
+	HTTP    *http.Client
+}
+
+func NewClient(apiKey string) *Client {
+	return &Client{BaseUrl: youtubeApiUrl, ApiKey: apiKey, HTTP: http.DefaultClient}
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	query.Set("key", c.ApiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.BaseUrl, "/")+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+		var body struct {
+			Error struct {
+				Message string `json:"message"`
+				Errors  []struct {
+					Reason string `json:"reason"`
+				} `json:"errors"`
+			} `json:"error"`
+		}
+		json.Unmarshal(data, &body)
+		apiErr := &ApiError{StatusCode: res.StatusCode, Message: body.Error.Message, Path: path}
+		if len(body.Error.Errors) > 0 {
+			apiErr.Reason = body.Error.Errors[0].Reason
+		}
+		return apiErr
+	}
+	return json.Unmarshal(data, out)
+}
+
+// LiveChatId finds the chat of a live video
+func (c *Client) LiveChatId(ctx context.Context, videoId string) (string, error) {
+	var body struct {
+		Items []struct {
+			LiveStreamingDetails struct {
+				ActiveLiveChatId string `json:"activeLiveChatId"`
+			} `json:"liveStreamingDetails"`
+		} `json:"items"`
+	}
+	query := url.Values{"part": {"liveStreamingDetails"}, "id": {videoId}}
+	if err := c.get(ctx, "/videos", query, &body); err != nil {
+		return "", err
+	}
+	if len(body.Items) == 0 {
+		return "", fmt.Errorf("youtube video %s not found", videoId)
+	}
+	id := body.Items[0].LiveStreamingDetails.ActiveLiveChatId
+	if id == "" {
+		return "", fmt.Errorf("youtube video %s is not live", videoId)
+	}
+	return id, nil
+}
+
+// LiveChatMessages returns the messages after pageToken, all recent ones when
+// it's empty
+func (c *Client) LiveChatMessages(ctx context.Context, liveChatId string, pageToken string) (*LiveChatMessageList, error) {
+	query := url.Values{"liveChatId": {liveChatId}, "part": {"snippet,authorDetails"}, "maxResults": {"2000"}}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	var list LiveChatMessageList
+	if err := c.get(ctx, "/liveChat/messages", query, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
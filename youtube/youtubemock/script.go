@@ -0,0 +1,107 @@
+package youtubemock
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Step is one thing the injector does. Scripts are one step per line as json,
+// blank lines and lines starting with # are skipped:
+//
+//	{"wait": "5s"}
+//	{"author": {"name": "viewer1"}, "chat": "skeleton"}
+//	{"author": {"name": "viewer2"}, "super_chat": {"amount": 5, "currency": "EUR"}, "chat": "have a creeper"}
+//	{"author": {"name": "viewer3"}, "member": "Diamond"}
+//	{"author": {"name": "viewer4"}, "milestone": {"level": "Diamond", "months": 6}}
+//	{"author": {"name": "viewer5"}, "gift": {"level": "Iron", "count": 5}}
+//	{"end": true}
+type Step struct {
+	Wait      string `json:"wait,omitempty"`
+	Author    Author `json:"author"`
+	Chat      string `json:"chat,omitempty"`
+	SuperChat *struct {
+		Amount   float64 `json:"amount"`
+		Currency string  `json:"currency"`
+	} `json:"super_chat,omitempty"`
+	Member    string `json:"member,omitempty"`
+	Milestone *struct {
+		Level  string `json:"level"`
+		Months int    `json:"months"`
+	} `json:"milestone,omitempty"`
+	Gift *struct {
+		Level string `json:"level"`
+		Count int    `json:"count"`
+	} `json:"gift,omitempty"`
+	End bool `json:"end,omitempty"`
+}
+
+func (s *Server) Do(ctx context.Context, step Step) error {
+	switch {
+	case step.Wait != "":
+		d, err := time.ParseDuration(step.Wait)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	case step.SuperChat != nil:
+		s.SuperChat(step.Author, step.SuperChat.Amount, step.SuperChat.Currency, step.Chat)
+	case step.Member != "":
+		s.Member(step.Author, step.Member)
+	case step.Milestone != nil:
+		s.Milestone(step.Author, step.Milestone.Level, step.Milestone.Months, step.Chat)
+	case step.Gift != nil:
+		s.Gift(step.Author, step.Gift.Level, step.Gift.Count)
+	case step.End:
+		s.End()
+	case step.Chat != "":
+		s.Chat(step.Author, step.Chat)
+	default:
+		return fmt.Errorf("step does nothing")
+	}
+	return nil
+}
+
+// RunScript runs the steps in r one after another, steps that fail are
+// reported and skipped
+func (s *Server) RunScript(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var step Step
+		if err := json.Unmarshal([]byte(text), &step); err != nil {
+			return fmt.Errorf("script line %d: %w", line, err)
+		}
+		if err := s.Do(ctx, step); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fmt.Println("youtubemock script line", line, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// handleInject runs steps posted to /mock/inject, one per line like a script
+func (s *Server) handleInject(res http.ResponseWriter, req *http.Request) {
+	if err := s.RunScript(req.Context(), req.Body); err != nil {
+		writeError(res, http.StatusBadRequest, "invalidScript", err.Error())
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,243 @@
+// Package youtubemock is a stand-in for the YouTube Data API calls the bot
+// makes to read live chat. It runs under httptest or cmd/youtubemock and
+// complains like YouTube when polled faster than pollingIntervalMillis.
+package youtubemock
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"minecraftgo/youtube"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Author is who sends a message, badges are "owner", "moderator" and "member"
+type Author struct {
+	Name   string   `json:"name"`
+	Badges []string `json:"badges,omitempty"`
+}
+
+type Server struct {
+	// the api key requests must carry, empty accepts any
+	ApiKey          string
+	VideoId         string
+	LiveChatId      string
+	PollingInterval time.Duration
+
+	mu       sync.Mutex
+	messages []youtube.LiveChatMessage
+	ended    bool
+	lastPoll time.Time
+	polls    int
+
+	mux *http.ServeMux
+}
+
+func NewServer() *Server {
+	s := &Server{
+		VideoId:         "mockvideo",
+		LiveChatId:      "mockchat",
+		PollingInterval: 2 * time.Second,
+		mux:             http.NewServeMux(),
+	}
+	s.mux.HandleFunc("GET /youtube/v3/videos", s.handleVideos)
+	s.mux.HandleFunc("GET /youtube/v3/liveChat/messages", s.handleMessages)
+	s.mux.HandleFunc("POST /mock/inject", s.handleInject)
+	return s
+}
+
+func (s *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	fmt.Println("youtubemock", req.Method, req.URL.Path)
+	s.mux.ServeHTTP(res, req)
+}
+
+// ApiUrl is what to point youtube.Client at for this server running on baseUrl
+func ApiUrl(baseUrl string) string {
+	return strings.TrimSuffix(baseUrl, "/") + "/youtube/v3"
+}
+
+// Polls returns how many times chat was polled
+func (s *Server) Polls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.polls
+}
+
+func writeJSON(res http.ResponseWriter, status int, body any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(body)
+}
+
+func writeError(res http.ResponseWriter, status int, reason string, message string) {
+	writeJSON(res, status, map[string]any{"error": map[string]any{
+		"code":    status,
+		"message": message,
+		"errors":  []map[string]string{{"reason": reason, "message": message}},
+	}})
+}
+
+func (s *Server) authorized(res http.ResponseWriter, req *http.Request) bool {
+	if s.ApiKey != "" && req.URL.Query().Get("key") != s.ApiKey {
+		writeError(res, http.StatusBadRequest, "keyInvalid", "API key not valid. Please pass a valid API key.")
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleVideos(res http.ResponseWriter, req *http.Request) {
+	if !s.authorized(res, req) {
+		return
+	}
+	items := []any{}
+	if req.URL.Query().Get("id") == s.VideoId {
+		details := map[string]string{}
+		s.mu.Lock()
+		if !s.ended {
+			details["activeLiveChatId"] = s.LiveChatId
+		}
+		s.mu.Unlock()
+		items = append(items, map[string]any{"id": s.VideoId, "liveStreamingDetails": details})
+	}
+	writeJSON(res, http.StatusOK, map[string]any{"items": items})
+}
+
+// handleMessages pages through chat, the page token is how many messages were
+// handed out so far
+func (s *Server) handleMessages(res http.ResponseWriter, req *http.Request) {
+	if !s.authorized(res, req) {
+		return
+	}
+	query := req.URL.Query()
+	if query.Get("liveChatId") != s.LiveChatId {
+		writeError(res, http.StatusNotFound, youtube.ReasonLiveChatNotFound, "The live chat that you are trying to retrieve cannot be found.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	// a little slack for timers, anything much faster is a client ignoring the interval
+	if !s.lastPoll.IsZero() && now.Sub(s.lastPoll) < s.PollingInterval*9/10 {
+		writeError(res, http.StatusForbidden, youtube.ReasonRateLimitExceeded, "The request was sent too soon after the previous one.")
+		return
+	}
+	s.lastPoll = now
+	s.polls++
+
+	from := 0
+	if token := query.Get("pageToken"); token != "" {
+		var err error
+		if from, err = strconv.Atoi(token); err != nil || from > len(s.messages) {
+			writeError(res, http.StatusBadRequest, "pageTokenInvalid", "The page token is not valid.")
+			return
+		}
+	}
+	if s.ended && from == len(s.messages) {
+		writeError(res, http.StatusForbidden, youtube.ReasonLiveChatEnded, "The live chat is no longer live.")
+		return
+	}
+
+	writeJSON(res, http.StatusOK, youtube.LiveChatMessageList{
+		NextPageToken:         strconv.Itoa(len(s.messages)),
+		PollingIntervalMillis: int(s.PollingInterval.Milliseconds()),
+		Items:                 append([]youtube.LiveChatMessage{}, s.messages[from:]...),
+	})
+}
+
+func channelId(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(name)))
+	return "UC" + strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// add puts a message in chat, fill sets the type specific parts
+func (s *Server) add(author Author, messageType string, display string, fill func(msg *youtube.LiveChatMessage)) {
+	var msg youtube.LiveChatMessage
+	msg.Id = uuid.NewString()
+	msg.Snippet.Type = messageType
+	msg.Snippet.LiveChatId = s.LiveChatId
+	msg.Snippet.AuthorChannelId = channelId(author.Name)
+	msg.Snippet.PublishedAt = time.Now().UTC()
+	msg.Snippet.DisplayMessage = display
+	msg.AuthorDetails.ChannelId = channelId(author.Name)
+	msg.AuthorDetails.DisplayName = author.Name
+	for _, badge := range author.Badges {
+		switch badge {
+		case "owner":
+			msg.AuthorDetails.IsChatOwner = true
+		case "moderator":
+			msg.AuthorDetails.IsChatModerator = true
+		case "member":
+			msg.AuthorDetails.IsChatSponsor = true
+		}
+	}
+	if fill != nil {
+		fill(&msg)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+}
+
+func (s *Server) Chat(author Author, text string) {
+	s.add(author, youtube.TextMessageType, text, func(msg *youtube.LiveChatMessage) {
+		msg.Snippet.TextMessageDetails = &youtube.TextMessageDetails{MessageText: text}
+	})
+}
+
+// superChatTiers are the lowest amount of each tier, in whole units of any currency
+var superChatTiers = []float64{1, 2, 5, 10, 20, 50, 100, 200, 300, 400, 500}
+
+// SuperChat sends amount of currency with comment, the tier follows the amount
+func (s *Server) SuperChat(author Author, amount float64, currency string, comment string) {
+	tier := 0
+	for idx, floor := range superChatTiers {
+		if amount >= floor {
+			tier = idx + 1
+		}
+	}
+	display := fmt.Sprintf("%s%.2f", currency, amount)
+	s.add(author, youtube.SuperChatType, comment, func(msg *youtube.LiveChatMessage) {
+		msg.Snippet.SuperChatDetails = &youtube.SuperChatDetails{
+			AmountMicros:        strconv.FormatInt(int64(amount*1e6), 10),
+			Currency:            currency,
+			AmountDisplayString: display,
+			UserComment:         comment,
+			Tier:                tier,
+		}
+	})
+}
+
+func (s *Server) Member(author Author, level string) {
+	s.add(author, youtube.NewSponsorType, "Welcome to "+level+"!", func(msg *youtube.LiveChatMessage) {
+		msg.Snippet.NewSponsorDetails = &youtube.NewSponsorDetails{MemberLevelName: level}
+	})
+}
+
+func (s *Server) Milestone(author Author, level string, months int, comment string) {
+	s.add(author, youtube.MemberMilestoneType, comment, func(msg *youtube.LiveChatMessage) {
+		msg.Snippet.MemberMilestoneChatDetails = &youtube.MemberMilestoneChatDetails{MemberLevelName: level, MemberMonth: months, UserComment: comment}
+	})
+}
+
+func (s *Server) Gift(author Author, level string, count int) {
+	s.add(author, youtube.MembershipGiftingType, fmt.Sprintf("Gifted %d %s memberships", count, level), func(msg *youtube.LiveChatMessage) {
+		msg.Snippet.MembershipGiftingDetails = &youtube.MembershipGiftingDetails{GiftMembershipsCount: count, GiftMembershipsLevelName: level}
+	})
+}
+
+// End closes chat, polls after the last message get liveChatEnded
+func (s *Server) End() {
+	s.add(Author{Name: "YouTube"}, youtube.ChatEndedType, "", nil)
+	s.mu.Lock()
+	s.ended = true
+	s.mu.Unlock()
+}